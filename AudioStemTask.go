@@ -2,7 +2,6 @@ package audioStem
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/janction/audioStem/db"
@@ -18,7 +17,7 @@ func GetEmptyAudioStemTaskList() []IndexedAudioStemTask {
 
 func (t *AudioStemTask) GenerateThreads(taskId string, cid string) (res []*AudioStemThread) {
 	for i := range t.AmountFiles {
-		thread := AudioStemThread{ThreadId: t.TaskId + strconv.FormatInt(int64(i), 10), TaskId: taskId, Instrument: t.Instrument, Mp3: t.Mp3, Cid: cid}
+		thread := AudioStemThread{ThreadId: FormatThreadId(taskId, uint32(i)), TaskId: taskId, Index: uint32(i), Instrument: t.Instrument, Mp3: t.Mp3, Cid: cid}
 		res = append(res, &thread)
 	}
 	return res
@@ -28,7 +27,7 @@ func (t AudioStemTask) SubscribeWorkerToTask(ctx context.Context, workerAddress,
 	// TODO call cmd with message subscribeWorkerToTask
 	args := []string{
 		"tx", "audioStem", "subscribe-worker-to-task",
-		workerAddress, taskId, threadId, "--yes", "--from", workerAddress,
+		signerOf(workerAddress), taskId, threadId, "--yes", "--from", signerOf(workerAddress),
 	}
 	err := ExecuteCli(args)
 	if err != nil {
@@ -38,12 +37,14 @@ func (t AudioStemTask) SubscribeWorkerToTask(ctx context.Context, workerAddress,
 	return nil
 }
 
-func (t *AudioStemTask) GetWinnerReward() types.Coin {
-	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+// GetWinnerReward returns what the proposer of an accepted solution is paid. The reward left
+// in the task is split among the threads still pending, half for the proposer and half for
+// the validators.
+func (t *AudioStemTask) GetWinnerReward(pendingThreads int) types.Coin {
+	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(max(1, pendingThreads))))
 }
 
-func (t *AudioStemTask) GetValidatorsReward() types.Coin {
-	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+// GetValidatorsReward returns what the validators of an accepted solution are paid together.
+func (t *AudioStemTask) GetValidatorsReward(pendingThreads int) types.Coin {
+	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(max(1, pendingThreads))))
 }
@@ -1,15 +1,11 @@
 package audioStem
 
 import (
-	"context"
-	fmt "fmt"
 	"strconv"
 	"testing"
 
-	"bou.ke/monkey"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
-	"github.com/janction/audioStem/mocks"
 
 	"github.com/stretchr/testify/require"
 )
@@ -17,14 +13,13 @@ import (
 // --- Test for Validate ---
 func TestValidate(t *testing.T) {
 	task := &AudioStemTask{
-		TaskId:       "task1",
-		Requester:    "user1",
-		Cid:          "QmTestCid",
-		StartFrame:   0,
-		EndFrame:     9,
-		ThreadAmount: 2,
-		Completed:    false,
-		Reward:       &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
+		TaskId:      "task1",
+		Requester:   "user1",
+		Cid:         "QmTestCid",
+		AmountFiles: 2,
+		Instrument:  "vocals",
+		Completed:   false,
+		Reward:      &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
 	}
 
 	err := task.Validate()
@@ -40,98 +35,60 @@ func TestGetEmptyAudioStemTaskList(t *testing.T) {
 // --- Test for GenerateThreads ---
 func TestGenerateThreads(t *testing.T) {
 	task := &AudioStemTask{
-		TaskId:       "task1",
-		Requester:    "user1",
-		Cid:          "QmTestCid",
-		StartFrame:   0,
-		EndFrame:     9,
-		ThreadAmount: 2,
-		Completed:    false,
-		Reward:       &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
+		TaskId:      "task1",
+		Requester:   "user1",
+		Cid:         "QmTestCid",
+		AmountFiles: 2,
+		Instrument:  "drums",
+		Mp3:         true,
+		Reward:      &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
 	}
 
-	threads := task.GenerateThreads(task.TaskId)
-	require.Len(t, threads, int(task.ThreadAmount))
-
-	expectedRanges := []struct {
-		start int64
-		end   int64
-	}{
-		{0, 4},
-		{5, 9},
-	}
+	threads := task.GenerateThreads(task.TaskId, task.Cid)
+	require.Len(t, threads, int(task.AmountFiles))
 
+	// there is a thread per file, separating the instrument of the task
 	for i, thread := range threads {
-		expectedID := task.TaskId + strconv.Itoa(i)
+		expectedID := task.TaskId + "-" + strconv.Itoa(i)
 		require.Equal(t, expectedID, thread.ThreadId)
-		require.Equal(t, expectedRanges[i].start, thread.StartFrame)
-		require.Equal(t, expectedRanges[i].end, thread.EndFrame)
 		require.Equal(t, task.TaskId, thread.TaskId)
+		require.Equal(t, uint32(i), thread.Index)
+		require.Equal(t, task.Cid, thread.Cid)
+		require.Equal(t, task.Instrument, thread.Instrument)
+		require.True(t, thread.Mp3)
 	}
 }
 
-// --- Test for splitFrames ---
-func TestSplitFrames(t *testing.T) {
-	t.Run("Even number of frames: 10 frames, 2 threads", func(t *testing.T) {
-		expected := []frameRange{
-			{StartFrame: 1, EndFrame: 5},
-			{StartFrame: 6, EndFrame: 10},
-		}
-		result := splitFrames(1, 10, 2)
-		require.Equal(t, expected, result)
-	})
-
-	t.Run("Odd number of frames: 10 frames, 3 threads", func(t *testing.T) {
-		expected := []frameRange{
-			{StartFrame: 1, EndFrame: 4},
-			{StartFrame: 5, EndFrame: 7},
-			{StartFrame: 8, EndFrame: 10},
-		}
-		result := splitFrames(1, 10, 3)
-		require.Equal(t, expected, result)
-	})
-}
-
-// --- Test for SubscribeWorkerToTask ---
-func TestSubscribeWorkerToTaskKo(t *testing.T) {
-	mockDB := new(mocks.DB)
-	task := AudioStemTask{}
-
-	mockDB.On("UpdateTask", "task123", "thread456", false).Return(nil)
+// --- Test for GenerateThreads ids ---
+func TestGenerateThreads_UniqueIds(t *testing.T) {
+	// the thread at index 10 of task 1 and the one at index 0 of task 11 used to share id 110
+	first := &AudioStemTask{TaskId: "1", AmountFiles: 11}
+	second := &AudioStemTask{TaskId: "11", AmountFiles: 1}
 
-	patch := monkey.Patch(ExecuteCli, func(args []string) error {
-		return fmt.Errorf("mock error")
-	})
-	defer patch.Unpatch()
+	tenth := first.GenerateThreads(first.TaskId, "")[10]
+	other := second.GenerateThreads(second.TaskId, "")[0]
+	require.NotEqual(t, tenth.ThreadId, other.ThreadId)
 
-	err := task.SubscribeWorkerToTask(context.Background(), "workerAddress", "task123", "thread456", mockDB)
-	require.Error(t, err)
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubscribeWorkerToTaskOk(t *testing.T) {
-	mockDB := new(mocks.DB)
-	task := AudioStemTask{}
-
-	patch := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch.Unpatch()
-
-	err := task.SubscribeWorkerToTask(context.Background(), "workerAddress", "task123", "thread456", mockDB)
+	index, err := ThreadIndex("1", tenth.ThreadId)
 	require.NoError(t, err)
+	require.Equal(t, uint32(10), index)
+	_, err = ThreadIndex("11", tenth.ThreadId)
+	require.ErrorIs(t, err, ErrInvalidThreadId)
+	index, err = ThreadIndex("11", other.ThreadId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), index)
+	_, err = ThreadIndex("1", other.ThreadId)
+	require.ErrorIs(t, err, ErrInvalidThreadId)
 }
 
 // --- Test for GetWinnerReward ---
 func TestGetWinnerReward(t *testing.T) {
 	task := AudioStemTask{
-		Reward: &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
-		Threads: []*AudioStemThread{
-			{}, {}, {}, {},
-		},
+		Reward:      &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
+		AmountFiles: 4,
 	}
 
-	reward := task.GetWinnerReward()
+	reward := task.GetWinnerReward(4)
 
 	expected := types.NewCoin("token", sdkmath.NewInt(1000).QuoRaw(2).QuoRaw(4))
 	require.Equal(t, expected, reward)
@@ -140,14 +97,15 @@ func TestGetWinnerReward(t *testing.T) {
 // --- Test for GetValidatorsReward ---
 func TestGetValidatorsReward(t *testing.T) {
 	task := AudioStemTask{
-		Reward: &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
-		Threads: []*AudioStemThread{
-			{}, {}, {}, {},
-		},
+		Reward:      &types.Coin{Denom: "token", Amount: sdkmath.NewInt(1000)},
+		AmountFiles: 4,
 	}
 
-	reward := task.GetValidatorsReward()
+	reward := task.GetValidatorsReward(4)
 
 	expected := types.NewCoin("token", sdkmath.NewInt(1000).QuoRaw(2).QuoRaw(4))
 	require.Equal(t, expected, reward)
+
+	// the reward left goes to the threads still pending
+	require.Equal(t, types.NewCoin("token", sdkmath.NewInt(500)), task.GetValidatorsReward(1))
 }
@@ -1,7 +1,10 @@
 package audioStem
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"errors"
 	fmt "fmt"
 	"os"
 	"path"
@@ -16,125 +19,156 @@ import (
 	"github.com/janction/audioStem/audioStemLogger"
 	audioStemCrypto "github.com/janction/audioStem/crypto"
 	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/fingerprint"
 	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/merkle"
 	"github.com/janction/audioStem/vm"
 )
 
-func (t *AudioStemThread) StartWork(ctx context.Context, worker string, cid string, path string, db *db.DB) error {
-	// ctx := context.Background()
+func (t *AudioStemThread) StartWork(ctx context.Context, worker string, cid string, path string, download ipfs.DownloadOptions, database db.Database) error {
+	if vm.IsContainerRunning(ctx, t.ThreadId) {
+		audioStemLogger.Logger.Info("Work for thread %s is already going", t.ThreadId)
+		return nil
+	}
 
-	if err := db.UpdateThread(t.ThreadId, false, false, true, false, false, false, false, false); err != nil {
-		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+	localThread, err := database.ReadThread(t.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to read thread %s, err: %s", t.ThreadId, err.Error())
+		return err
 	}
+	state := localThread.State
 
-	isRunning := vm.IsContainerRunning(ctx, t.ThreadId)
-	if !isRunning {
-		// task is not running,
+	if state == db.ThreadStemming {
+		// the container is gone but stemming never finished, so we start it over
+		if err := database.TransitionThread(t.ThreadId, db.ThreadStemming, db.ThreadDownloaded); err != nil {
+			audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			return err
+		}
+		state = db.ThreadDownloaded
+	}
+
+	started := time.Now().Unix()
+	if state == db.ThreadIdle || state == db.ThreadDownloading {
+		if state == db.ThreadIdle {
+			if err := database.TransitionThread(t.ThreadId, db.ThreadIdle, db.ThreadDownloading); err != nil {
+				audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+				return err
+			}
+
+			// we remove the container just in case it already exists.
+			vm.RemoveContainer(ctx, "janctionstem"+t.ThreadId)
 
-		// we remove the container just in case it already exists.
-		vm.RemoveContainer(ctx, "janctionstem"+t.ThreadId)
+			audioStemLogger.Logger.Info("No solution for thread %s. Starting work", t.ThreadId)
+			database.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading IPFS file %s...", cid), started, 0)
+		} else {
+			// the download was interrupted, by a restart for example, so we pick it up where it stopped
+			audioStemLogger.Logger.Info("Resuming download for thread %s", t.ThreadId)
+			database.AddLogEntry(t.ThreadId, fmt.Sprintf("Resuming download of IPFS file %s...", cid), started, 0)
+		}
 
-		audioStemLogger.Logger.Info("No solution for thread %s. Starting work", t.ThreadId)
-		// we don't have a solution, start working
-		started := time.Now().Unix()
 		ipfs.EnsureIPFSRunning()
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading IPFS file %s...", cid), started, 0)
-		if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
-			audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		download.Progress = func(downloaded, total uint64) {
+			database.AddLogEntry(t.ThreadId, fmt.Sprintf("Downloaded %d of %d bytes of IPFS file %s", downloaded, total, cid), time.Now().Unix(), 0)
+		}
+		err := ipfs.GetInput(ctx, cid, t.Index, path, download)
+		if errors.Is(err, ipfs.ErrInputTooLarge) {
+			return t.leaveThread(worker, err.Error(), database)
 		}
-		err := ipfs.IPFSGet(cid, path)
 		if err != nil {
-			if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
-				audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
-			}
-			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting IPFS file %s. %s", cid, err.Error()), started, 2)
+			revertThread(database, t.ThreadId, db.ThreadDownloading, db.ThreadIdle)
+			database.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting IPFS file %s. %s", cid, err.Error()), time.Now().Unix(), 2)
 			audioStemLogger.Logger.Error("Error getting cid %s", cid)
 			return err
 		}
+		// we make sure the input is audio we can work with before spending time on it
+		if reason := probeInput(filepath.Join(path, cid)); reason != "" {
+			return t.rejectInput(worker, reason, database)
+		}
+
 		// download completed successfuly
-		if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
+		if err := database.TransitionThread(t.ThreadId, db.ThreadDownloading, db.ThreadDownloaded); err != nil {
 			audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			return err
 		}
 
 		finish := time.Now().Unix()
 		difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
-
-		// we start rendering
-		vm.StemAudio(ctx, t.ThreadId, cid, t.Instrument, t.Mp3, path, db)
+		database.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
+		state = db.ThreadDownloaded
+	}
 
-		rendersPath := filepath.Join(path, "htdemucs")
-		_, err = os.Stat(rendersPath)
-		finish = time.Now().Unix()
-		difference = time.Unix(finish, 0).Sub(time.Unix(started, 0))
-		if err != nil {
-			// output path was not created so no rendering happened. we will start over
-			audioStemLogger.Logger.Error("Unable to complete rendering of task, retrying. No files at %s", rendersPath)
+	if state != db.ThreadDownloaded {
+		audioStemLogger.Logger.Debug("Thread %s is %s, no work to start", t.ThreadId, state)
+		return nil
+	}
 
-			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
-			return nil
-		}
-		files, _ := os.ReadDir(rendersPath)
-		if len(files) != 1 {
-			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
-			audioStemLogger.Logger.Error("Not the amount we expected. retrying. Amount of files %v", len(files))
-			return nil
-		}
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s completed succesfully in %v seconds.", t.ThreadId, int(difference.Seconds())), finish, 1)
-	} else {
-		// Container is running, so we update worker status
-		// if worker status is idle, we change it
-		audioStemLogger.Logger.Info("Work for thread %s is already going", t.ThreadId)
+	// we start rendering
+	if err := database.TransitionThread(t.ThreadId, db.ThreadDownloaded, db.ThreadStemming); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
+	vm.StemAudio(ctx, t.ThreadId, cid, t.Instrument, t.Mp3, path, database)
 
+	rendersPath := filepath.Join(path, "htdemucs")
+	_, err = os.Stat(rendersPath)
+	finish := time.Now().Unix()
+	difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
+	if err != nil {
+		// output path was not created so no rendering happened. we will start over
+		audioStemLogger.Logger.Error("Unable to complete rendering of task, retrying. No files at %s", rendersPath)
+		revertThread(database, t.ThreadId, db.ThreadStemming, db.ThreadDownloaded)
+		return fmt.Errorf("no stems found at %s", rendersPath)
+	}
+	files, _ := os.ReadDir(rendersPath)
+	if len(files) != 1 {
+		revertThread(database, t.ThreadId, db.ThreadStemming, db.ThreadDownloaded)
+		audioStemLogger.Logger.Error("Not the amount we expected. retrying. Amount of files %v", len(files))
+		return fmt.Errorf("expected 1 stem directory at %s, found %v", rendersPath, len(files))
+	}
+	if err := database.TransitionThread(t.ThreadId, db.ThreadStemming, db.ThreadStemmed); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
 	}
+	database.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s completed succesfully in %v seconds.", t.ThreadId, int(difference.Seconds())), finish, 1)
 
 	return nil
 }
 
-func (t AudioStemThread) ProposeSolution(codec codec.Codec, alias, workerAddress string, rootPath string, db *db.DB) error {
-	db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+// revertThread moves the thread back to the state it had before the work that just failed.
+func revertThread(database db.Database, threadId string, from, to db.ThreadState) {
+	if err := database.TransitionThread(threadId, from, to); err != nil {
+		audioStemLogger.Logger.Error("Unable to move thread %s back to %s, err: %s", threadId, to, err.Error())
+	}
+}
+
+func (t AudioStemThread) ProposeSolution(codec codec.Codec, keys audioStemCrypto.KeyLocation, alias, workerAddress string, rootPath string, database db.Database) error {
+	if err := database.TransitionThread(t.ThreadId, db.ThreadStemmed, db.ThreadProposing); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
 
 	output := path.Join(rootPath, "audioStems", t.ThreadId, "htdemucs", t.Cid)
 
-	hashes, err := GenerateDirectoryFileHashes(output)
+	pkey, err := audioStemCrypto.ExtractPublicKey(keys, alias, codec)
 	if err != nil {
-		audioStemLogger.Logger.Error("Unable to calculate CIDs: %s", err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+		audioStemLogger.Logger.Error("Unable to extract public key for alias %s at path %s: %s", alias, keys.Dir, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadProposing, db.ThreadStemmed)
 		return err
 	}
 
-	pkey, err := audioStemCrypto.ExtractPublicKey(rootPath, alias, codec)
+	publicKey, err := audioStemCrypto.EncodePublicKeyForCLI(pkey)
 	if err != nil {
-		audioStemLogger.Logger.Error("Unable to extract public key for alias %s at path %s: %s", alias, rootPath, err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+		revertThread(database, t.ThreadId, db.ThreadProposing, db.ThreadStemmed)
 		return err
 	}
 
-	publicKey := audioStemCrypto.EncodePublicKeyForCLI(pkey)
-
-	for filename, hash := range hashes {
-		sigMsg, err := audioStemCrypto.GenerateSignableMessage(hash, workerAddress)
-
-		if err != nil {
-			audioStemLogger.Logger.Error("Unable to generate message for worker %s and hash %s: %s", workerAddress, hash, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-			return err
-		}
-
-		signature, _, err := audioStemCrypto.SignMessage(rootPath, alias, sigMsg, codec)
-
-		if err != nil {
-			audioStemLogger.Logger.Error("Unable to sign message for worker %s and hash %s: %s", workerAddress, hash, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-			return err
-		}
-		// We rewrite the hash with the signature
-		hashes[filename] = audioStemCrypto.EncodeSignatureForCLI(signature)
+	commitment, signature, err := commitStems(codec, keys, alias, workerAddress, output, t.ThreadId, database)
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to commit to the solution of thread %s: %s", t.ThreadId, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadProposing, db.ThreadStemmed)
+		return err
 	}
 
-	solution := MapToKeyValueFormat(hashes)
-
 	// Base arguments
 	args := []string{
 		"tx", "audioStem", "propose-solution",
@@ -142,103 +176,160 @@ func (t AudioStemThread) ProposeSolution(codec codec.Codec, alias, workerAddress
 	}
 
 	// Append solution arguments
-	args = append(args, publicKey)
-	args = append(args, solution...)
+	args = append(args, publicKey, commitment, signature)
 
 	// Append flags
-	args = append(args, "--yes", "--from", workerAddress)
+	args = append(args, "--yes", "--from", signerOf(workerAddress))
 	err = ExecuteCli(args)
 	if err != nil {
 		audioStemLogger.Logger.Error(err.Error())
+		revertThread(database, t.ThreadId, db.ThreadProposing, db.ThreadStemmed)
 		return err
 	}
 
-	db.AddLogEntry(t.ThreadId, "Solution proposed. Wainting confirmation...", time.Now().Unix(), 0)
+	if err := database.TransitionThread(t.ThreadId, db.ThreadProposing, db.ThreadProposed); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
+	database.AddLogEntry(t.ThreadId, "Solution proposed. Wainting confirmation...", time.Now().Unix(), 0)
 
 	return nil
 }
 
-func (t AudioStemThread) SubmitVerification(codec codec.Codec, alias, workerAddress string, rootPath string, db *db.DB) error {
+func (t AudioStemThread) SubmitVerification(codec codec.Codec, keys audioStemCrypto.KeyLocation, alias, workerAddress string, rootPath string, minValidStemsPercent int64, database db.Database) error {
+	localThread, err := database.ReadThread(t.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to read thread %s, err: %s", t.ThreadId, err.Error())
+		return err
+	}
+	// we verify from the stemmed state or, if we proposed the solution ourselves, from the proposed one
+	from := localThread.State
+	if from != db.ThreadStemmed && from != db.ThreadProposed {
+		audioStemLogger.Logger.Debug("Thread %s is %s, skipping verification", t.ThreadId, from)
+		return nil
+	}
 
 	// we will verify any file we already have rendered.
-	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, false, false)
+	if err := database.TransitionThread(t.ThreadId, from, db.ThreadVerifying); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
 	output := path.Join(rootPath, "audioStems", t.ThreadId, "htdemucs", t.Cid)
 	files := vm.CountFilesInDirectory(output)
 	if files == 0 {
 		audioStemLogger.Logger.Error("found %v files in path %s", files, output)
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
 		return nil
 	}
 
-	// Before we calculate verification, we need to make sure we have enought rendered files to submit one.
-	totalFiles := 1
-	threshold := float64(totalFiles) * 0.2 // TODO this percentage should be in params
-
-	if float64(files) > threshold {
+	// Before we calculate verification, we need to make sure we have enought stems for it to
+	// make the solution valid.
+	if files >= RequiredStems(StemsPerSolution, minValidStemsPercent) {
 		audioStemLogger.Logger.Info("rendered files %v at %sis enought to generate verification", files, output)
 	} else {
 		audioStemLogger.Logger.Error("not enought files %v at %s to generate validation. Rendering should continue", files, output)
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
 		return nil
 	}
-	// we do have some work, lets compare it with the solution
-	myWork, err := GenerateDirectoryFileHashes(output)
+	publicKey, err := audioStemCrypto.GetPublicKey(keys, alias, codec)
+	if err != nil {
+		audioStemLogger.Logger.Error("Error getting public key for alias %s at path %s: %s", alias, keys.Dir, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
+		return err
+	}
+	encodedKey, err := audioStemCrypto.EncodePublicKeyForCLI(publicKey)
+	if err != nil {
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
+		return err
+	}
 
+	// we commit to our work, it is compared with the solution once both are revealed
+	commitment, signature, err := commitStems(codec, keys, alias, workerAddress, output, t.ThreadId, database)
 	if err != nil {
-		audioStemLogger.Logger.Error("error getting hashes. Err: %s", err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		audioStemLogger.Logger.Error("unable to commit to the stems of thread %s: %s", t.ThreadId, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
 		return err
 	}
 
-	publicKey, err := audioStemCrypto.GetPublicKey(rootPath, alias, codec)
+	database.AddLogEntry(t.ThreadId, "Starting verification of solution...", time.Now().Unix(), 0)
+
+	err = submitValidation(workerAddress, t.TaskId, t.ThreadId, encodedKey, commitment, signature)
+
 	if err != nil {
-		audioStemLogger.Logger.Error("Error getting public key for alias %s at path %s: %s", alias, rootPath, err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		audioStemLogger.Logger.Error("error sending verification: %s", err.Error())
+		revertThread(database, t.ThreadId, db.ThreadVerifying, from)
+		return err
+	}
+	if err := database.TransitionThread(t.ThreadId, db.ThreadVerifying, db.ThreadVerified); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
 		return err
 	}
+	database.AddLogEntry(t.ThreadId, "Solution verified", time.Now().Unix(), 0)
+	return nil
+}
 
-	for filename, hash := range myWork {
-		message, err := audioStemCrypto.GenerateSignableMessage(hash, workerAddress)
-		if err != nil {
-			audioStemLogger.Logger.Error("unable to generate message to sign %s: %s", message, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
-			return err
-		}
+// probeInput returns why the downloaded input can't be stemmed, or empty if it can.
+func probeInput(inputPath string) string {
+	info, err := os.Stat(inputPath)
+	if err != nil || !info.Mode().IsRegular() {
+		// inputs that are directories are probed by the stemming itself
+		return ""
+	}
+	if _, err := ProbeAudioFile(inputPath); errors.Is(err, ErrUndecodableAudio) {
+		return err.Error()
+	}
+	return ""
+}
 
-		signature, _, err := audioStemCrypto.SignMessage(rootPath, alias, message, codec)
+// rejectInput reports the input of the thread as invalid and stops working on it.
+func (t *AudioStemThread) rejectInput(worker, reason string, database db.Database) error {
+	audioStemLogger.Logger.Info("Input of thread %s is invalid: %s", t.ThreadId, reason)
+	database.AddLogEntry(t.ThreadId, fmt.Sprintf("Input is not valid audio, reporting it. %s", reason), time.Now().Unix(), 2)
 
-		if err != nil {
-			audioStemLogger.Logger.Error("unable to sign message %s: %s", message, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
-			return err
-		}
-		// we replace the hash for the signature
-		myWork[filename] = audioStemCrypto.EncodeSignatureForCLI(signature)
+	args := []string{"tx", "audioStem", "report-invalid-input", t.TaskId, t.ThreadId, reason, "--yes", "--from", signerOf(worker)}
+	if err := ExecuteCli(args); err != nil {
+		audioStemLogger.Logger.Error("error reporting invalid input: %s", err.Error())
+		revertThread(database, t.ThreadId, db.ThreadDownloading, db.ThreadIdle)
+		return err
 	}
 
-	db.AddLogEntry(t.ThreadId, "Starting verification of solution...", time.Now().Unix(), 0)
+	if err := database.TransitionThread(t.ThreadId, db.ThreadDownloading, db.ThreadCompleted); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
+	return nil
+}
 
-	err = submitValidation(workerAddress, t.TaskId, t.ThreadId, audioStemCrypto.EncodePublicKeyForCLI(publicKey), MapToKeyValueFormat(myWork))
+// leaveThread leaves a thread whose input this node can't process, like an input above the
+// size it downloads. That is a setting of the node and not a property of the input, so the
+// input isn't reported and another worker can take the place of this one.
+func (t *AudioStemThread) leaveThread(worker, reason string, database db.Database) error {
+	audioStemLogger.Logger.Info("Leaving thread %s: %s", t.ThreadId, reason)
+	database.AddLogEntry(t.ThreadId, fmt.Sprintf("Input can't be processed by this node, leaving the thread. %s", reason), time.Now().Unix(), 2)
+
+	args := []string{"tx", "audioStem", "leave-thread", t.TaskId, t.ThreadId, "--yes", "--from", signerOf(worker)}
+	if err := ExecuteCli(args); err != nil {
+		audioStemLogger.Logger.Error("error leaving thread %s: %s", t.ThreadId, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadDownloading, db.ThreadIdle)
+		return err
+	}
 
-	if err != nil {
-		audioStemLogger.Logger.Error("error sending verification: %s", err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+	if err := database.TransitionThread(t.ThreadId, db.ThreadDownloading, db.ThreadCompleted); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
 		return err
 	}
-	db.AddLogEntry(t.ThreadId, "Solution verified", time.Now().Unix(), 0)
 	return nil
 }
 
-func submitValidation(validator string, taskId, threadId, publicKey string, signatures []string) error {
+func submitValidation(validator string, taskId, threadId, publicKey, commitment, signature string) error {
 	// Base arguments
 	args := []string{
 		"tx", "audioStem", "submit-validation",
 		taskId, threadId,
 	}
-	args = append(args, publicKey)
-	args = append(args, signatures...)
+	args = append(args, publicKey, commitment, signature)
 	args = append(args, "--from")
-	args = append(args, validator)
+	args = append(args, signerOf(validator))
 	args = append(args, "--yes")
 	err := ExecuteCli(args)
 	if err != nil {
@@ -247,31 +338,42 @@ func submitValidation(validator string, taskId, threadId, publicKey string, sign
 	return nil
 }
 
-func (t AudioStemThread) SubmitSolution(ctx context.Context, workerAddress, rootPath string, db *db.DB) error {
-	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, true)
+func (t AudioStemThread) SubmitSolution(ctx context.Context, workerAddress, rootPath string, database db.Database) error {
+	if err := database.TransitionThread(t.ThreadId, db.ThreadRevealed, db.ThreadSubmitting); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
 
-	db.AddLogEntry(t.ThreadId, "Submiting solution to IPFS...", time.Now().Unix(), 0)
+	database.AddLogEntry(t.ThreadId, "Submiting solution to IPFS...", time.Now().Unix(), 0)
 	cid, err := ipfs.UploadSolution(ctx, rootPath, t.ThreadId, t.Cid)
 	if err != nil {
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, false)
+		revertThread(database, t.ThreadId, db.ThreadSubmitting, db.ThreadRevealed)
 		audioStemLogger.Logger.Error(err.Error())
 		return err
 	}
+	// the solution stays pinned until the storage of the thread is collected
+	if err := database.AddPin(t.ThreadId, cid); err != nil {
+		audioStemLogger.Logger.Error("Unable to record pin %s of thread %s: %s", cid, t.ThreadId, err.Error())
+	}
 
 	// we get the average duration of rendering the frames
-	duration, err := db.GetAverageRenderTime(t.ThreadId)
+	duration, err := database.GetAverageRenderTime(t.ThreadId)
 	if err != nil {
 		duration = 0
 	}
 
 	err = submitSolution(workerAddress, t.TaskId, t.ThreadId, cid, int64(duration))
 	if err != nil {
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, false)
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error submitting solution. %s", err.Error()), time.Now().Unix(), 2)
+		revertThread(database, t.ThreadId, db.ThreadSubmitting, db.ThreadRevealed)
+		database.AddLogEntry(t.ThreadId, fmt.Sprintf("Error submitting solution. %s", err.Error()), time.Now().Unix(), 2)
 		return err
 	}
 
-	db.AddLogEntry(t.ThreadId, "Solution uploaded to IPFS correctly.", time.Now().Unix(), 0)
+	if err := database.TransitionThread(t.ThreadId, db.ThreadSubmitting, db.ThreadSubmitted); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
+	database.AddLogEntry(t.ThreadId, "Solution uploaded to IPFS correctly.", time.Now().Unix(), 0)
 	return nil
 }
 
@@ -286,7 +388,7 @@ func submitSolution(address, taskId, threadId string, cid string, duration int64
 	args = append(args, strconv.FormatInt(duration, 10))
 
 	// Append flags
-	args = append(args, "--yes", "--from", address)
+	args = append(args, "--yes", "--from", signerOf(address))
 
 	err := ExecuteCli(args)
 	if err != nil {
@@ -312,10 +414,10 @@ func (t *AudioStemThread) GetValidatorReward(worker string, totalReward types.Co
 	for _, validation := range t.Validations {
 		if validation.Validator == worker {
 			amount := calculateValidatorPayment(int(len(validation.Stems)), totalFiles, totalReward.Amount)
-			return types.NewCoin("jct", amount)
+			return types.NewCoin(totalReward.Denom, amount)
 		}
 	}
-	return types.NewCoin("jct", math.NewInt(0))
+	return types.NewCoin(totalReward.Denom, math.NewInt(0))
 }
 
 // Calculate the validator's reward proportionally using sdkmath.Int
@@ -329,55 +431,321 @@ func calculateValidatorPayment(filesValidated, totalFilesValidated int, totalVal
 }
 
 // Once validations are ready, we show blockchain the solution
-func (t *AudioStemThread) RevealSolution(rootPath string, db *db.DB) error {
+func (t *AudioStemThread) RevealSolution(rootPath string, database db.Database) error {
+	localThread, err := database.ReadThread(t.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to read thread %s, err: %s", t.ThreadId, err.Error())
+		return err
+	}
+	from := localThread.State
+	if from != db.ThreadVerified && from != db.ThreadProposed {
+		audioStemLogger.Logger.Debug("Thread %s is %s, skipping reveal", t.ThreadId, from)
+		return nil
+	}
+	if err := database.TransitionThread(t.ThreadId, from, db.ThreadRevealing); err != nil {
+		audioStemLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+		return err
+	}
+
 	output := path.Join(rootPath, "audioStems", t.ThreadId, "htdemucs", t.Cid)
-	cids, err := ipfs.CalculateCIDs(output)
+	solution, err := revealStems(output)
+	if err == nil {
+		solution, err = ProveStems(solution)
+	}
 	if err != nil {
 		audioStemLogger.Logger.Error(err.Error())
+		revertThread(database, t.ThreadId, db.ThreadRevealing, from)
 		return err
 	}
+	salt, err := database.ReadSalt(t.ThreadId)
+	if err != nil || salt == "" {
+		audioStemLogger.Logger.Error("No salt for the commitments of thread %s", t.ThreadId)
+		revertThread(database, t.ThreadId, db.ThreadRevealing, from)
+		return fmt.Errorf("no salt for the commitments of thread %s", t.ThreadId)
+	}
 
-	solution := make(map[string]AudioStemThread_Stem)
-	for filename, cid := range cids {
-		path := filepath.Join(output, filename)
-		hash, err := CalculateFileHash(path)
-		audioStemLogger.Logger.Debug("Revealing solution. Path: %s, filename: %s, cid: %s, hash:%s", path, filename, cid, hash)
-
-		if err != nil {
-			audioStemLogger.Logger.Error(err.Error())
-			return err
-		}
-
-		frame := AudioStemThread_Stem{Filename: filename, Cid: cid, Hash: hash}
-		solution[filename] = frame
+	reveals, err := WriteReveals(path.Join(rootPath, "audioStems", t.ThreadId, "reveals"), FromFramesToReveals(solution))
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to write the reveals of thread %s, err: %s", t.ThreadId, err.Error())
+		revertThread(database, t.ThreadId, db.ThreadRevealing, from)
+		return err
 	}
 
 	// Base arguments
 	args := []string{
 		"tx", "audioStem", "reveal-solution",
-		t.TaskId, t.ThreadId,
+		t.TaskId, t.ThreadId, salt,
 	}
-	args = append(args, FromFramesToCli(solution)...)
+	args = append(args, reveals...)
 	args = append(args, "--from")
-	args = append(args, t.Solution.ProposedBy)
+	args = append(args, signerOf(t.Solution.ProposedBy))
 	args = append(args, "--yes")
 	audioStemLogger.Logger.Debug("Revealing solution. args: %s", args)
 	err = ExecuteCli(args)
 
 	if err != nil {
+		revertThread(database, t.ThreadId, db.ThreadRevealing, from)
 		return err
 	}
-	err = db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, false)
+	return database.TransitionThread(t.ThreadId, db.ThreadRevealing, db.ThreadRevealed)
+}
+
+// RevealValidation reveals the stems this worker committed to when it validated the thread.
+func (t *AudioStemThread) RevealValidation(workerAddress, rootPath string, database db.Database) error {
+	salt, err := database.ReadSalt(t.ThreadId)
 	if err != nil {
 		return err
 	}
+	if salt == "" {
+		return fmt.Errorf("no salt for the commitments of thread %s", t.ThreadId)
+	}
+
+	output := path.Join(rootPath, "audioStems", t.ThreadId, "htdemucs", t.Cid)
+	stems, err := revealStems(output)
+	if err == nil {
+		stems, err = ProveStems(stems)
+	}
+	if err != nil {
+		audioStemLogger.Logger.Error(err.Error())
+		return err
+	}
+
+	reveals, err := WriteReveals(path.Join(rootPath, "audioStems", t.ThreadId, "reveals"), FromFramesToReveals(stems))
+	if err != nil {
+		audioStemLogger.Logger.Error("Unable to write the reveals of thread %s, err: %s", t.ThreadId, err.Error())
+		return err
+	}
+
+	args := []string{
+		"tx", "audioStem", "reveal-validation",
+		t.TaskId, t.ThreadId, salt,
+	}
+	args = append(args, reveals...)
+	args = append(args, "--yes", "--from", signerOf(workerAddress))
+	if err := ExecuteCli(args); err != nil {
+		return err
+	}
+	database.AddLogEntry(t.ThreadId, "Validation revealed", time.Now().Unix(), 0)
 	return nil
 }
 
-// Evaluates if the verifications sent are valid
-func (t *AudioStemThread) EvaluateVerifications() error {
+// commitStems commits to the merkle root of the hashes and fingerprints of the stems in the
+// directory, using the salt of the thread, and signs the commitment.
+func commitStems(codec codec.Codec, keys audioStemCrypto.KeyLocation, alias, workerAddress, output, threadId string, database db.Database) (commitment, signature string, err error) {
+	salt, err := database.ReadSalt(threadId)
+	if err != nil {
+		return "", "", err
+	}
+	if salt == "" {
+		if salt, err = audioStemCrypto.GenerateSalt(); err != nil {
+			return "", "", err
+		}
+		// the salt must survive restarts, or we won't be able to reveal
+		if err := database.SetSalt(threadId, salt); err != nil {
+			return "", "", err
+		}
+	}
+
+	stems, err := revealStems(output)
+	if err != nil {
+		return "", "", err
+	}
+	root, err := StemsRoot(stems)
+	if err != nil {
+		return "", "", err
+	}
+
+	commitment = audioStemCrypto.GenerateCommitment(root, salt, workerAddress)
+	message, err := audioStemCrypto.GenerateSignableMessage(commitment, workerAddress)
+	if err != nil {
+		return "", "", err
+	}
+	sig, _, err := audioStemCrypto.SignMessage(keys, alias, message, codec)
+	if err != nil {
+		return "", "", err
+	}
+	return commitment, audioStemCrypto.EncodeSignatureForCLI(sig), nil
+}
+
+// revealStems returns the CID, hash and fingerprint of every stem in the directory.
+func revealStems(output string) (map[string]AudioStemThread_Stem, error) {
+	cids, err := ipfs.CalculateCIDs(output)
+	if err != nil {
+		return nil, err
+	}
+
+	stems := make(map[string]AudioStemThread_Stem)
+	for filename, cid := range cids {
+		path := filepath.Join(output, filename)
+		hash, err := CalculateFileHash(path)
+		if err != nil {
+			return nil, err
+		}
+		fp, err := fingerprint.File(path)
+		if err != nil {
+			return nil, err
+		}
+		audioStemLogger.Logger.Debug("Stem %s, cid: %s, hash: %s", path, cid, hash)
+		stems[filename] = AudioStemThread_Stem{Filename: filename, Cid: cid, Hash: hash, Fingerprint: fp.Encode()}
+	}
+	return stems, nil
+}
+
+// CommittedValue is what a worker commits to for a stem: its hash and its fingerprint.
+func (s *AudioStemThread_Stem) CommittedValue() string {
+	return s.Hash + ":" + s.Fingerprint
+}
+
+func (s *AudioStemThread_Stem) merkleEntry() merkle.Entry {
+	return merkle.Entry{Key: s.Filename, Value: s.CommittedValue()}
+}
+
+// StemsRoot returns, in hex, the merkle root of the stems sorted by filename. Workers
+// commit to this root instead of to each stem.
+func StemsRoot(stems map[string]AudioStemThread_Stem) (string, error) {
+	tree, err := stemsTree(stems)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(tree.Root()), nil
+}
+
+// ProveStems returns the stems, each with the proof of its inclusion in the root returned
+// by StemsRoot.
+func ProveStems(stems map[string]AudioStemThread_Stem) (map[string]AudioStemThread_Stem, error) {
+	tree, err := stemsTree(stems)
+	if err != nil {
+		return nil, err
+	}
+
+	proved := make(map[string]AudioStemThread_Stem)
+	for filename, stem := range stems {
+		proof, err := tree.Proof(filename)
+		if err != nil {
+			return nil, err
+		}
+		stem.Proof = proof.Encode()
+		proved[filename] = stem
+	}
+	return proved, nil
+}
+
+func stemsTree(stems map[string]AudioStemThread_Stem) (*merkle.Tree, error) {
+	var entries []merkle.Entry
+	for _, stem := range stems {
+		entries = append(entries, stem.merkleEntry())
+	}
+	return merkle.New(entries)
+}
+
+// VerifyStems checks that the revealed stems belong to the merkle root hidden in the commitment
+// of the worker. Any subset of the committed stems can be revealed, and the amount of stems
+// committed to is returned, so callers can require all of them.
+func VerifyStems(stems []*AudioStemThread_Stem, commitment, salt, workerAddr string) (int, error) {
+	if commitment == "" || salt == "" {
+		return 0, errors.New("stems are not committed and revealed")
+	}
+	if len(stems) == 0 {
+		return 0, errors.New("no stems revealed")
+	}
+
+	var root []byte
+	total := 0
+	filenames := make(map[string]bool)
+	indexes := make(map[int]bool)
+	for _, stem := range stems {
+		proof, err := merkle.DecodeProof(stem.Proof)
+		if err != nil {
+			return 0, fmt.Errorf("stem %s: %w", stem.Filename, err)
+		}
+		stemRoot, err := proof.Root(stem.merkleEntry())
+		if err != nil {
+			return 0, fmt.Errorf("stem %s: %w", stem.Filename, err)
+		}
+		if root == nil {
+			root, total = stemRoot, proof.Total
+		} else if !bytes.Equal(root, stemRoot) || total != proof.Total {
+			return 0, fmt.Errorf("stem %s doesn't belong to the same root", stem.Filename)
+		}
+		if filenames[stem.Filename] || indexes[proof.Index] {
+			return 0, fmt.Errorf("stem %s is revealed twice", stem.Filename)
+		}
+		filenames[stem.Filename], indexes[proof.Index] = true, true
+	}
+
+	if audioStemCrypto.GenerateCommitment(hex.EncodeToString(root), salt, workerAddr) != commitment {
+		return 0, errors.New("stems don't match their commitment")
+	}
+	return total, nil
+}
+
+// VerifyCommitmentSignature checks that the worker signed its commitment with the public key.
+func VerifyCommitmentSignature(publicKey, signature, commitment, workerAddr string) error {
+	pk, err := audioStemCrypto.DecodePublicKeyFromCLI(publicKey)
+	if err != nil {
+		return err
+	}
+	sig, err := audioStemCrypto.DecodeSignatureFromCLI(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	message, err := audioStemCrypto.GenerateSignableMessage(commitment, workerAddr)
+	if err != nil {
+		return err
+	}
+	if !audioStemCrypto.VerifyMessage(pk, message, sig) {
+		return errors.New("commitment signature is not valid")
+	}
+	return nil
+}
+
+// IsRevealed tells if the proposer and every validator revealed their commitments.
+func (t *AudioStemThread) IsRevealed() bool {
+	if t.Solution == nil || t.Solution.Salt == "" {
+		return false
+	}
+	for _, validation := range t.Validations {
+		if validation.Salt == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluates if the verifications sent are valid. Every worker committed to the merkle root of
+// the hashes and fingerprints of its stems and signed the commitment. Once revealed, the
+// fingerprint of each validator must be at least minSimilarity (between 0 and 1) similar to
+// the solution. Validations that were never revealed don't count.
+func (t *AudioStemThread) EvaluateVerifications(minSimilarity float64) error {
+	if t.Solution == nil || t.Solution.Salt == "" {
+		return errors.New("solution is not revealed")
+	}
+
+	// we evaluate from scratch, so evaluating again doesn't count validations twice
 	for _, frame := range t.Solution.Stems {
-		for _, validation := range t.Validations {
+		frame.ValidCount = 0
+		frame.InvalidCount = 0
+	}
+
+	if err := verifyCommitted(t.Solution.Stems, t.Solution.PublicKey, t.Solution.Signature, t.Solution.Commitment, t.Solution.Salt, t.Solution.ProposedBy); err != nil {
+		audioStemLogger.Logger.Debug("Solution of thread %s is not valid: %s", t.ThreadId, err.Error())
+		for _, frame := range t.Solution.Stems {
+			frame.InvalidCount = int64(len(t.Validations))
+		}
+		return nil
+	}
+
+	for _, validation := range t.Validations {
+		if validation.Salt == "" {
+			audioStemLogger.Logger.Debug("Validation of validator %s was not revealed", validation.Validator)
+			continue
+		}
+		commitmentErr := verifyCommitted(validation.Stems, validation.PublicKey, validation.Signature, validation.Commitment, validation.Salt, validation.Validator)
+		if commitmentErr != nil {
+			audioStemLogger.Logger.Debug("Validation of validator %s is NOT VALID: %s", validation.Validator, commitmentErr.Error())
+		}
+
+		for _, frame := range t.Solution.Stems {
 			idx := slices.IndexFunc(validation.Stems, func(f *AudioStemThread_Stem) bool { return f.Filename == frame.Filename })
 
 			if idx < 0 {
@@ -385,70 +753,57 @@ func (t *AudioStemThread) EvaluateVerifications() error {
 				audioStemLogger.Logger.Debug("Solution Frame %s, not found at validation of validator %s ", frame.Filename, validation.Validator)
 				continue
 			}
+			stem := validation.Stems[idx]
 
-			pk, err := audioStemCrypto.DecodePublicKeyFromCLI(validation.PublicKey)
-			if err != nil {
-				audioStemLogger.Logger.Error("unable to get public key from cli: %s", err.Error())
-				return err
-			}
-
-			message, err := audioStemCrypto.GenerateSignableMessage(frame.Hash, validation.Validator)
-			if err != nil {
-				audioStemLogger.Logger.Error("unable to recreate original message %sto verify: %s", message, err.Error())
-				return err
-			}
-			sig, err := audioStemCrypto.DecodeSignatureFromCLI(validation.Stems[idx].Signature)
-			if err != nil {
-				audioStemLogger.Logger.Error("unable to decode signature: %s", err.Error())
-				return err
+			if commitmentErr != nil {
+				frame.InvalidCount++
+				continue
 			}
 
-			valid := pk.VerifySignature(message, sig)
-
-			if valid {
-				// verification passed
-				frame.ValidCount++
+			if frame.Fingerprint == "" && stem.Fingerprint == "" {
+				// without fingerprints, only the exact same stem is valid
+				if stem.Hash != frame.Hash {
+					frame.InvalidCount++
+					continue
+				}
 			} else {
-				audioStemLogger.Logger.Debug("Verification for frame %s from pk %s NOT VALID!\nMessage: Hash: %s, address: %s\npublicKey:%s\nsignature:%s", validation.Stems[idx].Filename, validation.Validator, frame.Hash, validation.Validator, validation.PublicKey, validation.Stems[idx].Signature)
-				audioStemLogger.Logger.Debug("Message is %s", message)
-				// frame.InvalidCount++
-				// TODO change
-				frame.ValidCount++
+				similarity, err := stemSimilarity(frame.Fingerprint, stem.Fingerprint)
+				if err != nil || similarity < minSimilarity {
+					audioStemLogger.Logger.Debug("Frame %s from validator %s is not similar to the solution: %v", stem.Filename, validation.Validator, similarity)
+					frame.InvalidCount++
+					continue
+				}
 			}
+			// verification passed
+			frame.ValidCount++
 		}
 
 	}
 	return nil
 }
 
-// for those frames evaluated, if we have at least one that has more
-// invalid counts than valid ones, we rejected. Otherwise is accepted
-func (t *AudioStemThread) IsSolutionAccepted() bool {
-	validFrameCount := 0
-
-	minValidValidations := 2
-	if len(t.Workers) == 1 {
-		minValidValidations = 1
+// verifyCommitted checks that the worker signed its commitment and that the stems belong to it.
+func verifyCommitted(stems []*AudioStemThread_Stem, publicKey, signature, commitment, salt, workerAddr string) error {
+	if err := VerifyCommitmentSignature(publicKey, signature, commitment, workerAddr); err != nil {
+		return err
 	}
+	_, err := VerifyStems(stems, commitment, salt, workerAddr)
+	return err
+}
 
-	totalFrames := len(t.Solution.Stems)
-	if totalFrames == 0 {
-		return false // no frames to evaluate
+func stemSimilarity(solution, validation string) (float64, error) {
+	if solution == "" {
+		return 0, errors.New("solution has no fingerprint revealed")
 	}
-
-	for _, frame := range t.Solution.Stems {
-		if int(frame.ValidCount) >= minValidValidations {
-			validFrameCount++
-		}
+	a, err := fingerprint.Decode(solution)
+	if err != nil {
+		return 0, err
 	}
-
-	// Require at least 20% of frames to be valid
-	required := int(float64(totalFrames) * 0.2)
-	if required == 0 && totalFrames > 0 {
-		required = 1 // always require at least 1 if there are frames
+	b, err := fingerprint.Decode(validation)
+	if err != nil {
+		return 0, err
 	}
-
-	return validFrameCount >= required
+	return fingerprint.Similarity(a, b), nil
 }
 
 // validates the IPFS dir contains all files in the solution
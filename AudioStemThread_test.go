@@ -2,1355 +2,656 @@ package audioStem
 
 import (
 	"context"
-	fmt "fmt"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
-	"time"
 
-	"bou.ke/monkey"
 	sdkmath "cosmossdk.io/math"
-	"github.com/cosmos/cosmos-sdk/codec"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
-	secp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	c_types "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/stretchr/testify/require"
+
 	audioStemCrypto "github.com/janction/audioStem/crypto"
 	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/fingerprint"
 	"github.com/janction/audioStem/ipfs"
 	"github.com/janction/audioStem/mocks"
-	"github.com/janction/audioStem/vm"
-	"github.com/stretchr/testify/mock"
-
-	"github.com/stretchr/testify/require"
 )
 
-// --- Test for StartWork ---
-func TestStartWork_ContainerRunning(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
+var testStems = []string{"vocals.wav", "drums.wav", "bass.wav", "other.wav"}
 
-	// Mock DB methods
-	mockDB.On("UpdateThread", "thread123", false, false, true, false, false, false, false, false).Return(nil).Once()
+var (
+	stemmed  = []db.ThreadState{db.ThreadDownloading, db.ThreadDownloaded, db.ThreadStemming, db.ThreadStemmed}
+	proposed = slices.Concat(stemmed, []db.ThreadState{db.ThreadProposing, db.ThreadProposed})
+	verified = slices.Concat(stemmed, []db.ThreadState{db.ThreadVerifying, db.ThreadVerified})
+	revealed = slices.Concat(proposed, []db.ThreadState{db.ThreadRevealing, db.ThreadRevealed})
+)
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+// fakeCli puts a janctiond that records its arguments before running its script, and a docker
+// running its own script, first in the path. It returns the file with the arguments of every
+// janctiond call.
+func fakeCli(t *testing.T, janctiond, docker string) string {
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "janctiond"), []byte("#!/bin/sh\necho \"$@\" >> "+calls+"\n"+janctiond+"\n"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docker"), []byte("#!/bin/sh\n"+docker+"\n"), 0o755))
+	t.Setenv("PATH", dir)
+	return calls
+}
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return true // Simulate that the container is running
-	})
-	defer patch1.Unpatch()
+// cliCalls returns the arguments of the janctiond calls, a call per line.
+func cliCalls(t *testing.T, calls string) []string {
+	content, err := os.ReadFile(calls)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(content)), "\n")
+}
 
-	// Prepare test context and input values
-	cid := "fakeCID"
-	path := t.TempDir()
-	ctx := context.Background()
+func useFakeIPFS(t *testing.T) *ipfs.Fake {
+	node := ipfs.NewFake()
+	previous := ipfs.DefaultClient()
+	ipfs.SetClient(node)
+	t.Cleanup(func() { ipfs.SetClient(previous) })
+	return node
+}
 
-	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+// newTestKeys returns a test keyring holding the key of alice, and the address of alice.
+func newTestKeys(t *testing.T) (audioStemCrypto.KeyLocation, string) {
+	keys := audioStemCrypto.KeyLocation{Backend: keyring.BackendTest, Dir: t.TempDir()}
+	kr, err := keyring.New("janction", keys.Backend, keys.Dir, nil, moduletestutil.MakeTestEncodingConfig().Codec)
 	require.NoError(t, err)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, c_types.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	return keys, address.String()
+}
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+// writeWav writes a second of a tone to path.
+func writeWav(t *testing.T, path string, tone int) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	samples := make([]int, 8000)
+	for i := range samples {
+		samples[i] = int(8000 * math.Sin(float64(i*tone)/20))
+	}
+	encoder := wav.NewEncoder(file, 8000, 16, 1, 1)
+	buf := &audio.IntBuffer{Format: &audio.Format{NumChannels: 1, SampleRate: 8000}, Data: samples, SourceBitDepth: 16}
+	require.NoError(t, encoder.Write(buf))
+	require.NoError(t, encoder.Close())
 }
 
-func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+// writeStems writes the stems in dir, each of them a different tone.
+func writeStems(t *testing.T, dir string, names ...string) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for i, name := range names {
+		writeWav(t, filepath.Join(dir, name), i+1)
 	}
+}
 
-	// Mock DB methods
-	mockDB.On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+// stemsDir returns the directory with the stems of the thread under the root path.
+func stemsDir(rootPath string, thread AudioStemThread) string {
+	return filepath.Join(rootPath, "audioStems", thread.ThreadId, "htdemucs", thread.Cid)
+}
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+// newLocalThread returns a database with the local thread 1-0 moved through the states.
+func newLocalThread(t *testing.T, states ...db.ThreadState) *db.Memory {
+	database := db.NewMemory()
+	require.NoError(t, database.AddThread("1-0"))
+	from := db.ThreadIdle
+	for _, state := range states {
+		require.NoError(t, database.TransitionThread("1-0", from, state))
+		from = state
+	}
+	return database
+}
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
+func requireState(t *testing.T, database db.Database, state db.ThreadState) {
+	local, err := database.ReadThread("1-0")
+	require.NoError(t, err)
+	require.Equal(t, state, local.State)
+}
 
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
-		return nil // Simulate successful removal of container
-	})
-	defer patch2.Unpatch()
+// --- Test for StartWork ---
+func TestStartWork_ContainerRunning(t *testing.T) {
+	// Setup
+	calls := fakeCli(t, "", "echo janctionstem1-0")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
-	patch3 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {
-		// no-op, simulate IPFS is not running
-	})
-	defer patch3.Unpatch()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), ipfs.DownloadOptions{}, database)
 
-	patch4 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
-		// Simulate IPFS file is not available
-		return fmt.Errorf("IPFS file is not available")
-	})
-	defer patch4.Unpatch()
+	// the work is already going, so nothing changes
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadIdle)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	// Prepare test context and input values
-	cid := "fakeCID"
-	path := t.TempDir()
-	ctx := context.Background()
+func TestStartWork_ReadThreadKo(t *testing.T) {
+	// Setup
+	fakeCli(t, "", "")
+	mockDB := new(mocks.DB)
+	mockDB.On("ReadThread", "1-0").Return(nil, errors.New("thread not found")).Once()
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
-
-	// Verify that we got the expected error (IPFS file not available)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "IPFS file is not available")
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), ipfs.DownloadOptions{}, mockDB)
 
-	// Verify mock expectations
+	// Verify
+	require.ErrorContains(t, err, "thread not found")
 	mockDB.AssertExpectations(t)
 }
 
-func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoKo(t *testing.T) {
+func TestStartWork_ContainerNotRunning_IPFSGetKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == false &&
-				args.Bool(4) == false &&
-				args.Bool(5) == false &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Times(4)
+	useFakeIPFS(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Execute method under test, the file isn't in IPFS
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), ipfs.DownloadOptions{}, database)
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
+	// the download starts over next time
+	require.Error(t, err)
+	requireState(t, database, db.ThreadIdle)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
-		return nil // Simulate successful removal of container
-	})
-	defer patch2.Unpatch()
+func TestStartWork_ContainerNotRunning_IPFSGetOk_InvalidInput(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	cid := node.AddFile([]byte("this is not audio"))
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
-		// no-op, simulate video rendering
-	})
-	defer patch3.Unpatch()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", cid, t.TempDir(), ipfs.DownloadOptions{}, database)
 
-	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {
-		// no-op, simulate IPFS is running
-	})
-	defer patch4.Unpatch()
+	// the input is reported and the worker is done with the thread
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadCompleted)
+	sent := cliCalls(t, calls)
+	require.Len(t, sent, 1)
+	require.True(t, strings.HasPrefix(sent[0], "tx audioStem report-invalid-input 1 1-0 "))
+}
 
-	patch5 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
-		// Simulate IPFS file is available
-		return nil
-	})
-	defer patch5.Unpatch()
+func TestStartWork_ContainerNotRunning_IPFSGetOk_InvalidInput_ReportKo(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	cid := node.AddFile([]byte("this is not audio"))
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
-	patch6 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return nil, fmt.Errorf("Video rendering error") // Simulate video rendering failure
-	})
-	defer patch6.Unpatch()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", cid, t.TempDir(), ipfs.DownloadOptions{}, database)
 
-	// Prepare test context and input values
-	cid := "fakeCID"
-	path := t.TempDir()
-	ctx := context.Background()
+	// the report is sent again next time
+	require.Error(t, err)
+	requireState(t, database, db.ThreadIdle)
+}
+
+func TestStartWork_ContainerNotRunning_IPFSGetOk_InputTooLarge(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	cid := node.AddFile(make([]byte, 100))
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(context.Background(), "worker", cid, t.TempDir(), ipfs.DownloadOptions{MaxSize: 99}, database)
 
-	// Verify that we got the expected thread status (video rendering error)
+	// the input is over the limit of this node, which leaves the thread instead of reporting it
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (video rendering error)")
+	sent := cliCalls(t, calls)
+	require.Len(t, sent, 1)
+	require.True(t, strings.HasPrefix(sent[0], "tx audioStem leave-thread 1 1-0 "))
+	requireState(t, database, db.ThreadCompleted)
+}
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+// addInput adds a wav input to the node and returns its CID.
+func addInput(t *testing.T, node *ipfs.Fake) string {
+	path := filepath.Join(t.TempDir(), "input.wav")
+	writeWav(t, path, 1)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return node.AddFile(content)
 }
 
-func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_FilesKo(t *testing.T) {
+func TestStartWork_ContainerNotRunning_IPFSGetOk_StemAudioKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	file := mocks.MockFileInfo{
-		Filename: "video1.mp4",
-		Filesize: 2048,
-		Filemode: 0644,
-		ModTime_: time.Date(2025, 4, 11, 10, 0, 0, 0, time.UTC),
-		IsDir_:   false,
-	}
-	files := []os.DirEntry{
-		mocks.MockDirEntry{Filename: "video1.mp4", IsDir_: false},
-	}
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == false &&
-				args.Bool(4) == false &&
-				args.Bool(5) == false &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Times(4)
+	node := useFakeIPFS(t)
+	cid := addInput(t, node)
+	fakeCli(t, "", "exit 1")
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
+	path := t.TempDir()
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", cid, path, ipfs.DownloadOptions{}, database)
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
+	// the input is kept and the stemming starts over next time
+	require.ErrorContains(t, err, "no stems found")
+	requireState(t, database, db.ThreadDownloaded)
+	require.FileExists(t, filepath.Join(path, cid))
+}
 
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
-		return nil // Simulate successful removal of container
-	})
-	defer patch2.Unpatch()
+func TestStartWork_ContainerNotRunning_IPFSGetOk_StemAudioOk_FilesKo(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	cid := addInput(t, node)
+	path := t.TempDir()
+	fakeCli(t, "", `if [ "$1" = run ]; then /bin/mkdir -p `+path+`/htdemucs/a `+path+`/htdemucs/b; fi`)
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
-		// no-op, simulate video rendering
-	})
-	defer patch3.Unpatch()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", cid, path, ipfs.DownloadOptions{}, database)
 
-	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {
-		// no-op, simulate IPFS is running
-	})
-	defer patch4.Unpatch()
+	// Verify
+	require.ErrorContains(t, err, "expected 1 stem directory")
+	requireState(t, database, db.ThreadDownloaded)
+}
 
-	patch5 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
-		// Simulate IPFS file is available
-		return nil
-	})
-	defer patch5.Unpatch()
+func TestStartWork_ContainerNotRunning_IPFSGetOk_StemAudioOk_FilesOk(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	cid := addInput(t, node)
+	path := t.TempDir()
+	docker := filepath.Join(t.TempDir(), "docker")
+	fakeCli(t, "", `echo "$@" >> `+docker+`
+if [ "$1" = run ]; then /bin/mkdir -p `+path+`/htdemucs/input; fi`)
+	database := newLocalThread(t)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Instrument: "vocals"}
 
-	patch6 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return file, nil
-	})
-	defer patch6.Unpatch()
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", cid, path, ipfs.DownloadOptions{}, database)
 
-	patch7 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return files, nil
-	})
-	defer patch7.Unpatch()
+	// Verify
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	run, err := os.ReadFile(docker)
+	require.NoError(t, err)
+	require.Contains(t, string(run), "run --rm --name janctionstem1-0 -v "+path+":/data/input")
+	require.Contains(t, string(run), "/data/input/"+cid)
+}
 
-	// Prepare test context and input values
-	cid := "fakeCID"
+func TestStartWork_Stemming_StartsOver(t *testing.T) {
+	// Setup
 	path := t.TempDir()
-	ctx := context.Background()
+	fakeCli(t, "", `if [ "$1" = run ]; then /bin/mkdir -p `+path+`/htdemucs/input; fi`)
+	// the node restarted while stemming, with the input already downloaded
+	database := newLocalThread(t, db.ThreadDownloading, db.ThreadDownloaded, db.ThreadStemming)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", path, ipfs.DownloadOptions{}, database)
 
-	// Verify that we got the expected thread status (incorrect amount of files)
+	// Verify
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	transitions, err := database.ReadThreadTransitions("1-0")
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (incorrect amount of files)")
+	require.Equal(t, db.ThreadDownloaded, transitions[len(transitions)-2].From)
+}
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+func TestStartWork_AlreadyStemmed(t *testing.T) {
+	// Setup
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0"}
+
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), ipfs.DownloadOptions{}, database)
+
+	// there is no work to start
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_FilesOk(t *testing.T) {
+// --- Test for ProposeSolution ---
+func TestProposeSolution_NotStemmed(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	file := mocks.MockFileInfo{
-		Filename: "video1.mp4",
-		Filesize: 2048,
-		Filemode: 0644,
-		ModTime_: time.Date(2025, 4, 11, 10, 0, 0, 0, time.UTC),
-		IsDir_:   false,
-	}
-	files := []os.DirEntry{
-		mocks.MockDirEntry{Filename: "video1.mp4", IsDir_: false},
-		mocks.MockDirEntry{Filename: "video2.mp4", IsDir_: false},
-	}
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == true &&
-				args.Bool(4) == true &&
-				args.Bool(5) == false &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Times(4)
+	keys, address := newTestKeys(t)
+	database := newLocalThread(t, db.ThreadDownloading)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Execute method under test
+	err := thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, t.TempDir(), database)
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
+	// Verify
+	require.ErrorIs(t, err, db.ErrStateMismatch)
+	requireState(t, database, db.ThreadDownloading)
+}
 
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
-		return nil // Simulate successful removal of container
-	})
-	defer patch2.Unpatch()
+func TestProposeSolution_ExtractPublicKeyKo(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
-		// no-op, simulate video rendering
-	})
-	defer patch3.Unpatch()
+	// Execute method under test, with an alias that isn't in the keyring
+	err := thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "bob", address, rootPath, database)
 
-	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {
-		// no-op, simulate IPFS is running
-	})
-	defer patch4.Unpatch()
+	// Verify
+	require.Error(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	patch5 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
-		// Simulate IPFS file is available
-		return nil
-	})
-	defer patch5.Unpatch()
+func TestProposeSolution_ExtractPublicKeyOk_CommitStemsKo(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	patch6 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return file, nil
-	})
-	defer patch6.Unpatch()
+	// Execute method under test, without stems to commit to
+	err := thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, t.TempDir(), database)
 
-	patch7 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return files, nil
-	})
-	defer patch7.Unpatch()
+	// Verify
+	require.Error(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	// Prepare test context and input values
-	cid := "fakeCID"
-	path := t.TempDir()
-	ctx := context.Background()
+func TestProposeSolution_ExtractPublicKeyOk_CommitStemsOk_ExecuteCliKo(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, database)
 
-	// Verify that we got the expected thread status (video rendering error)
+	// the solution is proposed again next time, with the same salt
+	require.Error(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	salt, err := database.ReadSalt("1-0")
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (no error)")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	require.NotEmpty(t, salt)
 }
 
-// --- Test for ProposeSolution ---
-func TestProposeSolution_FrameAmountKo(t *testing.T) {
+func TestProposeSolution_ExtractPublicKeyOk_CommitStemsOk_ExecuteCliOk(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == true &&
-				args.Bool(4) == true &&
-				args.Bool(5) == false &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	// Execute method under test
+	err := thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, database)
 
-	// Verify that we got the expected thread status (frame amount error)
+	// Verify
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (frame amount error)")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	requireState(t, database, db.ThreadProposed)
+	sent := cliCalls(t, calls)
+	require.Len(t, sent, 1)
+	args := strings.Fields(sent[0])
+	require.Equal(t, []string{"tx", "audioStem", "propose-solution", "1", "1-0"}, args[:5])
+	publicKey, commitment, signature := args[5], args[6], args[7]
+	require.NoError(t, VerifyCommitmentSignature(publicKey, signature, commitment, address))
+
+	// the commitment hides the root of the stems with the salt of the thread
+	stems, err := revealStems(stemsDir(rootPath, thread))
+	require.NoError(t, err)
+	root, err := StemsRoot(stems)
+	require.NoError(t, err)
+	salt, err := database.ReadSalt("1-0")
+	require.NoError(t, err)
+	require.Equal(t, audioStemCrypto.GenerateCommitment(root, salt, address), commitment)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesKo(t *testing.T) {
+// --- Test for SubmitVerification ---
+func TestSubmitVerification_NotStemmed(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return nil, fmt.Errorf("Generate hash error")
-	})
-	defer patch2.Unpatch()
-
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, db.ThreadDownloading)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Generate hash error")
+	// Execute method under test
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, t.TempDir(), 100, database)
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// there is nothing to verify yet
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadDownloading)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyKo(t *testing.T) {
+func TestSubmitVerification_FileCountKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
-		}, nil
-	})
-	defer patch2.Unpatch()
+	// Execute method under test
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, t.TempDir(), 100, database)
 
-	patch3 := monkey.Patch(audioStemCrypto.ExtractPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Extracting public key error")
-	})
-	defer patch3.Unpatch()
+	// Verify
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+func TestSubmitVerification_FileCountOk_FileThresholdKo(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems[:2]...)
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Extracting public key error")
+	// Execute method under test, requiring every stem
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, 100, database)
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// Verify
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageKo(t *testing.T) {
+func TestSubmitVerification_FileCountOk_FileThresholdOk_GetPublicKeyKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
-		}, nil
-	})
-	defer patch2.Unpatch()
+	// Execute method under test, with an alias that isn't in the keyring
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "bob", address, rootPath, 100, database)
 
-	patch3 := monkey.Patch(audioStemCrypto.ExtractPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
+	// Verify
+	require.Error(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), fmt.Errorf("GenerateSignableMessage error")
-	})
-	defer patch4.Unpatch()
+func TestSubmitVerification_FileCountOk_FileThresholdOk_GetPublicKeyOk_SubmitValidationKo(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	// Execute method under test
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, 100, database)
 
-	// Verify that we got the expected error
+	// Verify
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "GenerateSignableMessage error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	requireState(t, database, db.ThreadStemmed)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageKo(t *testing.T) {
+func TestSubmitVerification_FileCountOk_FileThresholdOk_GetPublicKeyOk_SubmitValidationOk(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
-		}, nil
-	})
-	defer patch2.Unpatch()
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	// half of the stems are enough with a threshold of 50%
+	writeStems(t, stemsDir(rootPath, thread), testStems[:2]...)
 
-	patch3 := monkey.Patch(audioStemCrypto.ExtractPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
+	// Execute method under test
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, 50, database)
 
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
+	// Verify
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadVerified)
+	sent := cliCalls(t, calls)
+	require.Len(t, sent, 1)
+	args := strings.Fields(sent[0])
+	require.Equal(t, []string{"tx", "audioStem", "submit-validation", "1", "1-0"}, args[:5])
+	require.NoError(t, VerifyCommitmentSignature(args[5], args[7], args[6], address))
+}
 
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Signing message error")
-	})
-	defer patch5.Unpatch()
+func TestSubmitVerification_Proposed(t *testing.T) {
+	// Setup
+	keys, address := newTestKeys(t)
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t, proposed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	// Execute method under test
+	err := thread.SubmitVerification(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, 100, database)
 
-	// Verify that we got the expected error
+	// the proposer goes back to its proposed solution
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Signing message error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	requireState(t, database, db.ThreadProposed)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageOk_SolutionKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Once()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
+// --- Test for submitValidation ---
+func TestSubmitValidationKo(t *testing.T) {
+	fakeCli(t, "exit 1", "")
+	require.Error(t, submitValidation("validator", "1", "1-0", "publicKey", "commitment", "signature"))
+}
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
-		}, nil
-	})
-	defer patch2.Unpatch()
+func TestSubmitValidationOk(t *testing.T) {
+	calls := fakeCli(t, "", "")
+	require.NoError(t, submitValidation("validator", "1", "1-0", "publicKey", "commitment", "signature"))
+	require.Equal(t, []string{"tx audioStem submit-validation 1 1-0 publicKey commitment signature --from validator --yes --gas auto --gas-adjustment 1.3"}, cliCalls(t, calls))
+}
 
-	patch3 := monkey.Patch(audioStemCrypto.ExtractPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
+// --- Test for SubmitSolution ---
+func TestSubmitSolution_NotRevealed(t *testing.T) {
+	// Setup
+	useFakeIPFS(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, proposed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
+	// Execute method under test
+	err := thread.SubmitSolution(context.Background(), "worker1", t.TempDir(), database)
 
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
+	// Verify
+	require.ErrorIs(t, err, db.ErrStateMismatch)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return fmt.Errorf("Solution error")
-	})
-	defer patch6.Unpatch()
+func TestSubmitSolution_IpfsKo(t *testing.T) {
+	// Setup
+	useFakeIPFS(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, revealed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	// Execute method under test, without stems to upload
+	err := thread.SubmitSolution(context.Background(), "worker1", t.TempDir(), database)
 
-	// Verify that we got the expected error
+	// Verify
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Solution error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	requireState(t, database, db.ThreadRevealed)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageOk_SolutionOk(t *testing.T) {
+func TestSubmitSolution_IpfsOk_SubmitSolutionKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Once()
+	useFakeIPFS(t)
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t, revealed...)
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Execute method under test
+	err := thread.SubmitSolution(context.Background(), "worker1", rootPath, database)
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 2
-	})
-	defer patch1.Unpatch()
+	// Verify
+	require.Error(t, err)
+	requireState(t, database, db.ThreadRevealed)
+}
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
-		}, nil
-	})
-	defer patch2.Unpatch()
+func TestSubmitSolution_IpfsOk_SubmitSolutionOk(t *testing.T) {
+	// Setup
+	node := useFakeIPFS(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, revealed...)
+	require.NoError(t, database.AddRenderDuration("1-0", 0, 12))
+	thread := AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song"}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, thread), testStems...)
 
-	patch3 := monkey.Patch(audioStemCrypto.ExtractPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
+	// Execute method under test
+	err := thread.SubmitSolution(context.Background(), "worker1", rootPath, database)
 
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch6.Unpatch()
-
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got no error
-	require.NoError(t, err)
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-// --- Test for SubmitVerification ---
-func TestSubmitVerification_FileCountKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == true &&
-				args.Bool(4) == true &&
-				args.Bool(5) == true &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 0
-	})
-	defer patch1.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected thread status (file amount error)
-	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (file amount error)")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   10,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-	expected := false
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			// thread expected status validation
-			if args.Bool(1) == true &&
-				args.Bool(2) == true &&
-				args.Bool(3) == true &&
-				args.Bool(4) == true &&
-				args.Bool(5) == true &&
-				args.Bool(6) == false &&
-				args.Bool(7) == false &&
-				args.Bool(8) == false {
-				expected = true
-			}
-		}).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (file threshold error)")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return nil, fmt.Errorf("Generate hash error")
-	})
-	defer patch2.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Generate hash error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-		}, nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.GetPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Get public key error")
-	})
-	defer patch3.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Get public key error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-		}, nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.GetPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
-
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), fmt.Errorf("GenerateSignableMessage error")
-	})
-	defer patch4.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "GenerateSignableMessage error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-		}, nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.GetPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
-
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Signing message error")
-	})
-	defer patch5.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Signing message error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageOk_SubmitValidationKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-		}, nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.GetPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
-
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, signatures []string) error {
-		return fmt.Errorf("Submit validation error")
-	})
-	defer patch6.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Submit validation error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageOk_SubmitValidationOk(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Once()
-
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
-		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-		}, nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.GetPublicKey, func(rootDir, alias string, codec codec.Codec) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch3.Unpatch()
-
-	patch4 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(audioStemCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, signatures []string) error {
-		return nil
-	})
-	defer patch6.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got no error
-	require.NoError(t, err)
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-// --- Test for submitValidation ---
-func TestSubmitValidationKo(t *testing.T) {
-	// Setup
-	validator := "alice"
-	taskId := "task456"
-	threadId := "thread123"
-	publicKey := "alicePublicKey123"
-	signatures := []string{"sig1", "sig2"}
-
-	// Monkey patching
-	patch1 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return fmt.Errorf("ExecuteCLI error")
-	})
-	defer patch1.Unpatch()
-
-	err := submitValidation(validator, taskId, threadId, publicKey, signatures)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "ExecuteCLI error")
-}
-
-func TestSubmitValidationOk(t *testing.T) {
-	// Setup
-	validator := "alice"
-	taskId := "task456"
-	threadId := "thread123"
-	publicKey := "alicePublicKey123"
-	signatures := []string{"sig1", "sig2"}
-
-	// Monkey patching
-	patch1 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	err := submitValidation(validator, taskId, threadId, publicKey, signatures)
-
-	// Verify that we got no error
-	require.NoError(t, err)
-}
-
-// --- Test for SubmitSolution ---
-func TestSubmitSolution_IpfsKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	ctx := context.Background()
-	workerAddress := "cosmos1abcd1234workerxyz"
-	rootPath := "/tmp/rendering/thread123"
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.UploadSolution, func(ctx context.Context, rootPath, threadId string) (string, error) {
-		return "", fmt.Errorf("Ipfs upload solution error")
-	})
-	defer patch1.Unpatch()
-
-	err := thread.SubmitSolution(ctx, workerAddress, rootPath, mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Ipfs upload solution error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitSolution_IpfsOk_SubmitSolutionKo(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	ctx := context.Background()
-	workerAddress := "cosmos1abcd1234workerxyz"
-	rootPath := "/tmp/rendering/thread123"
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Twice()
-
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.UploadSolution, func(ctx context.Context, rootPath, threadId string) (string, error) {
-		return "bafybeibwzifn3f6ld5n3nqsh2gsyw5vcnrbdfzq3e6q3yhdh6kuz3w5xku", nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string) error {
-		return fmt.Errorf("submit solution error")
-	})
-	defer patch2.Unpatch()
-
-	err := thread.SubmitSolution(ctx, workerAddress, rootPath, mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "submit solution error")
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
-
-func TestSubmitSolution_IpfsOk_SubmitSolutionOk(t *testing.T) {
-	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	ctx := context.Background()
-	workerAddress := "cosmos1abcd1234workerxyz"
-	rootPath := "/tmp/rendering/thread123"
-
-	// Mock DB methods
-	mockDB.
-		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		Once()
-
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.UploadSolution, func(ctx context.Context, rootPath, threadId string) (string, error) {
-		return "bafybeibwzifn3f6ld5n3nqsh2gsyw5vcnrbdfzq3e6q3yhdh6kuz3w5xku", nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string) error {
-		return nil
-	})
-	defer patch2.Unpatch()
-
-	err := thread.SubmitSolution(ctx, workerAddress, rootPath, mockDB)
-
-	// Verify that we got no error
-	require.NoError(t, err)
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
-}
+	// the uploaded stems stay pinned until the storage of the thread is collected
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadSubmitted)
+	pins, err := database.ReadPins("1-0")
+	require.NoError(t, err)
+	require.Len(t, pins, 1)
+	require.True(t, node.Pinned(pins[0]))
+	require.Equal(t, []string{"tx audioStem submit-solution 1 1-0 " + pins[0] + " 12 --yes --from worker1 --gas auto --gas-adjustment 1.3"}, cliCalls(t, calls))
+}
 
 // --- Test for submitSolution ---
 func TestSubmitSolutionKo(t *testing.T) {
-	// Setup
-	address := "validator1-address"
-	taskId := "task-abc-123"
-	threadId := "thread-xyz-789"
-	cid := "bafybeigdyrztq2h7s4i5c4g4qkkl7urxkzv5n6x5k3qscgopn4mmybghpe"
-
-	// Monkey patching
-	patch1 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return fmt.Errorf("ExecuteCLI error")
-	})
-	defer patch1.Unpatch()
-
-	err := submitSolution(address, taskId, threadId, cid)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "ExecuteCLI error")
+	fakeCli(t, "exit 1", "")
+	require.Error(t, submitSolution("worker1", "1", "1-0", "dirCID", 12))
 }
 
 func TestSubmitSolutionOk(t *testing.T) {
-	// Setup
-	address := "validator1-address"
-	taskId := "task-abc-123"
-	threadId := "thread-xyz-789"
-	cid := "bafybeigdyrztq2h7s4i5c4g4qkkl7urxkzv5n6x5k3qscgopn4mmybghpe"
-
-	// Monkey patching
-	patch1 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	err := submitSolution(address, taskId, threadId, cid)
-
-	// Verify that we got no error
-	require.NoError(t, err)
+	calls := fakeCli(t, "", "")
+	require.NoError(t, submitSolution("worker1", "1", "1-0", "dirCID", 12))
+	require.Equal(t, []string{"tx audioStem submit-solution 1 1-0 dirCID 12 --yes --from worker1 --gas auto --gas-adjustment 1.3"}, cliCalls(t, calls))
 }
 
 // --- Test for IsReverse ---
@@ -1380,18 +681,18 @@ func TestGetValidatorReward(t *testing.T) {
 		Validations: []*AudioStemThread_Validation{
 			{
 				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{Filename: "frame_1.png", Signature: "sig_1", Cid: "cid_1", Hash: "hash_1", ValidCount: 1, InvalidCount: 0},
-					{Filename: "frame_2.png", Signature: "sig_2", Cid: "cid_2", Hash: "hash_2", ValidCount: 1, InvalidCount: 0},
+				Stems: []*AudioStemThread_Stem{
+					{Filename: "vocals.wav", Cid: "cid_1", Hash: "hash_1"},
+					{Filename: "drums.wav", Cid: "cid_2", Hash: "hash_2"},
 				},
 			},
 			{
 				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{Filename: "frame_3.png", Signature: "sig_3", Cid: "cid_3", Hash: "hash_3", ValidCount: 1, InvalidCount: 0},
-					{Filename: "frame_4.png", Signature: "sig_4", Cid: "cid_4", Hash: "hash_4", ValidCount: 1, InvalidCount: 0},
-					{Filename: "frame_5.png", Signature: "sig_5", Cid: "cid_5", Hash: "hash_5", ValidCount: 1, InvalidCount: 0},
-					{Filename: "frame_6.png", Signature: "sig_6", Cid: "cid_6", Hash: "hash_6", ValidCount: 1, InvalidCount: 0},
+				Stems: []*AudioStemThread_Stem{
+					{Filename: "vocals.wav", Cid: "cid_3", Hash: "hash_3"},
+					{Filename: "drums.wav", Cid: "cid_4", Hash: "hash_4"},
+					{Filename: "bass.wav", Cid: "cid_5", Hash: "hash_5"},
+					{Filename: "other.wav", Cid: "cid_6", Hash: "hash_6"},
 				},
 			},
 		},
@@ -1401,8 +702,8 @@ func TestGetValidatorReward(t *testing.T) {
 
 	t.Run("validator receives proportional reward", func(t *testing.T) {
 		reward := thread.GetValidatorReward("bob", totalReward)
-		require.Equal(t, "jct", reward.Denom)
-		require.Equal(t, int64(40), reward.Amount.Int64()) // 4 of 6 frames => 4/6 of 60 = 40
+		require.Equal(t, "token", reward.Denom)            // paid in the denom of the reward
+		require.Equal(t, int64(40), reward.Amount.Int64()) // 4 of 6 stems => 4/6 of 60 = 40
 	})
 
 	t.Run("non-validator receives zero", func(t *testing.T) {
@@ -1459,1255 +760,305 @@ func TestCalculateValidatorPayment(t *testing.T) {
 }
 
 // --- Test for RevealSolution ---
-func TestRevealSolution_CalculateCIDsKo(t *testing.T) {
+func TestRevealSolution_NotProposed(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	rootPath := "/tmp/rendering/thread123"
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
-		return nil, fmt.Errorf("Calculate CIDs error")
-	})
-	defer patch1.Unpatch()
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: "proposer"}}
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	// Execute method under test
+	err := thread.RevealSolution(t.TempDir(), database)
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Calculate CIDs error")
+	// there is nothing to reveal
+	require.NoError(t, err)
+	requireState(t, database, db.ThreadStemmed)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-	}
-	rootPath := "/tmp/rendering/thread123"
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
-		}, nil
-	})
-	defer patch1.Unpatch()
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, proposed...)
+	require.NoError(t, database.SetSalt("1-0", "salt"))
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: "proposer"}}
 
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "", fmt.Errorf("Calculate file hash error")
-	})
-	defer patch2.Unpatch()
-
-	err := thread.RevealSolution(rootPath, mockDB)
+	// Execute method under test, without stems to reveal
+	err := thread.RevealSolution(t.TempDir(), database)
 
-	// Verify that we got the expected error
+	// Verify
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Calculate file hash error")
+	requireState(t, database, db.ThreadProposed)
+	require.Empty(t, cliCalls(t, calls))
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_ReadSaltKo(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame_001.png",
-					Signature:    "sig1",
-					Cid:          "bafyframe001",
-					Hash:         "abc123hash001",
-					ValidCount:   3,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame_002.png",
-					Signature:    "sig2",
-					Cid:          "bafyframe002",
-					Hash:         "abc123hash002",
-					ValidCount:   2,
-					InvalidCount: 1,
-				},
-			},
-		}}
-	rootPath := "/tmp/rendering/thread123"
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
-		}, nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
-	})
-	defer patch2.Unpatch()
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, verified...)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: "proposer"}}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, *thread), testStems...)
+
+	// Execute method under test, the salt of the commitment is lost
+	err := thread.RevealSolution(rootPath, database)
+
+	// Verify
+	require.ErrorContains(t, err, "no salt")
+	requireState(t, database, db.ThreadVerified)
+	require.Empty(t, cliCalls(t, calls))
+}
 
-	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return fmt.Errorf("FromFramesToCli error")
-	})
-	defer patch4.Unpatch()
+func TestRevealSolution_CalculateCIDsOk_ReadSaltOk_ExecuteCliKo(t *testing.T) {
+	// Setup
+	fakeCli(t, "exit 1", "")
+	database := newLocalThread(t, proposed...)
+	require.NoError(t, database.SetSalt("1-0", "salt"))
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: "proposer"}}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, *thread), testStems...)
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	// Execute method under test
+	err := thread.RevealSolution(rootPath, database)
 
-	// Verify that we got the expected error
+	// Verify
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "FromFramesToCli error")
+	requireState(t, database, db.ThreadProposed)
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateThreadKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_ReadSaltOk_ExecuteCliOk_UpdateThreadKo(t *testing.T) {
 	// Setup
+	fakeCli(t, "", "")
 	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame_001.png",
-					Signature:    "sig1",
-					Cid:          "bafyframe001",
-					Hash:         "abc123hash001",
-					ValidCount:   3,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame_002.png",
-					Signature:    "sig2",
-					Cid:          "bafyframe002",
-					Hash:         "abc123hash002",
-					ValidCount:   2,
-					InvalidCount: 1,
-				},
-			},
-		}}
-	rootPath := "/tmp/rendering/thread123"
-
-	// Mock DB methods
-	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, true, true, false).Return(fmt.Errorf("UpdateThread error")).Once()
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
-		}, nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
-	})
-	defer patch2.Unpatch()
-
-	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch4.Unpatch()
+	mockDB.On("ReadThread", "1-0").Return(&db.Thread{ID: "1-0", State: db.ThreadVerified}, nil).Once()
+	mockDB.On("TransitionThread", "1-0", db.ThreadVerified, db.ThreadRevealing).Return(nil).Once()
+	mockDB.On("ReadSalt", "1-0").Return("salt", nil).Once()
+	mockDB.On("TransitionThread", "1-0", db.ThreadRevealing, db.ThreadRevealed).Return(errors.New("database is locked")).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: "proposer"}}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, *thread), testStems...)
 
+	// Execute method under test
 	err := thread.RevealSolution(rootPath, mockDB)
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "UpdateThread error")
-
-	// Verify mock expectations
+	// Verify
+	require.ErrorContains(t, err, "database is locked")
 	mockDB.AssertExpectations(t)
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateThreadOk(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_ReadSaltOk_ExecuteCliOk_UpdateThreadOk(t *testing.T) {
 	// Setup
-	mockDB := new(mocks.DB)
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame_001.png",
-					Signature:    "sig1",
-					Cid:          "bafyframe001",
-					Hash:         "abc123hash001",
-					ValidCount:   3,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame_002.png",
-					Signature:    "sig2",
-					Cid:          "bafyframe002",
-					Hash:         "abc123hash002",
-					ValidCount:   2,
-					InvalidCount: 1,
-				},
-			},
-		}}
-	rootPath := "/tmp/rendering/thread123"
-
-	// Mock DB methods
-	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, true, true, false).Return(nil).Once()
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
-		}, nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
-	})
-	defer patch2.Unpatch()
-
-	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
-		return nil
-	})
-	defer patch4.Unpatch()
+	keys, address := newTestKeys(t)
+	calls := fakeCli(t, "", "")
+	database := newLocalThread(t, stemmed...)
+	thread := &AudioStemThread{TaskId: "1", ThreadId: "1-0", Cid: "song", Solution: &AudioStemThread_Solution{ProposedBy: address}}
+	rootPath := t.TempDir()
+	writeStems(t, stemsDir(rootPath, *thread), testStems...)
+	require.NoError(t, thread.ProposeSolution(moduletestutil.MakeTestEncodingConfig().Codec, keys, "alice", address, rootPath, database))
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	// Execute method under test
+	err := thread.RevealSolution(rootPath, database)
 
-	// Verify that we got no error
+	// Verify
 	require.NoError(t, err)
-
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	requireState(t, database, db.ThreadRevealed)
+	sent := cliCalls(t, calls)
+	require.Len(t, sent, 2)
+	commitment := strings.Fields(sent[0])[6]
+	args := strings.Fields(sent[1])
+	salt, err := database.ReadSalt("1-0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx", "audioStem", "reveal-solution", "1", "1-0", salt}, args[:6])
+
+	// the revealed stems open the commitment of the proposal
+	var stems []*AudioStemThread_Stem
+	for _, file := range args[6 : 6+len(testStems)] {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var reveal StemReveal
+		require.NoError(t, json.Unmarshal(content, &reveal))
+		stems = append(stems, &AudioStemThread_Stem{Filename: reveal.Filename, Cid: reveal.Cid, Hash: reveal.Hash, Fingerprint: reveal.Fingerprint, Proof: reveal.Proof})
+	}
+	total, err := VerifyStems(stems, commitment, salt, address)
+	require.NoError(t, err)
+	require.Equal(t, len(testStems), total)
 }
 
 // --- Test for EvaluateVerifications ---
-func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   3,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
-	}
-
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), fmt.Errorf("DecodePublicKeyFromCLI error")
-	})
-	defer patch1.Unpatch()
 
-	err := thread.EvaluateVerifications()
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "DecodePublicKeyFromCLI error")
+// committer signs commitments to the stems it reveals.
+type committer struct {
+	address string
+	key     *secp256k1.PrivKey
+	salt    string
 }
-func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageKo(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   3,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
-	}
-
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return nil, fmt.Errorf("GenerateSignableMessage error")
-	})
-	defer patch2.Unpatch()
-
-	err := thread.EvaluateVerifications()
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "GenerateSignableMessage error")
+func newCommitter(address string) committer {
+	return committer{address: address, key: secp256k1.GenPrivKey(), salt: address + "-salt"}
 }
-func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageOk_DecodeSignatureFromCLIKo(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   3,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
-	}
-
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch2.Unpatch()
 
-	patch3 := monkey.Patch(audioStemCrypto.DecodeSignatureFromCLI, func(encodedSig string) ([]byte, error) {
-		return nil, fmt.Errorf("DecodeSignatureFromCLI error")
-	})
-	defer patch3.Unpatch()
-
-	err := thread.EvaluateVerifications()
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "DecodeSignatureFromCLI error")
-}
-func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageOk_DecodeSignatureFromCLIOk_VerifySignatureFalse(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   3,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
+// fingerprinted returns the stems of a song, whose fingerprints are all the level.
+func fingerprinted(level byte) map[string]AudioStemThread_Stem {
+	stems := make(map[string]AudioStemThread_Stem)
+	for i, name := range testStems {
+		fp := fingerprint.Fingerprint{level, level, level, level, level, level, level, level}
+		stems[name] = AudioStemThread_Stem{Filename: name, Cid: fmt.Sprintf("cid%d", i), Hash: fmt.Sprintf("hash%d", i), Fingerprint: fp.Encode()}
 	}
-	mockPublicKey := new(mocks.MockPubKey)
-
-	// Mock public key methods
-	mockPublicKey.On("VerifySignature", mock.Anything, mock.Anything).Return(false).Times(4) // Called 4 times as there is 4 frames in total
-
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return mockPublicKey, nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.DecodeSignatureFromCLI, func(encodedSig string) ([]byte, error) {
-		return []byte{0x12, 0x34, 0xab, 0xcd, 0xef}, nil
-	})
-	defer patch3.Unpatch()
-
-	err := thread.EvaluateVerifications()
-
-	// Verify that we got no error
-	require.NoError(t, err)
+	return stems
 }
-func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageOk_DecodeSignatureFromCLIOk_VerifySignatureTrue(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   3,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
-	}
-	mockPublicKey := new(mocks.MockPubKey)
 
-	// Mock public key methods
-	mockPublicKey.On("VerifySignature", mock.Anything, mock.Anything).Return(true).Times(4) // Called 4 times as there is 4 frames in total
-
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return mockPublicKey, nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch2.Unpatch()
-
-	patch3 := monkey.Patch(audioStemCrypto.DecodeSignatureFromCLI, func(encodedSig string) ([]byte, error) {
-		return []byte{0x12, 0x34, 0xab, 0xcd, 0xef}, nil
-	})
-	defer patch3.Unpatch()
-
-	err := thread.EvaluateVerifications()
+// commit returns the public key, commitment, signature and revealed stems of the committer.
+func (c committer) commit(t *testing.T, stems map[string]AudioStemThread_Stem) (string, string, string, []*AudioStemThread_Stem) {
+	root, err := StemsRoot(stems)
+	require.NoError(t, err)
+	commitment := audioStemCrypto.GenerateCommitment(root, c.salt, c.address)
+	message, err := audioStemCrypto.GenerateSignableMessage(commitment, c.address)
+	require.NoError(t, err)
+	signature, err := c.key.Sign(message)
+	require.NoError(t, err)
+	publicKey, err := audioStemCrypto.EncodePublicKeyForCLI(c.key.PubKey())
+	require.NoError(t, err)
 
-	// Verify that we got no error
+	proved, err := ProveStems(stems)
 	require.NoError(t, err)
+	var revealed []*AudioStemThread_Stem
+	for _, name := range testStems {
+		if stem, ok := proved[name]; ok {
+			revealed = append(revealed, &stem)
+		}
+	}
+	return publicKey, commitment, audioStemCrypto.EncodeSignatureForCLI(signature), revealed
 }
 
-func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageOk_DecodeSignatureFromCLIOk_VerifySignatureTrue_FrameNotFound(t *testing.T) {
-	// Setup
+// committedThread returns a thread whose solution and validation were committed and revealed.
+func committedThread(t *testing.T, solution, validation map[string]AudioStemThread_Stem) *AudioStemThread {
+	proposer, validator := newCommitter("proposer"), newCommitter("validator")
+	publicKey, commitment, signature, stems := proposer.commit(t, solution)
 	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-		Validations: []*AudioStemThread_Validation{
-			{
-				Validator: "alice",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   1,
-						InvalidCount: 0,
-					},
-					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
-						Cid:          "cid2",
-						Hash:         "hash2",
-						ValidCount:   2,
-						InvalidCount: 0,
-					},
-				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
-			},
-			{
-				Validator: "bob",
-				Frames: []*AudioStemThread_Frame{
-					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
-						Cid:          "cid1",
-						Hash:         "hash1",
-						ValidCount:   0,
-						InvalidCount: 1,
-					},
-					// "frame2.png" eliminated
-				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
-			},
-		},
+		ThreadId: "1-0",
+		Solution: &AudioStemThread_Solution{ProposedBy: proposer.address, PublicKey: publicKey, Commitment: commitment, Signature: signature, Salt: proposer.salt, Stems: stems},
 	}
-	mockPublicKey := new(mocks.MockPubKey)
-
-	// Mock public key methods
-	mockPublicKey.On("VerifySignature", mock.Anything, mock.Anything).Return(true).Times(4) // Called 4 times as there is 4 frames in total
+	publicKey, commitment, signature, stems = validator.commit(t, validation)
+	thread.Validations = []*AudioStemThread_Validation{{Validator: validator.address, PublicKey: publicKey, Commitment: commitment, Signature: signature, Salt: validator.salt, Stems: stems}}
+	return thread
+}
 
-	// Monkey patching
-	patch1 := monkey.Patch(audioStemCrypto.DecodePublicKeyFromCLI, func(encodedPubKey string) (cryptotypes.PubKey, error) {
-		return mockPublicKey, nil
-	})
-	defer patch1.Unpatch()
+// requireCounts checks the valid and invalid counts of every stem of the solution.
+func requireCounts(t *testing.T, thread *AudioStemThread, valid, invalid int64) {
+	for _, stem := range thread.Solution.Stems {
+		require.Equal(t, valid, stem.ValidCount, stem.Filename)
+		require.Equal(t, invalid, stem.InvalidCount, stem.Filename)
+	}
+}
 
-	patch2 := monkey.Patch(audioStemCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch2.Unpatch()
+func TestEvaluateVerifications_SolutionNotRevealed(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
+	thread.Solution.Salt = ""
+	require.Error(t, thread.EvaluateVerifications(0.9))
+}
 
-	patch3 := monkey.Patch(audioStemCrypto.DecodeSignatureFromCLI, func(encodedSig string) ([]byte, error) {
-		return []byte{0x12, 0x34, 0xab, 0xcd, 0xef}, nil
-	})
-	defer patch3.Unpatch()
+func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
+	thread.Solution.PublicKey = "not a public key"
 
-	err := thread.EvaluateVerifications()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
 
-	// Verify that we got no error
-	require.NoError(t, err)
+	// the solution can't be trusted, so every validation counts against it
+	requireCounts(t, thread, 0, 1)
 }
 
-// --- Test for IsSolutionAccepted ---
-func TestIsSolutionAccepted_NoFrames(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames:     []*AudioStemThread_Frame{},
-		},
-	}
+func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_DecodeSignatureFromCLIKo(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
+	thread.Validations[0].Signature = "not a signature"
 
-	valid := thread.IsSolutionAccepted()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
 
-	require.False(t, valid)
+	requireCounts(t, thread, 0, 1)
 }
 
-func TestIsSolutionAccepted_OneWorker_FrameAmountValidKo(t *testing.T) {
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
+func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_DecodeSignatureFromCLIOk_VerifySignatureFalse(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
+	// the validation is signed by another key
+	_, _, signature, _ := newCommitter("validator").commit(t, fingerprinted(100))
+	thread.Validations[0].Signature = signature
 
-	valid := thread.IsSolutionAccepted()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
 
-	require.False(t, valid)
+	requireCounts(t, thread, 0, 1)
 }
 
-func TestIsSolutionAccepted_OneWorker_FrameAmountValidOk(t *testing.T) {
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
+func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_DecodeSignatureFromCLIOk_VerifySignatureTrue(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
 
-	valid := thread.IsSolutionAccepted()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	requireCounts(t, thread, 1, 0)
 
-	require.True(t, valid)
+	// evaluating again doesn't count the validations twice
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	requireCounts(t, thread, 1, 0)
 }
 
-func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidKo(t *testing.T) {
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice", "bob"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame3.png",
-					Signature:    "sig3",
-					Cid:          "cid3",
-					Hash:         "hash3",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame4.png",
-					Signature:    "sig4",
-					Cid:          "cid4",
-					Hash:         "hash4",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame5.png",
-					Signature:    "sig5",
-					Cid:          "cid5",
-					Hash:         "hash5",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame6.png",
-					Signature:    "sig6",
-					Cid:          "cid6",
-					Hash:         "hash6",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame7.png",
-					Signature:    "sig7",
-					Cid:          "cid7",
-					Hash:         "hash7",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame8.png",
-					Signature:    "sig8",
-					Cid:          "cid8",
-					Hash:         "hash8",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame9.png",
-					Signature:    "sig9",
-					Cid:          "cid9",
-					Hash:         "hash9",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame10.png",
-					Signature:    "sig10",
-					Cid:          "cid10",
-					Hash:         "hash10",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
+func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_DecodeSignatureFromCLIOk_VerifySignatureTrue_FrameNotFound(t *testing.T) {
+	// the validator only rendered half of the stems
+	validation := fingerprinted(100)
+	delete(validation, "bass.wav")
+	delete(validation, "other.wav")
+	thread := committedThread(t, fingerprinted(100), validation)
 
-	valid := thread.IsSolutionAccepted()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
 
-	require.False(t, valid)
+	for _, stem := range thread.Solution.Stems {
+		_, validated := validation[stem.Filename]
+		require.Equal(t, validated, stem.ValidCount == 1, stem.Filename)
+		require.Zero(t, stem.InvalidCount, stem.Filename)
+	}
 }
 
-func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidOk(t *testing.T) {
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice", "bob"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame3.png",
-					Signature:    "sig3",
-					Cid:          "cid3",
-					Hash:         "hash3",
-					ValidCount:   3,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame4.png",
-					Signature:    "sig4",
-					Cid:          "cid4",
-					Hash:         "hash4",
-					ValidCount:   4,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame5.png",
-					Signature:    "sig5",
-					Cid:          "cid5",
-					Hash:         "hash5",
-					ValidCount:   5,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame6.png",
-					Signature:    "sig6",
-					Cid:          "cid6",
-					Hash:         "hash6",
-					ValidCount:   6,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
+func TestEvaluateVerifications_ValidationNotRevealed(t *testing.T) {
+	thread := committedThread(t, fingerprinted(100), fingerprinted(100))
+	thread.Validations[0].Salt = ""
 
-	valid := thread.IsSolutionAccepted()
+	require.NoError(t, thread.EvaluateVerifications(0.9))
 
-	require.True(t, valid)
+	requireCounts(t, thread, 0, 0)
 }
 
 // --- Test for VerifySubmittedSolution ---
 func TestVerifySubmittedSolution_ListDirectoryKo(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
-
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
-		return nil, fmt.Errorf("ListDirectory error")
-	})
-	defer patch1.Unpatch()
+	useFakeIPFS(t)
+	thread := &AudioStemThread{Solution: &AudioStemThread_Solution{Stems: []*AudioStemThread_Stem{{Filename: "vocals.wav", Cid: "cid"}}}}
 
-	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "ListDirectory error")
+	require.Error(t, thread.VerifySubmittedSolution("missing"))
 }
 
-func TestVerifySubmittedSolution_ListDirectoryOk_FilesExistKo(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
+// submittedThread uploads the stems of a solution and returns the thread with the stems and
+// the CID of their directory.
+func submittedThread(t *testing.T, node *ipfs.Fake) (*AudioStemThread, string) {
+	dir := t.TempDir()
+	writeStems(t, dir, testStems...)
+	thread := &AudioStemThread{Solution: &AudioStemThread_Solution{}}
+	for _, name := range testStems {
+		cid, err := ipfs.FileCID(filepath.Join(dir, name))
+		require.NoError(t, err)
+		thread.Solution.Stems = append(thread.Solution.Stems, &AudioStemThread_Stem{Filename: name, Cid: cid})
 	}
+	cid, err := node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+	return thread, cid
+}
 
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "cid1",
-			"frame2.png": "cid",
-		}, nil
-	})
-	defer patch1.Unpatch()
-
-	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
+func TestVerifySubmittedSolution_ListDirectoryOk_FilesExistKo(t *testing.T) {
+	node := useFakeIPFS(t)
+	thread, dir := submittedThread(t, node)
+	// the solution revealed a stem that wasn't uploaded
+	thread.Solution.Stems = append(thread.Solution.Stems, &AudioStemThread_Stem{Filename: "piano.wav", Cid: "cid"})
 
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "doesn't exists in")
+	require.ErrorContains(t, thread.VerifySubmittedSolution(dir), "piano.wav")
 }
 
 func TestVerifySubmittedSolution_ListDirectoryOk_FilesExistOk(t *testing.T) {
-	// Setup
-	thread := &AudioStemThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
-		Solution: &AudioStemThread_Solution{
-			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
-			Frames: []*AudioStemThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-			},
-		},
-	}
+	node := useFakeIPFS(t)
+	thread, dir := submittedThread(t, node)
 
-	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
-		return map[string]string{
-			"frame1.png": "cid1",
-			"frame2.png": "cid2",
-		}, nil
-	})
-	defer patch1.Unpatch()
-
-	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
-
-	// Verify that we got no error
-	require.NoError(t, err)
+	require.NoError(t, thread.VerifySubmittedSolution(dir))
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/janction/audioStem/ipfs"
 )
 
-func (w Worker) RegisterWorker(address string, stake types.Coin, db *db.DB) error {
+func (w Worker) RegisterWorker(address string, stake types.Coin, db db.Database) error {
 	time.Sleep(5 * time.Second) // Delay 5 seconds before registering
 
 	db.Addworker(address)
@@ -25,6 +25,7 @@ func (w Worker) RegisterWorker(address string, stake types.Coin, db *db.DB) erro
 	args = append(args, ip)
 	args = append(args, ipfsId)
 	args = append(args, stake.String())
+	// registering stakes, so it is sent by the operator and never by the signer of the worker
 	args = append(args, "--from")
 	args = append(args, address)
 	args = append(args, "--yes")
@@ -54,12 +55,62 @@ func getPublicIP() (string, error) {
 	return strings.TrimSpace(string(ip)), nil
 }
 
-func (w *Worker) DeclareWinner(payment types.Coin) {
-	w.CurrentTaskId = ""
-	w.CurrentThreadIndex = 0
+// DeclareWinner adds the accepted solution and its payment to the reputation of the worker.
+// The worker is released together with the rest of the thread.
+func (w *Worker) DeclareWinner(payment types.Coin, renderSeconds int64) {
+	if w.Reputation == nil {
+		w.Reputation = &Worker_Reputation{}
+	}
 	w.Reputation.Points = w.Reputation.Points + 1
 	w.Reputation.Solutions = w.Reputation.Solutions + 1
-	w.Reputation.Winnings = w.Reputation.Winnings.Add(payment)
+	w.Reputation.RenderDurations = append(w.Reputation.RenderDurations, renderSeconds)
+	w.addWinnings(payment)
+}
+
+// DeclareValidator adds the validation of an accepted solution and its payment to the
+// reputation of the worker.
+func (w *Worker) DeclareValidator(payment types.Coin) {
+	if w.Reputation == nil {
+		w.Reputation = &Worker_Reputation{}
+	}
+	w.Reputation.Points = w.Reputation.Points + 1
+	w.Reputation.Validations = w.Reputation.Validations + 1
+	w.addWinnings(payment)
+}
+
+// addWinnings adds the payment to the winnings of the worker, which are kept in a single
+// denom. Payments in another denom are not added.
+func (w *Worker) addWinnings(payment types.Coin) {
+	switch w.Reputation.Winnings.Denom {
+	case "":
+		w.Reputation.Winnings = payment
+	case payment.Denom:
+		w.Reputation.Winnings = w.Reputation.Winnings.Add(payment)
+	}
+}
+
+// Penalize takes percent of the stake of the worker after a rejected solution or a withheld
+// reveal, returning the amount taken. A worker left with less than minStake is disabled until
+// it tops its stake up. The worker is released by the thread it was penalized on, since it may
+// already be working on another one.
+func (w *Worker) Penalize(percent int64, minStake *types.Coin) types.Coin {
+	if w.Reputation == nil || w.Reputation.Staked == nil {
+		return types.Coin{}
+	}
+
+	w.Reputation.Points = w.Reputation.Points - 1
+	slash := types.NewCoin(w.Reputation.Staked.Denom, w.Reputation.Staked.Amount.MulRaw(percent).QuoRaw(100))
+	staked := w.Reputation.Staked.Sub(slash)
+	w.Reputation.Staked = &staked
+	if minStake != nil && staked.Denom == minStake.Denom && staked.Amount.LT(minStake.Amount) {
+		w.Enabled = false
+	}
+	return slash
+}
+
+// IsWorkingOn returns true if the worker is assigned to the thread.
+func (w Worker) IsWorkingOn(thread *AudioStemThread) bool {
+	return w.CurrentTaskId == thread.TaskId && uint32(w.CurrentThreadIndex) == thread.Index
 }
 
 func (w *Worker) ReleaseValidator() {
@@ -0,0 +1,68 @@
+package audioStem
+
+import "slices"
+
+// StemsPerSolution is the amount of stems every file is separated into.
+const StemsPerSolution = 4
+
+// AcceptancePolicy decides when a thread has enough validations to reveal its commitments,
+// and whether its revealed solution is accepted once EvaluateVerifications counted the
+// validations matching each stem.
+type AcceptancePolicy interface {
+	// HasEnoughValidations tells if the thread stops accepting commitments so they can be revealed.
+	HasEnoughValidations(thread *AudioStemThread) bool
+	// IsSolutionAccepted tells if the evaluated solution of the thread is accepted.
+	IsSolutionAccepted(thread *AudioStemThread) bool
+}
+
+// QuorumPolicy gives every validation the same weight. A stem is valid when a quorum of
+// validations matches it, and the solution is accepted when enough of its stems are valid.
+type QuorumPolicy struct {
+	// MinValidators is the amount of validations the thread waits for before revealing.
+	MinValidators int64
+	// Quorum is the amount of validations that must match a stem for it to be valid.
+	Quorum int64
+	// MinValidStemsPercent is the percentage of the stems of the solution that must be valid.
+	MinValidStemsPercent int64
+}
+
+var _ AcceptancePolicy = QuorumPolicy{}
+
+// HasEnoughValidations implements AcceptancePolicy. Threads with fewer validators than
+// MinValidators reveal once every validator validated.
+func (p QuorumPolicy) HasEnoughValidations(thread *AudioStemThread) bool {
+	validations := int64(len(thread.Validations))
+	return validations > 0 && (validations >= p.MinValidators || len(thread.Validations) == validators(thread))
+}
+
+// IsSolutionAccepted implements AcceptancePolicy. The quorum is capped to the validators of
+// the thread, so a thread with a single validator can be accepted with its validation.
+func (p QuorumPolicy) IsSolutionAccepted(thread *AudioStemThread) bool {
+	if thread.Solution == nil || len(thread.Solution.Stems) == 0 {
+		return false
+	}
+
+	quorum := min(p.Quorum, int64(max(1, validators(thread))))
+	valid := 0
+	for _, stem := range thread.Solution.Stems {
+		if stem.ValidCount >= quorum {
+			valid++
+		}
+	}
+	return valid >= RequiredStems(len(thread.Solution.Stems), p.MinValidStemsPercent)
+}
+
+// RequiredStems returns how many of the given stems are at least the percentage of them,
+// never less than one.
+func RequiredStems(total int, percent int64) int {
+	return max(1, total*int(percent)/100)
+}
+
+// validators returns how many workers of the thread can validate its solution, which are
+// all of them but the proposer.
+func validators(thread *AudioStemThread) int {
+	if thread.Solution != nil && slices.Contains(thread.Workers, thread.Solution.ProposedBy) {
+		return len(thread.Workers) - 1
+	}
+	return len(thread.Workers)
+}
@@ -0,0 +1,157 @@
+package audioStem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// evaluatedThread returns a thread on the given workers whose solution stems were matched
+// by the given amount of validations each.
+func evaluatedThread(workers int, validCounts ...int64) *AudioStemThread {
+	thread := &AudioStemThread{Solution: &AudioStemThread_Solution{}}
+	for i := 0; i < workers; i++ {
+		thread.Workers = append(thread.Workers, testAddress)
+	}
+	for _, count := range validCounts {
+		thread.Solution.Stems = append(thread.Solution.Stems, &AudioStemThread_Stem{ValidCount: count})
+	}
+	return thread
+}
+
+// proposedThread returns an evaluated thread whose solution was proposed by one of its workers.
+func proposedThread(workers int, validCounts ...int64) *AudioStemThread {
+	thread := evaluatedThread(workers, validCounts...)
+	thread.Solution.ProposedBy = testAddress
+	return thread
+}
+
+func TestQuorumPolicy_HasEnoughValidations(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      QuorumPolicy
+		workers     int
+		proposed    bool
+		validations int
+		enough      bool
+	}{
+		{"no validations", QuorumPolicy{MinValidators: 2}, 2, false, 0, false},
+		{"below the minimum", QuorumPolicy{MinValidators: 2}, 3, false, 1, false},
+		{"at the minimum", QuorumPolicy{MinValidators: 2}, 3, false, 2, true},
+		{"every worker validated", QuorumPolicy{MinValidators: 2}, 1, false, 1, true},
+		{"higher minimum", QuorumPolicy{MinValidators: 3}, 4, false, 2, false},
+		{"no workers", QuorumPolicy{MinValidators: 1}, 0, false, 1, true},
+		{"proposer doesn't validate", QuorumPolicy{MinValidators: 2}, 2, true, 1, true},
+		{"validator missing", QuorumPolicy{MinValidators: 2}, 3, true, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thread := evaluatedThread(tt.workers)
+			if tt.proposed {
+				thread.Solution.ProposedBy = testAddress
+			}
+			for i := 0; i < tt.validations; i++ {
+				thread.Validations = append(thread.Validations, &AudioStemThread_Validation{})
+			}
+			require.Equal(t, tt.enough, tt.policy.HasEnoughValidations(thread))
+		})
+	}
+}
+
+func TestQuorumPolicy_IsSolutionAccepted(t *testing.T) {
+	defaults := QuorumPolicy{MinValidators: 2, Quorum: 2, MinValidStemsPercent: 20}
+	tests := []struct {
+		name     string
+		policy   QuorumPolicy
+		thread   *AudioStemThread
+		accepted bool
+	}{
+		{"without solution", defaults, &AudioStemThread{}, false},
+		{"without stems", defaults, evaluatedThread(2), false},
+		{"no valid stem", defaults, evaluatedThread(2, 1, 1, 1, 1), false},
+		{"one stem reaches the quorum", defaults, evaluatedThread(2, 2, 1, 0, 0), true},
+		{"quorum capped to a single worker", defaults, evaluatedThread(1, 1, 0, 0, 0), true},
+		{"quorum capped to a single validator", defaults, proposedThread(2, 1, 0, 0, 0), true},
+		{"validator disagrees", defaults, proposedThread(2, 0, 0, 0, 0), false},
+		{"higher quorum", QuorumPolicy{Quorum: 3, MinValidStemsPercent: 20}, evaluatedThread(3, 2, 2, 2, 2), false},
+		{"half of the stems required", QuorumPolicy{Quorum: 2, MinValidStemsPercent: 50}, evaluatedThread(2, 2, 1, 1, 1), false},
+		{"half of the stems valid", QuorumPolicy{Quorum: 2, MinValidStemsPercent: 50}, evaluatedThread(2, 2, 2, 1, 1), true},
+		{"every stem required", QuorumPolicy{Quorum: 2, MinValidStemsPercent: 100}, evaluatedThread(2, 2, 2, 2, 1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.accepted, tt.policy.IsSolutionAccepted(tt.thread))
+		})
+	}
+}
+
+func TestRequiredStems(t *testing.T) {
+	tests := []struct {
+		total    int
+		percent  int64
+		required int
+	}{
+		{4, 20, 1},
+		{4, 0, 1},
+		{4, 50, 2},
+		{4, 100, 4},
+		{10, 20, 2},
+		{0, 20, 1},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.required, RequiredStems(tt.total, tt.percent), "%d%% of %d", tt.percent, tt.total)
+	}
+}
+
+func TestParams_AcceptancePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		policy AcceptancePolicy
+	}{
+		{"defaults", DefaultParams(), QuorumPolicy{MinValidators: 2, Quorum: 2, MinValidStemsPercent: 20}},
+		{"governed", Params{MinValidators: 3, MinValidValidations: 1, MinValidStemsPercent: 75}, QuorumPolicy{MinValidators: 3, Quorum: 1, MinValidStemsPercent: 75}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.policy, tt.params.AcceptancePolicy())
+		})
+	}
+}
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Params)
+		valid  bool
+	}{
+		{"defaults", func(p *Params) {}, true},
+		{"more validators than workers", func(p *Params) { p.MinValidators = p.MaxWorkersPerThread + 1 }, false},
+		{"negative validators", func(p *Params) { p.MinValidators = -1 }, false},
+		{"quorum larger than the workers", func(p *Params) { p.MinValidValidations = p.MaxWorkersPerThread + 1 }, false},
+		{"valid stems above 100%", func(p *Params) { p.MinValidStemsPercent = 101 }, false},
+		{"every stem valid", func(p *Params) { p.MinValidStemsPercent = 100 }, true},
+		{"no validators", func(p *Params) { p.MinValidators = 0 }, false},
+		{"no quorum", func(p *Params) { p.MinValidValidations = 0 }, false},
+		{"no valid stems", func(p *Params) { p.MinValidStemsPercent = 0 }, false},
+		{"single worker per thread", func(p *Params) { p.MaxWorkersPerThread, p.MinValidators, p.MinValidValidations = 1, 1, 1 }, false},
+		{"no invalid input reports", func(p *Params) { p.MinInvalidInputReports = 0 }, false},
+		{"no stem similarity", func(p *Params) { p.MinStemSimilarityPercent = 0 }, false},
+		{"no reveal period", func(p *Params) { p.RevealPeriodBlocks = 0 }, false},
+		{"no solution attempts", func(p *Params) { p.MaxSolutionAttempts = 0 }, false},
+		{"no min staking", func(p *Params) { p.MinWorkerStaking = nil }, false},
+		{"no probing fee", func(p *Params) { p.ProbingFeePercent = 0 }, true},
+		{"no rejection slash", func(p *Params) { p.RejectionSlashPercent = 0 }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			tt.modify(&params)
+			if tt.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package audioStemv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
@@ -871,25 +872,27 @@ func (x *fastReflection_QueryGetAudioStemTaskResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGetAudioStemLogsRequest          protoreflect.MessageDescriptor
-	fd_QueryGetAudioStemLogsRequest_threadId protoreflect.FieldDescriptor
+	md_QueryGetAudioStemThreadRequest         protoreflect.MessageDescriptor
+	fd_QueryGetAudioStemThreadRequest_task_id protoreflect.FieldDescriptor
+	fd_QueryGetAudioStemThreadRequest_index   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetAudioStemLogsRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemLogsRequest")
-	fd_QueryGetAudioStemLogsRequest_threadId = md_QueryGetAudioStemLogsRequest.Fields().ByName("threadId")
+	md_QueryGetAudioStemThreadRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemThreadRequest")
+	fd_QueryGetAudioStemThreadRequest_task_id = md_QueryGetAudioStemThreadRequest.Fields().ByName("task_id")
+	fd_QueryGetAudioStemThreadRequest_index = md_QueryGetAudioStemThreadRequest.Fields().ByName("index")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemLogsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemThreadRequest)(nil)
 
-type fastReflection_QueryGetAudioStemLogsRequest QueryGetAudioStemLogsRequest
+type fastReflection_QueryGetAudioStemThreadRequest QueryGetAudioStemThreadRequest
 
-func (x *QueryGetAudioStemLogsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAudioStemLogsRequest)(x)
+func (x *QueryGetAudioStemThreadRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemThreadRequest)(x)
 }
 
-func (x *QueryGetAudioStemLogsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetAudioStemThreadRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,43 +904,43 @@ func (x *QueryGetAudioStemLogsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAudioStemLogsRequest_messageType fastReflection_QueryGetAudioStemLogsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemLogsRequest_messageType{}
+var _fastReflection_QueryGetAudioStemThreadRequest_messageType fastReflection_QueryGetAudioStemThreadRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemThreadRequest_messageType{}
 
-type fastReflection_QueryGetAudioStemLogsRequest_messageType struct{}
+type fastReflection_QueryGetAudioStemThreadRequest_messageType struct{}
 
-func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAudioStemLogsRequest)(nil)
+func (x fastReflection_QueryGetAudioStemThreadRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemThreadRequest)(nil)
 }
-func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAudioStemLogsRequest)
+func (x fastReflection_QueryGetAudioStemThreadRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemThreadRequest)
 }
-func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAudioStemLogsRequest
+func (x fastReflection_QueryGetAudioStemThreadRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemThreadRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAudioStemLogsRequest
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemThreadRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAudioStemLogsRequest_messageType
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAudioStemThreadRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAudioStemLogsRequest)
+func (x *fastReflection_QueryGetAudioStemThreadRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemThreadRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAudioStemLogsRequest)(x)
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAudioStemThreadRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -945,10 +948,16 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_QueryGetAudioStemLogsRequest_threadId, value) {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_QueryGetAudioStemThreadRequest_task_id, value) {
+			return
+		}
+	}
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_QueryGetAudioStemThreadRequest_index, value) {
 			return
 		}
 	}
@@ -965,15 +974,17 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
-		return x.ThreadId != ""
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
+		return x.TaskId != ""
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		return x.Index != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -983,15 +994,17 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
-		x.ThreadId = ""
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
+		x.TaskId = ""
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		x.Index = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1001,16 +1014,19 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
-		value := x.ThreadId
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
+		value := x.TaskId
 		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1024,15 +1040,17 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
-		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
+		x.TaskId = value.Interface().(string)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		x.Index = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1046,40 +1064,44 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
-		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.QueryGetAudioStemLogsRequest is not mutable"))
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
+		panic(fmt.Errorf("field task_id of message janction.audioStem.v1.QueryGetAudioStemThreadRequest is not mutable"))
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		panic(fmt.Errorf("field index of message janction.audioStem.v1.QueryGetAudioStemThreadRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.task_id":
 		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.QueryGetAudioStemThreadRequest.index":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemLogsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemThreadRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1087,7 +1109,7 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1098,7 +1120,7 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1110,7 +1132,7 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) IsValid() bool {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1120,9 +1142,9 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetAudioStemThreadRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1134,10 +1156,13 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.ThreadId)
+		l = len(x.TaskId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1148,7 +1173,7 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1167,10 +1192,15 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1185,7 +1215,7 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1217,15 +1247,15 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemThreadRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemThreadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1253,8 +1283,27 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				x.TaskId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1291,25 +1340,25 @@ func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryGetAudioStemLogsResponse                 protoreflect.MessageDescriptor
-	fd_QueryGetAudioStemLogsResponse_audio_stem_logs protoreflect.FieldDescriptor
+	md_QueryGetAudioStemThreadResponse        protoreflect.MessageDescriptor
+	fd_QueryGetAudioStemThreadResponse_thread protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetAudioStemLogsResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemLogsResponse")
-	fd_QueryGetAudioStemLogsResponse_audio_stem_logs = md_QueryGetAudioStemLogsResponse.Fields().ByName("audio_stem_logs")
+	md_QueryGetAudioStemThreadResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemThreadResponse")
+	fd_QueryGetAudioStemThreadResponse_thread = md_QueryGetAudioStemThreadResponse.Fields().ByName("thread")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemLogsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemThreadResponse)(nil)
 
-type fastReflection_QueryGetAudioStemLogsResponse QueryGetAudioStemLogsResponse
+type fastReflection_QueryGetAudioStemThreadResponse QueryGetAudioStemThreadResponse
 
-func (x *QueryGetAudioStemLogsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAudioStemLogsResponse)(x)
+func (x *QueryGetAudioStemThreadResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemThreadResponse)(x)
 }
 
-func (x *QueryGetAudioStemLogsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetAudioStemThreadResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1321,43 +1370,43 @@ func (x *QueryGetAudioStemLogsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAudioStemLogsResponse_messageType fastReflection_QueryGetAudioStemLogsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemLogsResponse_messageType{}
+var _fastReflection_QueryGetAudioStemThreadResponse_messageType fastReflection_QueryGetAudioStemThreadResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemThreadResponse_messageType{}
 
-type fastReflection_QueryGetAudioStemLogsResponse_messageType struct{}
+type fastReflection_QueryGetAudioStemThreadResponse_messageType struct{}
 
-func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAudioStemLogsResponse)(nil)
+func (x fastReflection_QueryGetAudioStemThreadResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemThreadResponse)(nil)
 }
-func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAudioStemLogsResponse)
+func (x fastReflection_QueryGetAudioStemThreadResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemThreadResponse)
 }
-func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAudioStemLogsResponse
+func (x fastReflection_QueryGetAudioStemThreadResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemThreadResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAudioStemLogsResponse
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemThreadResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAudioStemLogsResponse_messageType
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAudioStemThreadResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAudioStemLogsResponse)
+func (x *fastReflection_QueryGetAudioStemThreadResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemThreadResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAudioStemLogsResponse)(x)
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAudioStemThreadResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1365,10 +1414,10 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AudioStemLogs != nil {
-		value := protoreflect.ValueOfMessage(x.AudioStemLogs.ProtoReflect())
-		if !f(fd_QueryGetAudioStemLogsResponse_audio_stem_logs, value) {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Thread != nil {
+		value := protoreflect.ValueOfMessage(x.Thread.ProtoReflect())
+		if !f(fd_QueryGetAudioStemThreadResponse_thread, value) {
 			return
 		}
 	}
@@ -1385,15 +1434,15 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		return x.AudioStemLogs != nil
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		return x.Thread != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1403,15 +1452,15 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		x.AudioStemLogs = nil
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		x.Thread = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1421,16 +1470,16 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		value := x.AudioStemLogs
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		value := x.Thread
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1444,15 +1493,15 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		x.AudioStemLogs = value.Message().Interface().(*AudioStemLogs)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		x.Thread = value.Message().Interface().(*AudioStemThread)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1466,44 +1515,44 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		if x.AudioStemLogs == nil {
-			x.AudioStemLogs = new(AudioStemLogs)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		if x.Thread == nil {
+			x.Thread = new(AudioStemThread)
 		}
-		return protoreflect.ValueOfMessage(x.AudioStemLogs.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Thread.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
-		m := new(AudioStemLogs)
+	case "janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread":
+		m := new(AudioStemThread)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemThreadResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemThreadResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemLogsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemThreadResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1511,7 +1560,7 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1522,7 +1571,7 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1534,7 +1583,7 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) IsValid() bool {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1544,9 +1593,9 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetAudioStemThreadResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1558,8 +1607,8 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.AudioStemLogs != nil {
-			l = options.Size(x.AudioStemLogs)
+		if x.Thread != nil {
+			l = options.Size(x.Thread)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1572,7 +1621,7 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1591,8 +1640,8 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AudioStemLogs != nil {
-			encoded, err := options.Marshal(x.AudioStemLogs)
+		if x.Thread != nil {
+			encoded, err := options.Marshal(x.Thread)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1616,7 +1665,7 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		x := input.Message.Interface().(*QueryGetAudioStemThreadResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1648,15 +1697,15 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemThreadResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemThreadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AudioStemLogs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Thread", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1683,10 +1732,10 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.AudioStemLogs == nil {
-					x.AudioStemLogs = &AudioStemLogs{}
+				if x.Thread == nil {
+					x.Thread = &AudioStemThread{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AudioStemLogs); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Thread); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1726,23 +1775,31 @@ func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGetPendingAudioStemTaskRequest protoreflect.MessageDescriptor
+	md_QueryGetAudioStemLogsRequest              protoreflect.MessageDescriptor
+	fd_QueryGetAudioStemLogsRequest_threadId     protoreflect.FieldDescriptor
+	fd_QueryGetAudioStemLogsRequest_since        protoreflect.FieldDescriptor
+	fd_QueryGetAudioStemLogsRequest_min_severity protoreflect.FieldDescriptor
+	fd_QueryGetAudioStemLogsRequest_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetPendingAudioStemTaskRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetPendingAudioStemTaskRequest")
+	md_QueryGetAudioStemLogsRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemLogsRequest")
+	fd_QueryGetAudioStemLogsRequest_threadId = md_QueryGetAudioStemLogsRequest.Fields().ByName("threadId")
+	fd_QueryGetAudioStemLogsRequest_since = md_QueryGetAudioStemLogsRequest.Fields().ByName("since")
+	fd_QueryGetAudioStemLogsRequest_min_severity = md_QueryGetAudioStemLogsRequest.Fields().ByName("min_severity")
+	fd_QueryGetAudioStemLogsRequest_pagination = md_QueryGetAudioStemLogsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetPendingAudioStemTaskRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemLogsRequest)(nil)
 
-type fastReflection_QueryGetPendingAudioStemTaskRequest QueryGetPendingAudioStemTaskRequest
+type fastReflection_QueryGetAudioStemLogsRequest QueryGetAudioStemLogsRequest
 
-func (x *QueryGetPendingAudioStemTaskRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetPendingAudioStemTaskRequest)(x)
+func (x *QueryGetAudioStemLogsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemLogsRequest)(x)
 }
 
-func (x *QueryGetPendingAudioStemTaskRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetAudioStemLogsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1754,43 +1811,43 @@ func (x *QueryGetPendingAudioStemTaskRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetPendingAudioStemTaskRequest_messageType fastReflection_QueryGetPendingAudioStemTaskRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetPendingAudioStemTaskRequest_messageType{}
+var _fastReflection_QueryGetAudioStemLogsRequest_messageType fastReflection_QueryGetAudioStemLogsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemLogsRequest_messageType{}
 
-type fastReflection_QueryGetPendingAudioStemTaskRequest_messageType struct{}
+type fastReflection_QueryGetAudioStemLogsRequest_messageType struct{}
 
-func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetPendingAudioStemTaskRequest)(nil)
+func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemLogsRequest)(nil)
 }
-func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetPendingAudioStemTaskRequest)
+func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemLogsRequest)
 }
-func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetPendingAudioStemTaskRequest
+func (x fastReflection_QueryGetAudioStemLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemLogsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetPendingAudioStemTaskRequest
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemLogsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetPendingAudioStemTaskRequest_messageType
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAudioStemLogsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetPendingAudioStemTaskRequest)
+func (x *fastReflection_QueryGetAudioStemLogsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemLogsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetPendingAudioStemTaskRequest)(x)
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAudioStemLogsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1798,7 +1855,31 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_QueryGetAudioStemLogsRequest_threadId, value) {
+			return
+		}
+	}
+	if x.Since != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Since)
+		if !f(fd_QueryGetAudioStemLogsRequest_since, value) {
+			return
+		}
+	}
+	if x.MinSeverity != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.MinSeverity))
+		if !f(fd_QueryGetAudioStemLogsRequest_min_severity, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetAudioStemLogsRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1812,13 +1893,21 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		return x.ThreadId != ""
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		return x.Since != int64(0)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		return x.MinSeverity != 0
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1828,13 +1917,2957 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		x.ThreadId = ""
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		x.Since = int64(0)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		x.MinSeverity = 0
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		value := x.Since
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		value := x.MinSeverity
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		x.Since = value.Int()
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		x.MinSeverity = (AudioStemLogs_AudioStemLog_SEVERITY)(value.Enum())
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.QueryGetAudioStemLogsRequest is not mutable"))
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		panic(fmt.Errorf("field since of message janction.audioStem.v1.QueryGetAudioStemLogsRequest is not mutable"))
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		panic(fmt.Errorf("field min_severity of message janction.audioStem.v1.QueryGetAudioStemLogsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.since":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity":
+		return protoreflect.ValueOfEnum(0)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemLogsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetAudioStemLogsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Since != 0 {
+			n += 1 + runtime.Sov(uint64(x.Since))
+		}
+		if x.MinSeverity != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinSeverity))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MinSeverity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinSeverity))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Since != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Since))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				}
+				x.Since = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Since |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSeverity", wireType)
+				}
+				x.MinSeverity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinSeverity |= AudioStemLogs_AudioStemLog_SEVERITY(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetAudioStemLogsResponse                 protoreflect.MessageDescriptor
+	fd_QueryGetAudioStemLogsResponse_audio_stem_logs protoreflect.FieldDescriptor
+	fd_QueryGetAudioStemLogsResponse_pagination      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetAudioStemLogsResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetAudioStemLogsResponse")
+	fd_QueryGetAudioStemLogsResponse_audio_stem_logs = md_QueryGetAudioStemLogsResponse.Fields().ByName("audio_stem_logs")
+	fd_QueryGetAudioStemLogsResponse_pagination = md_QueryGetAudioStemLogsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetAudioStemLogsResponse)(nil)
+
+type fastReflection_QueryGetAudioStemLogsResponse QueryGetAudioStemLogsResponse
+
+func (x *QueryGetAudioStemLogsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemLogsResponse)(x)
+}
+
+func (x *QueryGetAudioStemLogsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetAudioStemLogsResponse_messageType fastReflection_QueryGetAudioStemLogsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAudioStemLogsResponse_messageType{}
+
+type fastReflection_QueryGetAudioStemLogsResponse_messageType struct{}
+
+func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAudioStemLogsResponse)(nil)
+}
+func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemLogsResponse)
+}
+func (x fastReflection_QueryGetAudioStemLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemLogsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAudioStemLogsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAudioStemLogsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAudioStemLogsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAudioStemLogsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AudioStemLogs != nil {
+		value := protoreflect.ValueOfMessage(x.AudioStemLogs.ProtoReflect())
+		if !f(fd_QueryGetAudioStemLogsResponse_audio_stem_logs, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetAudioStemLogsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		return x.AudioStemLogs != nil
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		x.AudioStemLogs = nil
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		value := x.AudioStemLogs
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		x.AudioStemLogs = value.Message().Interface().(*AudioStemLogs)
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		if x.AudioStemLogs == nil {
+			x.AudioStemLogs = new(AudioStemLogs)
+		}
+		return protoreflect.ValueOfMessage(x.AudioStemLogs.ProtoReflect())
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs":
+		m := new(AudioStemLogs)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetAudioStemLogsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetAudioStemLogsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetAudioStemLogsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetAudioStemLogsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AudioStemLogs != nil {
+			l = options.Size(x.AudioStemLogs)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AudioStemLogs != nil {
+			encoded, err := options.Marshal(x.AudioStemLogs)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAudioStemLogsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAudioStemLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AudioStemLogs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AudioStemLogs == nil {
+					x.AudioStemLogs = &AudioStemLogs{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AudioStemLogs); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetPendingAudioStemTaskRequest            protoreflect.MessageDescriptor
+	fd_QueryGetPendingAudioStemTaskRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetPendingAudioStemTaskRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetPendingAudioStemTaskRequest")
+	fd_QueryGetPendingAudioStemTaskRequest_pagination = md_QueryGetPendingAudioStemTaskRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetPendingAudioStemTaskRequest)(nil)
+
+type fastReflection_QueryGetPendingAudioStemTaskRequest QueryGetPendingAudioStemTaskRequest
+
+func (x *QueryGetPendingAudioStemTaskRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetPendingAudioStemTaskRequest)(x)
+}
+
+func (x *QueryGetPendingAudioStemTaskRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetPendingAudioStemTaskRequest_messageType fastReflection_QueryGetPendingAudioStemTaskRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetPendingAudioStemTaskRequest_messageType{}
+
+type fastReflection_QueryGetPendingAudioStemTaskRequest_messageType struct{}
+
+func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetPendingAudioStemTaskRequest)(nil)
+}
+func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetPendingAudioStemTaskRequest)
+}
+func (x fastReflection_QueryGetPendingAudioStemTaskRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetPendingAudioStemTaskRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetPendingAudioStemTaskRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetPendingAudioStemTaskRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetPendingAudioStemTaskRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetPendingAudioStemTaskRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetPendingAudioStemTaskRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetPendingAudioStemTaskResponse_1_list)(nil)
+
+type _QueryGetPendingAudioStemTaskResponse_1_list struct {
+	list *[]*AudioStemTask
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AudioStemTask)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AudioStemTask)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AudioStemTask)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AudioStemTask)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetPendingAudioStemTaskResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetPendingAudioStemTaskResponse                  protoreflect.MessageDescriptor
+	fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks protoreflect.FieldDescriptor
+	fd_QueryGetPendingAudioStemTaskResponse_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetPendingAudioStemTaskResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetPendingAudioStemTaskResponse")
+	fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks = md_QueryGetPendingAudioStemTaskResponse.Fields().ByName("audio_stem_tasks")
+	fd_QueryGetPendingAudioStemTaskResponse_pagination = md_QueryGetPendingAudioStemTaskResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetPendingAudioStemTaskResponse)(nil)
+
+type fastReflection_QueryGetPendingAudioStemTaskResponse QueryGetPendingAudioStemTaskResponse
+
+func (x *QueryGetPendingAudioStemTaskResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetPendingAudioStemTaskResponse)(x)
+}
+
+func (x *QueryGetPendingAudioStemTaskResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetPendingAudioStemTaskResponse_messageType fastReflection_QueryGetPendingAudioStemTaskResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetPendingAudioStemTaskResponse_messageType{}
+
+type fastReflection_QueryGetPendingAudioStemTaskResponse_messageType struct{}
+
+func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetPendingAudioStemTaskResponse)(nil)
+}
+func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetPendingAudioStemTaskResponse)
+}
+func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetPendingAudioStemTaskResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetPendingAudioStemTaskResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetPendingAudioStemTaskResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetPendingAudioStemTaskResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetPendingAudioStemTaskResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AudioStemTasks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks})
+		if !f(fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetPendingAudioStemTaskResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		return len(x.AudioStemTasks) != 0
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		x.AudioStemTasks = nil
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		if len(x.AudioStemTasks) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{})
+		}
+		listValue := &_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks}
+		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		lv := value.List()
+		clv := lv.(*_QueryGetPendingAudioStemTaskResponse_1_list)
+		x.AudioStemTasks = *clv.list
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		if x.AudioStemTasks == nil {
+			x.AudioStemTasks = []*AudioStemTask{}
+		}
+		value := &_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks}
+		return protoreflect.ValueOfList(value)
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+		list := []*AudioStemTask{}
+		return protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{list: &list})
+	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AudioStemTasks) > 0 {
+			for _, e := range x.AudioStemTasks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AudioStemTasks) > 0 {
+			for iNdEx := len(x.AudioStemTasks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AudioStemTasks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AudioStemTasks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AudioStemTasks = append(x.AudioStemTasks, &AudioStemTask{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AudioStemTasks[len(x.AudioStemTasks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetWorkerRequest        protoreflect.MessageDescriptor
+	fd_QueryGetWorkerRequest_worker protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetWorkerRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkerRequest")
+	fd_QueryGetWorkerRequest_worker = md_QueryGetWorkerRequest.Fields().ByName("worker")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetWorkerRequest)(nil)
+
+type fastReflection_QueryGetWorkerRequest QueryGetWorkerRequest
+
+func (x *QueryGetWorkerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkerRequest)(x)
+}
+
+func (x *QueryGetWorkerRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetWorkerRequest_messageType fastReflection_QueryGetWorkerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetWorkerRequest_messageType{}
+
+type fastReflection_QueryGetWorkerRequest_messageType struct{}
+
+func (x fastReflection_QueryGetWorkerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkerRequest)(nil)
+}
+func (x fastReflection_QueryGetWorkerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkerRequest)
+}
+func (x fastReflection_QueryGetWorkerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkerRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetWorkerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkerRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetWorkerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetWorkerRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetWorkerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkerRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetWorkerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetWorkerRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetWorkerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_QueryGetWorkerRequest_worker, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetWorkerRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		return x.Worker != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		x.Worker = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetWorkerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		value := x.Worker
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		x.Worker = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		panic(fmt.Errorf("field worker of message janction.audioStem.v1.QueryGetWorkerRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetWorkerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetWorkerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkerRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetWorkerRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetWorkerRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Worker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Worker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetWorkerResponse        protoreflect.MessageDescriptor
+	fd_QueryGetWorkerResponse_worker protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetWorkerResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkerResponse")
+	fd_QueryGetWorkerResponse_worker = md_QueryGetWorkerResponse.Fields().ByName("worker")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetWorkerResponse)(nil)
+
+type fastReflection_QueryGetWorkerResponse QueryGetWorkerResponse
+
+func (x *QueryGetWorkerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkerResponse)(x)
+}
+
+func (x *QueryGetWorkerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetWorkerResponse_messageType fastReflection_QueryGetWorkerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetWorkerResponse_messageType{}
+
+type fastReflection_QueryGetWorkerResponse_messageType struct{}
+
+func (x fastReflection_QueryGetWorkerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkerResponse)(nil)
+}
+func (x fastReflection_QueryGetWorkerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkerResponse)
+}
+func (x fastReflection_QueryGetWorkerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetWorkerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetWorkerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetWorkerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetWorkerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetWorkerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetWorkerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetWorkerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Worker != nil {
+		value := protoreflect.ValueOfMessage(x.Worker.ProtoReflect())
+		if !f(fd_QueryGetWorkerResponse_worker, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetWorkerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		return x.Worker != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		x.Worker = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetWorkerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		value := x.Worker
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		x.Worker = value.Message().Interface().(*Worker)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		if x.Worker == nil {
+			x.Worker = new(Worker)
+		}
+		return protoreflect.ValueOfMessage(x.Worker.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetWorkerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
+		m := new(Worker)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetWorkerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetWorkerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetWorkerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Worker != nil {
+			l = options.Size(x.Worker)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Worker != nil {
+			encoded, err := options.Marshal(x.Worker)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Worker == nil {
+					x.Worker = &Worker{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Worker); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetWorkersRequest            protoreflect.MessageDescriptor
+	fd_QueryGetWorkersRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_query_proto_init()
+	md_QueryGetWorkersRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkersRequest")
+	fd_QueryGetWorkersRequest_pagination = md_QueryGetWorkersRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetWorkersRequest)(nil)
+
+type fastReflection_QueryGetWorkersRequest QueryGetWorkersRequest
+
+func (x *QueryGetWorkersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkersRequest)(x)
+}
+
+func (x *QueryGetWorkersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetWorkersRequest_messageType fastReflection_QueryGetWorkersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetWorkersRequest_messageType{}
+
+type fastReflection_QueryGetWorkersRequest_messageType struct{}
+
+func (x fastReflection_QueryGetWorkersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkersRequest)(nil)
+}
+func (x fastReflection_QueryGetWorkersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkersRequest)
+}
+func (x fastReflection_QueryGetWorkersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetWorkersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetWorkersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetWorkersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetWorkersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetWorkersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetWorkersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetWorkersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetWorkersRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetWorkersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetWorkersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1844,13 +4877,16 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1864,13 +4900,15 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetWorkersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1884,36 +4922,44 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.QueryGetWorkersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetWorkersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1921,7 +4967,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetWorkersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1932,7 +4978,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetWorkersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1944,7 +4990,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) IsValid() bool {
+func (x *fastReflection_QueryGetWorkersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1954,9 +5000,9 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetWorkersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		x := input.Message.Interface().(*QueryGetWorkersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1968,6 +5014,10 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1978,7 +5028,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		x := input.Message.Interface().(*QueryGetWorkersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1997,6 +5047,20 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2008,7 +5072,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskRequest)
+		x := input.Message.Interface().(*QueryGetWorkersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2040,12 +5104,48 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2081,78 +5181,80 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskRequest) ProtoMethods() *pro
 	}
 }
 
-var _ protoreflect.List = (*_QueryGetPendingAudioStemTaskResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGetWorkersResponse_1_list)(nil)
 
-type _QueryGetPendingAudioStemTaskResponse_1_list struct {
-	list *[]*AudioStemTask
+type _QueryGetWorkersResponse_1_list struct {
+	list *[]*Worker
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Len() int {
+func (x *_QueryGetWorkersResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGetWorkersResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGetWorkersResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AudioStemTask)
+	concreteValue := valueUnwrapped.Interface().(*Worker)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGetWorkersResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AudioStemTask)
+	concreteValue := valueUnwrapped.Interface().(*Worker)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(AudioStemTask)
+func (x *_QueryGetWorkersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Worker)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) Truncate(n int) {
+func (x *_QueryGetWorkersResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) NewElement() protoreflect.Value {
-	v := new(AudioStemTask)
+func (x *_QueryGetWorkersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Worker)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetPendingAudioStemTaskResponse_1_list) IsValid() bool {
+func (x *_QueryGetWorkersResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGetPendingAudioStemTaskResponse                  protoreflect.MessageDescriptor
-	fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks protoreflect.FieldDescriptor
+	md_QueryGetWorkersResponse            protoreflect.MessageDescriptor
+	fd_QueryGetWorkersResponse_workers    protoreflect.FieldDescriptor
+	fd_QueryGetWorkersResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetPendingAudioStemTaskResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetPendingAudioStemTaskResponse")
-	fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks = md_QueryGetPendingAudioStemTaskResponse.Fields().ByName("audio_stem_tasks")
+	md_QueryGetWorkersResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkersResponse")
+	fd_QueryGetWorkersResponse_workers = md_QueryGetWorkersResponse.Fields().ByName("workers")
+	fd_QueryGetWorkersResponse_pagination = md_QueryGetWorkersResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetPendingAudioStemTaskResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetWorkersResponse)(nil)
 
-type fastReflection_QueryGetPendingAudioStemTaskResponse QueryGetPendingAudioStemTaskResponse
+type fastReflection_QueryGetWorkersResponse QueryGetWorkersResponse
 
-func (x *QueryGetPendingAudioStemTaskResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetPendingAudioStemTaskResponse)(x)
+func (x *QueryGetWorkersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkersResponse)(x)
 }
 
-func (x *QueryGetPendingAudioStemTaskResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_query_proto_msgTypes[5]
+func (x *QueryGetWorkersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,43 +5265,43 @@ func (x *QueryGetPendingAudioStemTaskResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetPendingAudioStemTaskResponse_messageType fastReflection_QueryGetPendingAudioStemTaskResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetPendingAudioStemTaskResponse_messageType{}
+var _fastReflection_QueryGetWorkersResponse_messageType fastReflection_QueryGetWorkersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetWorkersResponse_messageType{}
 
-type fastReflection_QueryGetPendingAudioStemTaskResponse_messageType struct{}
+type fastReflection_QueryGetWorkersResponse_messageType struct{}
 
-func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetPendingAudioStemTaskResponse)(nil)
+func (x fastReflection_QueryGetWorkersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetWorkersResponse)(nil)
 }
-func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetPendingAudioStemTaskResponse)
+func (x fastReflection_QueryGetWorkersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkersResponse)
 }
-func (x fastReflection_QueryGetPendingAudioStemTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetPendingAudioStemTaskResponse
+func (x fastReflection_QueryGetWorkersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetPendingAudioStemTaskResponse
+func (x *fastReflection_QueryGetWorkersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetWorkersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetPendingAudioStemTaskResponse_messageType
+func (x *fastReflection_QueryGetWorkersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetWorkersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetPendingAudioStemTaskResponse)
+func (x *fastReflection_QueryGetWorkersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetWorkersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetPendingAudioStemTaskResponse)(x)
+func (x *fastReflection_QueryGetWorkersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetWorkersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2207,10 +5309,16 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AudioStemTasks) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks})
-		if !f(fd_QueryGetPendingAudioStemTaskResponse_audio_stem_tasks, value) {
+func (x *fastReflection_QueryGetWorkersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Workers) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetWorkersResponse_1_list{list: &x.Workers})
+		if !f(fd_QueryGetWorkersResponse_workers, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetWorkersResponse_pagination, value) {
 			return
 		}
 	}
@@ -2227,15 +5335,17 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetWorkersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
-		return len(x.AudioStemTasks) != 0
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
+		return len(x.Workers) != 0
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2245,15 +5355,17 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetWorkersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
-		x.AudioStemTasks = nil
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
+		x.Workers = nil
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2263,19 +5375,22 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
-		if len(x.AudioStemTasks) == 0 {
-			return protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{})
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
+		if len(x.Workers) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetWorkersResponse_1_list{})
 		}
-		listValue := &_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks}
+		listValue := &_QueryGetWorkersResponse_1_list{list: &x.Workers}
 		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2289,17 +5404,19 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetWorkersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
 		lv := value.List()
-		clv := lv.(*_QueryGetPendingAudioStemTaskResponse_1_list)
-		x.AudioStemTasks = *clv.list
+		clv := lv.(*_QueryGetWorkersResponse_1_list)
+		x.Workers = *clv.list
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2313,45 +5430,53 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
-		if x.AudioStemTasks == nil {
-			x.AudioStemTasks = []*AudioStemTask{}
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
+		if x.Workers == nil {
+			x.Workers = []*Worker{}
 		}
-		value := &_QueryGetPendingAudioStemTaskResponse_1_list{list: &x.AudioStemTasks}
+		value := &_QueryGetWorkersResponse_1_list{list: &x.Workers}
 		return protoreflect.ValueOfList(value)
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetWorkersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks":
-		list := []*AudioStemTask{}
-		return protoreflect.ValueOfList(&_QueryGetPendingAudioStemTaskResponse_1_list{list: &list})
+	case "janction.audioStem.v1.QueryGetWorkersResponse.workers":
+		list := []*Worker{}
+		return protoreflect.ValueOfList(&_QueryGetWorkersResponse_1_list{list: &list})
+	case "janction.audioStem.v1.QueryGetWorkersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkersResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetWorkersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2359,7 +5484,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetWorkersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2370,7 +5495,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetWorkersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2382,7 +5507,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) IsValid() bool {
+func (x *fastReflection_QueryGetWorkersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2392,9 +5517,9 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetWorkersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		x := input.Message.Interface().(*QueryGetWorkersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2406,12 +5531,16 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		if len(x.AudioStemTasks) > 0 {
-			for _, e := range x.AudioStemTasks {
+		if len(x.Workers) > 0 {
+			for _, e := range x.Workers {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2422,7 +5551,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		x := input.Message.Interface().(*QueryGetWorkersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2441,9 +5570,23 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AudioStemTasks) > 0 {
-			for iNdEx := len(x.AudioStemTasks) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AudioStemTasks[iNdEx])
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Workers) > 0 {
+			for iNdEx := len(x.Workers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Workers[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2468,7 +5611,7 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetPendingAudioStemTaskResponse)
+		x := input.Message.Interface().(*QueryGetWorkersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2500,15 +5643,15 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AudioStemTasks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2535,8 +5678,44 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AudioStemTasks = append(x.AudioStemTasks, &AudioStemTask{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AudioStemTasks[len(x.AudioStemTasks)-1]); err != nil {
+				x.Workers = append(x.Workers, &Worker{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Workers[len(x.Workers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2576,26 +5755,24 @@ func (x *fastReflection_QueryGetPendingAudioStemTaskResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryGetWorkerRequest        protoreflect.MessageDescriptor
-	fd_QueryGetWorkerRequest_worker protoreflect.FieldDescriptor
+	md_QueryGetParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetWorkerRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkerRequest")
-	fd_QueryGetWorkerRequest_worker = md_QueryGetWorkerRequest.Fields().ByName("worker")
+	md_QueryGetParamsRequest = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetWorkerRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetParamsRequest)(nil)
 
-type fastReflection_QueryGetWorkerRequest QueryGetWorkerRequest
+type fastReflection_QueryGetParamsRequest QueryGetParamsRequest
 
-func (x *QueryGetWorkerRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetWorkerRequest)(x)
+func (x *QueryGetParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetParamsRequest)(x)
 }
 
-func (x *QueryGetWorkerRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_query_proto_msgTypes[6]
+func (x *QueryGetParamsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2606,43 +5783,43 @@ func (x *QueryGetWorkerRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetWorkerRequest_messageType fastReflection_QueryGetWorkerRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetWorkerRequest_messageType{}
-
-type fastReflection_QueryGetWorkerRequest_messageType struct{}
+var _fastReflection_QueryGetParamsRequest_messageType fastReflection_QueryGetParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetParamsRequest_messageType{}
 
-func (x fastReflection_QueryGetWorkerRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetWorkerRequest)(nil)
+type fastReflection_QueryGetParamsRequest_messageType struct{}
+
+func (x fastReflection_QueryGetParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetParamsRequest)(nil)
 }
-func (x fastReflection_QueryGetWorkerRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetWorkerRequest)
+func (x fastReflection_QueryGetParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParamsRequest)
 }
-func (x fastReflection_QueryGetWorkerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetWorkerRequest
+func (x fastReflection_QueryGetParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetWorkerRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetWorkerRequest
+func (x *fastReflection_QueryGetParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetWorkerRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetWorkerRequest_messageType
+func (x *fastReflection_QueryGetParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetWorkerRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetWorkerRequest)
+func (x *fastReflection_QueryGetParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetWorkerRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetWorkerRequest)(x)
+func (x *fastReflection_QueryGetParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2650,13 +5827,7 @@ func (x *fastReflection_QueryGetWorkerRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetWorkerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Worker != "" {
-		value := protoreflect.ValueOfString(x.Worker)
-		if !f(fd_QueryGetWorkerRequest_worker, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryGetParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2670,15 +5841,13 @@ func (x *fastReflection_QueryGetWorkerRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetWorkerRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		return x.Worker != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2688,15 +5857,13 @@ func (x *fastReflection_QueryGetWorkerRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		x.Worker = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2706,16 +5873,13 @@ func (x *fastReflection_QueryGetWorkerRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetWorkerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		value := x.Worker
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2729,15 +5893,13 @@ func (x *fastReflection_QueryGetWorkerRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		x.Worker = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2751,40 +5913,36 @@ func (x *fastReflection_QueryGetWorkerRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		panic(fmt.Errorf("field worker of message janction.audioStem.v1.QueryGetWorkerRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetWorkerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerRequest.worker":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsRequest"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetWorkerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkerRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2792,7 +5950,7 @@ func (x *fastReflection_QueryGetWorkerRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetWorkerRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2803,7 +5961,7 @@ func (x *fastReflection_QueryGetWorkerRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2815,7 +5973,7 @@ func (x *fastReflection_QueryGetWorkerRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetWorkerRequest) IsValid() bool {
+func (x *fastReflection_QueryGetParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2825,9 +5983,9 @@ func (x *fastReflection_QueryGetWorkerRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		x := input.Message.Interface().(*QueryGetParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2839,10 +5997,6 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Worker)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2853,7 +6007,7 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		x := input.Message.Interface().(*QueryGetParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2872,13 +6026,6 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Worker) > 0 {
-			i -= len(x.Worker)
-			copy(dAtA[i:], x.Worker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2890,7 +6037,7 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetWorkerRequest)
+		x := input.Message.Interface().(*QueryGetParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2922,44 +6069,12 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Worker = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2996,26 +6111,26 @@ func (x *fastReflection_QueryGetWorkerRequest) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryGetWorkerResponse        protoreflect.MessageDescriptor
-	fd_QueryGetWorkerResponse_worker protoreflect.FieldDescriptor
+	md_QueryGetParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryGetParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_query_proto_init()
-	md_QueryGetWorkerResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetWorkerResponse")
-	fd_QueryGetWorkerResponse_worker = md_QueryGetWorkerResponse.Fields().ByName("worker")
+	md_QueryGetParamsResponse = File_janction_audioStem_v1_query_proto.Messages().ByName("QueryGetParamsResponse")
+	fd_QueryGetParamsResponse_params = md_QueryGetParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetWorkerResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetParamsResponse)(nil)
 
-type fastReflection_QueryGetWorkerResponse QueryGetWorkerResponse
+type fastReflection_QueryGetParamsResponse QueryGetParamsResponse
 
-func (x *QueryGetWorkerResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetWorkerResponse)(x)
+func (x *QueryGetParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetParamsResponse)(x)
 }
 
-func (x *QueryGetWorkerResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_query_proto_msgTypes[7]
+func (x *QueryGetParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3026,43 +6141,43 @@ func (x *QueryGetWorkerResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetWorkerResponse_messageType fastReflection_QueryGetWorkerResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetWorkerResponse_messageType{}
+var _fastReflection_QueryGetParamsResponse_messageType fastReflection_QueryGetParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetParamsResponse_messageType{}
 
-type fastReflection_QueryGetWorkerResponse_messageType struct{}
+type fastReflection_QueryGetParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryGetWorkerResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetWorkerResponse)(nil)
+func (x fastReflection_QueryGetParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetParamsResponse)(nil)
 }
-func (x fastReflection_QueryGetWorkerResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetWorkerResponse)
+func (x fastReflection_QueryGetParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParamsResponse)
 }
-func (x fastReflection_QueryGetWorkerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetWorkerResponse
+func (x fastReflection_QueryGetParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetWorkerResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetWorkerResponse
+func (x *fastReflection_QueryGetParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetWorkerResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetWorkerResponse_messageType
+func (x *fastReflection_QueryGetParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetWorkerResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetWorkerResponse)
+func (x *fastReflection_QueryGetParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetWorkerResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetWorkerResponse)(x)
+func (x *fastReflection_QueryGetParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3070,10 +6185,10 @@ func (x *fastReflection_QueryGetWorkerResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetWorkerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Worker != nil {
-		value := protoreflect.ValueOfMessage(x.Worker.ProtoReflect())
-		if !f(fd_QueryGetWorkerResponse_worker, value) {
+func (x *fastReflection_QueryGetParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryGetParamsResponse_params, value) {
 			return
 		}
 	}
@@ -3090,15 +6205,15 @@ func (x *fastReflection_QueryGetWorkerResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetWorkerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		return x.Worker != nil
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3108,15 +6223,15 @@ func (x *fastReflection_QueryGetWorkerResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		x.Worker = nil
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3126,16 +6241,16 @@ func (x *fastReflection_QueryGetWorkerResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetWorkerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		value := x.Worker
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3149,15 +6264,15 @@ func (x *fastReflection_QueryGetWorkerResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		x.Worker = value.Message().Interface().(*Worker)
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3171,44 +6286,44 @@ func (x *fastReflection_QueryGetWorkerResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		if x.Worker == nil {
-			x.Worker = new(Worker)
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.Worker.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetWorkerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.QueryGetWorkerResponse.worker":
-		m := new(Worker)
+	case "janction.audioStem.v1.QueryGetParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetWorkerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.QueryGetParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetWorkerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.QueryGetParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetWorkerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetWorkerResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.QueryGetParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3216,7 +6331,7 @@ func (x *fastReflection_QueryGetWorkerResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetWorkerResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3227,7 +6342,7 @@ func (x *fastReflection_QueryGetWorkerResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetWorkerResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3239,7 +6354,7 @@ func (x *fastReflection_QueryGetWorkerResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetWorkerResponse) IsValid() bool {
+func (x *fastReflection_QueryGetParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3249,9 +6364,9 @@ func (x *fastReflection_QueryGetWorkerResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		x := input.Message.Interface().(*QueryGetParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3263,8 +6378,8 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Worker != nil {
-			l = options.Size(x.Worker)
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3277,7 +6392,7 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		x := input.Message.Interface().(*QueryGetParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3296,8 +6411,8 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Worker != nil {
-			encoded, err := options.Marshal(x.Worker)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3321,7 +6436,7 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetWorkerResponse)
+		x := input.Message.Interface().(*QueryGetParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3353,15 +6468,15 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3388,10 +6503,10 @@ func (x *fastReflection_QueryGetWorkerResponse) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Worker == nil {
-					x.Worker = &Worker{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Worker); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3466,72 +6581,160 @@ func (x *QueryGetAudioStemTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetAudioStemTaskRequest) ProtoMessage() {}
+func (*QueryGetAudioStemTaskRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetAudioStemTaskRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetAudioStemTaskRequest) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryGetAudioStemTaskRequest) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+// QueryGetGameResponse is the response type for the Query/GetGame RPC
+// method.
+type QueryGetAudioStemTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Game defines the game at the requested index.
+	AudioStemTask *AudioStemTask `protobuf:"bytes,1,opt,name=audio_stem_task,json=audioStemTask,proto3" json:"audio_stem_task,omitempty"`
+}
+
+func (x *QueryGetAudioStemTaskResponse) Reset() {
+	*x = QueryGetAudioStemTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetAudioStemTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetAudioStemTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetAudioStemTaskResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetAudioStemTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryGetAudioStemTaskResponse) GetAudioStemTask() *AudioStemTask {
+	if x != nil {
+		return x.AudioStemTask
+	}
+	return nil
+}
+
+// QueryGetAudioStemThreadRequest is the request type for the Query/GetAudioStemThread RPC
+// method.
+type QueryGetAudioStemThreadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Index  uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *QueryGetAudioStemThreadRequest) Reset() {
+	*x = QueryGetAudioStemThreadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetAudioStemThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetAudioStemThreadRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetAudioStemTaskRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetAudioStemTaskRequest) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryGetAudioStemThreadRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetAudioStemThreadRequest) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *QueryGetAudioStemTaskRequest) GetIndex() string {
+func (x *QueryGetAudioStemThreadRequest) GetTaskId() string {
 	if x != nil {
-		return x.Index
+		return x.TaskId
 	}
 	return ""
 }
 
-// QueryGetGameResponse is the response type for the Query/GetGame RPC
+func (x *QueryGetAudioStemThreadRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// QueryGetAudioStemThreadResponse is the response type for the Query/GetAudioStemThread RPC
 // method.
-type QueryGetAudioStemTaskResponse struct {
+type QueryGetAudioStemThreadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Game defines the game at the requested index.
-	AudioStemTask *AudioStemTask `protobuf:"bytes,1,opt,name=audio_stem_task,json=audioStemTask,proto3" json:"audio_stem_task,omitempty"`
+	// empty if the thread doesn't exist
+	Thread *AudioStemThread `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
 }
 
-func (x *QueryGetAudioStemTaskResponse) Reset() {
-	*x = QueryGetAudioStemTaskResponse{}
+func (x *QueryGetAudioStemThreadResponse) Reset() {
+	*x = QueryGetAudioStemThreadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[1]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetAudioStemTaskResponse) String() string {
+func (x *QueryGetAudioStemThreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetAudioStemTaskResponse) ProtoMessage() {}
+func (*QueryGetAudioStemThreadResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetAudioStemTaskResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetAudioStemTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryGetAudioStemThreadResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetAudioStemThreadResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *QueryGetAudioStemTaskResponse) GetAudioStemTask() *AudioStemTask {
+func (x *QueryGetAudioStemThreadResponse) GetThread() *AudioStemThread {
 	if x != nil {
-		return x.AudioStemTask
+		return x.Thread
 	}
 	return nil
 }
 
-// QueryGetGameRequest is the request type for the Query/GetGame RPC
-// method.
+// QueryGetAudioStemLogsRequest selects the logs a worker keeps for a thread. Logs live in
+// the database of the worker, so they are read with its logs command instead of the chain.
 type QueryGetAudioStemLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	// since filters out the logs with a timestamp before it.
+	Since int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	// min_severity filters out the logs less severe than it.
+	MinSeverity AudioStemLogs_AudioStemLog_SEVERITY `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=janction.audioStem.v1.AudioStemLogs_AudioStemLog_SEVERITY" json:"min_severity,omitempty"`
+	Pagination  *v1beta1.PageRequest                `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryGetAudioStemLogsRequest) Reset() {
 	*x = QueryGetAudioStemLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[2]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3545,7 +6748,7 @@ func (*QueryGetAudioStemLogsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetAudioStemLogsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetAudioStemLogsRequest) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{2}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QueryGetAudioStemLogsRequest) GetThreadId() string {
@@ -3555,21 +6758,41 @@ func (x *QueryGetAudioStemLogsRequest) GetThreadId() string {
 	return ""
 }
 
-// QueryGetGameResponse is the response type for the Query/GetGame RPC
-// method.
+func (x *QueryGetAudioStemLogsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *QueryGetAudioStemLogsRequest) GetMinSeverity() AudioStemLogs_AudioStemLog_SEVERITY {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return AudioStemLogs_AudioStemLog_INFO
+}
+
+func (x *QueryGetAudioStemLogsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGetAudioStemLogsResponse is the page of logs the worker read for a thread.
 type QueryGetAudioStemLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Game defines the game at the requested index.
-	AudioStemLogs *AudioStemLogs `protobuf:"bytes,1,opt,name=audio_stem_logs,json=audioStemLogs,proto3" json:"audio_stem_logs,omitempty"`
+	AudioStemLogs *AudioStemLogs        `protobuf:"bytes,1,opt,name=audio_stem_logs,json=audioStemLogs,proto3" json:"audio_stem_logs,omitempty"`
+	Pagination    *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryGetAudioStemLogsResponse) Reset() {
 	*x = QueryGetAudioStemLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[3]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3583,7 +6806,7 @@ func (*QueryGetAudioStemLogsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetAudioStemLogsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAudioStemLogsResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{3}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *QueryGetAudioStemLogsResponse) GetAudioStemLogs() *AudioStemLogs {
@@ -3593,16 +6816,25 @@ func (x *QueryGetAudioStemLogsResponse) GetAudioStemLogs() *AudioStemLogs {
 	return nil
 }
 
+func (x *QueryGetAudioStemLogsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 type QueryGetPendingAudioStemTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryGetPendingAudioStemTaskRequest) Reset() {
 	*x = QueryGetPendingAudioStemTaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[4]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3616,7 +6848,14 @@ func (*QueryGetPendingAudioStemTaskRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetPendingAudioStemTaskRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetPendingAudioStemTaskRequest) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGetPendingAudioStemTaskRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
 type QueryGetPendingAudioStemTaskResponse struct {
@@ -3624,13 +6863,14 @@ type QueryGetPendingAudioStemTaskResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AudioStemTasks []*AudioStemTask `protobuf:"bytes,1,rep,name=audio_stem_tasks,json=audioStemTasks,proto3" json:"audio_stem_tasks,omitempty"`
+	AudioStemTasks []*AudioStemTask      `protobuf:"bytes,1,rep,name=audio_stem_tasks,json=audioStemTasks,proto3" json:"audio_stem_tasks,omitempty"`
+	Pagination     *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryGetPendingAudioStemTaskResponse) Reset() {
 	*x = QueryGetPendingAudioStemTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[5]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3644,7 +6884,7 @@ func (*QueryGetPendingAudioStemTaskResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetPendingAudioStemTaskResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetPendingAudioStemTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryGetPendingAudioStemTaskResponse) GetAudioStemTasks() []*AudioStemTask {
@@ -3654,6 +6894,13 @@ func (x *QueryGetPendingAudioStemTaskResponse) GetAudioStemTasks() []*AudioStemT
 	return nil
 }
 
+func (x *QueryGetPendingAudioStemTaskResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 type QueryGetWorkerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3665,7 +6912,7 @@ type QueryGetWorkerRequest struct {
 func (x *QueryGetWorkerRequest) Reset() {
 	*x = QueryGetWorkerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[6]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3679,7 +6926,7 @@ func (*QueryGetWorkerRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetWorkerRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetWorkerRequest) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryGetWorkerRequest) GetWorker() string {
@@ -3700,7 +6947,7 @@ type QueryGetWorkerResponse struct {
 func (x *QueryGetWorkerResponse) Reset() {
 	*x = QueryGetWorkerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_query_proto_msgTypes[7]
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3714,7 +6961,7 @@ func (*QueryGetWorkerResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetWorkerResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryGetWorkerResponse) GetWorker() *Worker {
@@ -3724,6 +6971,145 @@ func (x *QueryGetWorkerResponse) GetWorker() *Worker {
 	return nil
 }
 
+type QueryGetWorkersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGetWorkersRequest) Reset() {
+	*x = QueryGetWorkersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetWorkersRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetWorkersRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryGetWorkersRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryGetWorkersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workers    []*Worker             `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGetWorkersResponse) Reset() {
+	*x = QueryGetWorkersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetWorkersResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetWorkersResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryGetWorkersResponse) GetWorkers() []*Worker {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *QueryGetWorkersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryGetParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryGetParamsRequest) Reset() {
+	*x = QueryGetParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetParamsRequest) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+type QueryGetParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryGetParamsResponse) Reset() {
+	*x = QueryGetParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetParamsResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryGetParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 var File_janction_audioStem_v1_query_proto protoreflect.FileDescriptor
 
 var file_janction_audioStem_v1_query_proto_rawDesc = []byte{
@@ -3737,47 +7123,110 @@ var file_janction_audioStem_v1_query_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34,
-	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x6d, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73,
-	0x74, 0x65, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
-	0x61, 0x73, 0x6b, 0x22, 0x3a, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22,
-	0x6d, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c,
-	0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52,
-	0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x25,
-	0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0e, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x2f, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x4f,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34, 0x0a, 0x1c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x6d, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x22,
+	0x4f, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x61, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x22, 0xf7, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f,
+	0x67, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x2e,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb6, 0x01,
+	0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x6f,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x0d,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x32,
-	0x95, 0x05, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xaa, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0e,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x16, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x17, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32,
+	0xd7, 0x06, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xaa, 0x01, 0x0a, 0x10, 0x47, 0x65,
 	0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33,
 	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
 	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
@@ -3788,52 +7237,64 @@ var file_janction_audioStem_v1_query_proto_rawDesc = []byte{
 	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x88, 0xe7, 0xb0, 0x2a, 0x01,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x33, 0x2e, 0x6a, 0x61,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x35, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0x88, 0xe7,
+	0xb0, 0x2a, 0x01, 0x12, 0x96, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x7d, 0x12, 0x95, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3a, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
 	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
-	0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x7d, 0x12,
-	0x95, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3a, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xe2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
-	0x65, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x41, 0x58, 0xaa, 0x02,
-	0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x21, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x17, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x05, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x12, 0x6f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x05, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xe2, 0x01, 0x0a, 0x19, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x41, 0x58,
+	0xaa, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x21, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x3a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3848,38 +7309,63 @@ func file_janction_audioStem_v1_query_proto_rawDescGZIP() []byte {
 	return file_janction_audioStem_v1_query_proto_rawDescData
 }
 
-var file_janction_audioStem_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_janction_audioStem_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_janction_audioStem_v1_query_proto_goTypes = []interface{}{
 	(*QueryGetAudioStemTaskRequest)(nil),         // 0: janction.audioStem.v1.QueryGetAudioStemTaskRequest
 	(*QueryGetAudioStemTaskResponse)(nil),        // 1: janction.audioStem.v1.QueryGetAudioStemTaskResponse
-	(*QueryGetAudioStemLogsRequest)(nil),         // 2: janction.audioStem.v1.QueryGetAudioStemLogsRequest
-	(*QueryGetAudioStemLogsResponse)(nil),        // 3: janction.audioStem.v1.QueryGetAudioStemLogsResponse
-	(*QueryGetPendingAudioStemTaskRequest)(nil),  // 4: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest
-	(*QueryGetPendingAudioStemTaskResponse)(nil), // 5: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse
-	(*QueryGetWorkerRequest)(nil),                // 6: janction.audioStem.v1.QueryGetWorkerRequest
-	(*QueryGetWorkerResponse)(nil),               // 7: janction.audioStem.v1.QueryGetWorkerResponse
-	(*AudioStemTask)(nil),                        // 8: janction.audioStem.v1.AudioStemTask
-	(*AudioStemLogs)(nil),                        // 9: janction.audioStem.v1.AudioStemLogs
-	(*Worker)(nil),                               // 10: janction.audioStem.v1.Worker
+	(*QueryGetAudioStemThreadRequest)(nil),       // 2: janction.audioStem.v1.QueryGetAudioStemThreadRequest
+	(*QueryGetAudioStemThreadResponse)(nil),      // 3: janction.audioStem.v1.QueryGetAudioStemThreadResponse
+	(*QueryGetAudioStemLogsRequest)(nil),         // 4: janction.audioStem.v1.QueryGetAudioStemLogsRequest
+	(*QueryGetAudioStemLogsResponse)(nil),        // 5: janction.audioStem.v1.QueryGetAudioStemLogsResponse
+	(*QueryGetPendingAudioStemTaskRequest)(nil),  // 6: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest
+	(*QueryGetPendingAudioStemTaskResponse)(nil), // 7: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse
+	(*QueryGetWorkerRequest)(nil),                // 8: janction.audioStem.v1.QueryGetWorkerRequest
+	(*QueryGetWorkerResponse)(nil),               // 9: janction.audioStem.v1.QueryGetWorkerResponse
+	(*QueryGetWorkersRequest)(nil),               // 10: janction.audioStem.v1.QueryGetWorkersRequest
+	(*QueryGetWorkersResponse)(nil),              // 11: janction.audioStem.v1.QueryGetWorkersResponse
+	(*QueryGetParamsRequest)(nil),                // 12: janction.audioStem.v1.QueryGetParamsRequest
+	(*QueryGetParamsResponse)(nil),               // 13: janction.audioStem.v1.QueryGetParamsResponse
+	(*AudioStemTask)(nil),                        // 14: janction.audioStem.v1.AudioStemTask
+	(*AudioStemThread)(nil),                      // 15: janction.audioStem.v1.AudioStemThread
+	(AudioStemLogs_AudioStemLog_SEVERITY)(0),     // 16: janction.audioStem.v1.AudioStemLogs.AudioStemLog.SEVERITY
+	(*v1beta1.PageRequest)(nil),                  // 17: cosmos.base.query.v1beta1.PageRequest
+	(*AudioStemLogs)(nil),                        // 18: janction.audioStem.v1.AudioStemLogs
+	(*v1beta1.PageResponse)(nil),                 // 19: cosmos.base.query.v1beta1.PageResponse
+	(*Worker)(nil),                               // 20: janction.audioStem.v1.Worker
+	(*Params)(nil),                               // 21: janction.audioStem.v1.Params
 }
 var file_janction_audioStem_v1_query_proto_depIdxs = []int32{
-	8,  // 0: janction.audioStem.v1.QueryGetAudioStemTaskResponse.audio_stem_task:type_name -> janction.audioStem.v1.AudioStemTask
-	9,  // 1: janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs:type_name -> janction.audioStem.v1.AudioStemLogs
-	8,  // 2: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks:type_name -> janction.audioStem.v1.AudioStemTask
-	10, // 3: janction.audioStem.v1.QueryGetWorkerResponse.worker:type_name -> janction.audioStem.v1.Worker
-	0,  // 4: janction.audioStem.v1.Query.GetAudioStemTask:input_type -> janction.audioStem.v1.QueryGetAudioStemTaskRequest
-	2,  // 5: janction.audioStem.v1.Query.GetAudioStemLogs:input_type -> janction.audioStem.v1.QueryGetAudioStemLogsRequest
-	6,  // 6: janction.audioStem.v1.Query.GetWorker:input_type -> janction.audioStem.v1.QueryGetWorkerRequest
-	4,  // 7: janction.audioStem.v1.Query.GetPendingAudioStemTasks:input_type -> janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest
-	1,  // 8: janction.audioStem.v1.Query.GetAudioStemTask:output_type -> janction.audioStem.v1.QueryGetAudioStemTaskResponse
-	3,  // 9: janction.audioStem.v1.Query.GetAudioStemLogs:output_type -> janction.audioStem.v1.QueryGetAudioStemLogsResponse
-	7,  // 10: janction.audioStem.v1.Query.GetWorker:output_type -> janction.audioStem.v1.QueryGetWorkerResponse
-	5,  // 11: janction.audioStem.v1.Query.GetPendingAudioStemTasks:output_type -> janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: janction.audioStem.v1.QueryGetAudioStemTaskResponse.audio_stem_task:type_name -> janction.audioStem.v1.AudioStemTask
+	15, // 1: janction.audioStem.v1.QueryGetAudioStemThreadResponse.thread:type_name -> janction.audioStem.v1.AudioStemThread
+	16, // 2: janction.audioStem.v1.QueryGetAudioStemLogsRequest.min_severity:type_name -> janction.audioStem.v1.AudioStemLogs.AudioStemLog.SEVERITY
+	17, // 3: janction.audioStem.v1.QueryGetAudioStemLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	18, // 4: janction.audioStem.v1.QueryGetAudioStemLogsResponse.audio_stem_logs:type_name -> janction.audioStem.v1.AudioStemLogs
+	19, // 5: janction.audioStem.v1.QueryGetAudioStemLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	17, // 6: janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	14, // 7: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.audio_stem_tasks:type_name -> janction.audioStem.v1.AudioStemTask
+	19, // 8: janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	20, // 9: janction.audioStem.v1.QueryGetWorkerResponse.worker:type_name -> janction.audioStem.v1.Worker
+	17, // 10: janction.audioStem.v1.QueryGetWorkersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 11: janction.audioStem.v1.QueryGetWorkersResponse.workers:type_name -> janction.audioStem.v1.Worker
+	19, // 12: janction.audioStem.v1.QueryGetWorkersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	21, // 13: janction.audioStem.v1.QueryGetParamsResponse.params:type_name -> janction.audioStem.v1.Params
+	0,  // 14: janction.audioStem.v1.Query.GetAudioStemTask:input_type -> janction.audioStem.v1.QueryGetAudioStemTaskRequest
+	2,  // 15: janction.audioStem.v1.Query.GetAudioStemThread:input_type -> janction.audioStem.v1.QueryGetAudioStemThreadRequest
+	8,  // 16: janction.audioStem.v1.Query.GetWorker:input_type -> janction.audioStem.v1.QueryGetWorkerRequest
+	6,  // 17: janction.audioStem.v1.Query.GetPendingAudioStemTasks:input_type -> janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest
+	10, // 18: janction.audioStem.v1.Query.GetWorkers:input_type -> janction.audioStem.v1.QueryGetWorkersRequest
+	12, // 19: janction.audioStem.v1.Query.GetParams:input_type -> janction.audioStem.v1.QueryGetParamsRequest
+	1,  // 20: janction.audioStem.v1.Query.GetAudioStemTask:output_type -> janction.audioStem.v1.QueryGetAudioStemTaskResponse
+	3,  // 21: janction.audioStem.v1.Query.GetAudioStemThread:output_type -> janction.audioStem.v1.QueryGetAudioStemThreadResponse
+	9,  // 22: janction.audioStem.v1.Query.GetWorker:output_type -> janction.audioStem.v1.QueryGetWorkerResponse
+	7,  // 23: janction.audioStem.v1.Query.GetPendingAudioStemTasks:output_type -> janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse
+	11, // 24: janction.audioStem.v1.Query.GetWorkers:output_type -> janction.audioStem.v1.QueryGetWorkersResponse
+	13, // 25: janction.audioStem.v1.Query.GetParams:output_type -> janction.audioStem.v1.QueryGetParamsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_janction_audioStem_v1_query_proto_init() }
@@ -3914,7 +7400,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAudioStemLogsRequest); i {
+			switch v := v.(*QueryGetAudioStemThreadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3926,7 +7412,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAudioStemLogsResponse); i {
+			switch v := v.(*QueryGetAudioStemThreadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3938,7 +7424,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetPendingAudioStemTaskRequest); i {
+			switch v := v.(*QueryGetAudioStemLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3950,7 +7436,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetPendingAudioStemTaskResponse); i {
+			switch v := v.(*QueryGetAudioStemLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3962,7 +7448,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetWorkerRequest); i {
+			switch v := v.(*QueryGetPendingAudioStemTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3974,6 +7460,30 @@ func file_janction_audioStem_v1_query_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetPendingAudioStemTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetWorkerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGetWorkerResponse); i {
 			case 0:
 				return &v.state
@@ -3985,6 +7495,54 @@ func file_janction_audioStem_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_janction_audioStem_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetWorkersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetWorkersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3992,7 +7550,7 @@ func file_janction_audioStem_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_janction_audioStem_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
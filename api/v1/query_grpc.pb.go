@@ -20,9 +20,11 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_GetAudioStemTask_FullMethodName         = "/janction.audioStem.v1.Query/GetAudioStemTask"
-	Query_GetAudioStemLogs_FullMethodName         = "/janction.audioStem.v1.Query/GetAudioStemLogs"
+	Query_GetAudioStemThread_FullMethodName       = "/janction.audioStem.v1.Query/GetAudioStemThread"
 	Query_GetWorker_FullMethodName                = "/janction.audioStem.v1.Query/GetWorker"
 	Query_GetPendingAudioStemTasks_FullMethodName = "/janction.audioStem.v1.Query/GetPendingAudioStemTasks"
+	Query_GetWorkers_FullMethodName               = "/janction.audioStem.v1.Query/GetWorkers"
+	Query_GetParams_FullMethodName                = "/janction.audioStem.v1.Query/GetParams"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,9 +33,14 @@ const (
 type QueryClient interface {
 	// GetAudioStemTask returns the task based on the taskId
 	GetAudioStemTask(ctx context.Context, in *QueryGetAudioStemTaskRequest, opts ...grpc.CallOption) (*QueryGetAudioStemTaskResponse, error)
-	GetAudioStemLogs(ctx context.Context, in *QueryGetAudioStemLogsRequest, opts ...grpc.CallOption) (*QueryGetAudioStemLogsResponse, error)
+	// GetAudioStemThread returns a single thread of a task by its index
+	GetAudioStemThread(ctx context.Context, in *QueryGetAudioStemThreadRequest, opts ...grpc.CallOption) (*QueryGetAudioStemThreadResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingAudioStemTasks(ctx context.Context, in *QueryGetPendingAudioStemTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingAudioStemTaskResponse, error)
+	// GetWorkers returns the registered workers.
+	GetWorkers(ctx context.Context, in *QueryGetWorkersRequest, opts ...grpc.CallOption) (*QueryGetWorkersResponse, error)
+	// GetParams returns the parameters of the module.
+	GetParams(ctx context.Context, in *QueryGetParamsRequest, opts ...grpc.CallOption) (*QueryGetParamsResponse, error)
 }
 
 type queryClient struct {
@@ -53,9 +60,9 @@ func (c *queryClient) GetAudioStemTask(ctx context.Context, in *QueryGetAudioSte
 	return out, nil
 }
 
-func (c *queryClient) GetAudioStemLogs(ctx context.Context, in *QueryGetAudioStemLogsRequest, opts ...grpc.CallOption) (*QueryGetAudioStemLogsResponse, error) {
-	out := new(QueryGetAudioStemLogsResponse)
-	err := c.cc.Invoke(ctx, Query_GetAudioStemLogs_FullMethodName, in, out, opts...)
+func (c *queryClient) GetAudioStemThread(ctx context.Context, in *QueryGetAudioStemThreadRequest, opts ...grpc.CallOption) (*QueryGetAudioStemThreadResponse, error) {
+	out := new(QueryGetAudioStemThreadResponse)
+	err := c.cc.Invoke(ctx, Query_GetAudioStemThread_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,15 +87,38 @@ func (c *queryClient) GetPendingAudioStemTasks(ctx context.Context, in *QueryGet
 	return out, nil
 }
 
+func (c *queryClient) GetWorkers(ctx context.Context, in *QueryGetWorkersRequest, opts ...grpc.CallOption) (*QueryGetWorkersResponse, error) {
+	out := new(QueryGetWorkersResponse)
+	err := c.cc.Invoke(ctx, Query_GetWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetParams(ctx context.Context, in *QueryGetParamsRequest, opts ...grpc.CallOption) (*QueryGetParamsResponse, error) {
+	out := new(QueryGetParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// GetAudioStemTask returns the task based on the taskId
 	GetAudioStemTask(context.Context, *QueryGetAudioStemTaskRequest) (*QueryGetAudioStemTaskResponse, error)
-	GetAudioStemLogs(context.Context, *QueryGetAudioStemLogsRequest) (*QueryGetAudioStemLogsResponse, error)
+	// GetAudioStemThread returns a single thread of a task by its index
+	GetAudioStemThread(context.Context, *QueryGetAudioStemThreadRequest) (*QueryGetAudioStemThreadResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingAudioStemTasks(context.Context, *QueryGetPendingAudioStemTaskRequest) (*QueryGetPendingAudioStemTaskResponse, error)
+	// GetWorkers returns the registered workers.
+	GetWorkers(context.Context, *QueryGetWorkersRequest) (*QueryGetWorkersResponse, error)
+	// GetParams returns the parameters of the module.
+	GetParams(context.Context, *QueryGetParamsRequest) (*QueryGetParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -99,8 +129,8 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) GetAudioStemTask(context.Context, *QueryGetAudioStemTaskRequest) (*QueryGetAudioStemTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemTask not implemented")
 }
-func (UnimplementedQueryServer) GetAudioStemLogs(context.Context, *QueryGetAudioStemLogsRequest) (*QueryGetAudioStemLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemLogs not implemented")
+func (UnimplementedQueryServer) GetAudioStemThread(context.Context, *QueryGetAudioStemThreadRequest) (*QueryGetAudioStemThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemThread not implemented")
 }
 func (UnimplementedQueryServer) GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorker not implemented")
@@ -108,6 +138,12 @@ func (UnimplementedQueryServer) GetWorker(context.Context, *QueryGetWorkerReques
 func (UnimplementedQueryServer) GetPendingAudioStemTasks(context.Context, *QueryGetPendingAudioStemTaskRequest) (*QueryGetPendingAudioStemTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAudioStemTasks not implemented")
 }
+func (UnimplementedQueryServer) GetWorkers(context.Context, *QueryGetWorkersRequest) (*QueryGetWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkers not implemented")
+}
+func (UnimplementedQueryServer) GetParams(context.Context, *QueryGetParamsRequest) (*QueryGetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -139,20 +175,20 @@ func _Query_GetAudioStemTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetAudioStemLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAudioStemLogsRequest)
+func _Query_GetAudioStemThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAudioStemThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetAudioStemLogs(ctx, in)
+		return srv.(QueryServer).GetAudioStemThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_GetAudioStemLogs_FullMethodName,
+		FullMethod: Query_GetAudioStemThread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAudioStemLogs(ctx, req.(*QueryGetAudioStemLogsRequest))
+		return srv.(QueryServer).GetAudioStemThread(ctx, req.(*QueryGetAudioStemThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -193,6 +229,42 @@ func _Query_GetPendingAudioStemTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkers(ctx, req.(*QueryGetWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*QueryGetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -205,8 +277,8 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Query_GetAudioStemTask_Handler,
 		},
 		{
-			MethodName: "GetAudioStemLogs",
-			Handler:    _Query_GetAudioStemLogs_Handler,
+			MethodName: "GetAudioStemThread",
+			Handler:    _Query_GetAudioStemThread_Handler,
 		},
 		{
 			MethodName: "GetWorker",
@@ -216,6 +288,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingAudioStemTasks",
 			Handler:    _Query_GetPendingAudioStemTasks_Handler,
 		},
+		{
+			MethodName: "GetWorkers",
+			Handler:    _Query_GetWorkers_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/audioStem/v1/query.proto",
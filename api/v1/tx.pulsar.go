@@ -3235,59 +3235,14 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_MsgProposeSolution_5_list)(nil)
-
-type _MsgProposeSolution_5_list struct {
-	list *[]string
-}
-
-func (x *_MsgProposeSolution_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgProposeSolution_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgProposeSolution_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgProposeSolution_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgProposeSolution_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgProposeSolution at list field Signatures as it is not of Message kind"))
-}
-
-func (x *_MsgProposeSolution_5_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgProposeSolution_5_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgProposeSolution_5_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
 	md_MsgProposeSolution            protoreflect.MessageDescriptor
 	fd_MsgProposeSolution_creator    protoreflect.FieldDescriptor
 	fd_MsgProposeSolution_taskId     protoreflect.FieldDescriptor
 	fd_MsgProposeSolution_threadId   protoreflect.FieldDescriptor
 	fd_MsgProposeSolution_public_key protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_signatures protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_commitment protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_signature  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3297,7 +3252,8 @@ func init() {
 	fd_MsgProposeSolution_taskId = md_MsgProposeSolution.Fields().ByName("taskId")
 	fd_MsgProposeSolution_threadId = md_MsgProposeSolution.Fields().ByName("threadId")
 	fd_MsgProposeSolution_public_key = md_MsgProposeSolution.Fields().ByName("public_key")
-	fd_MsgProposeSolution_signatures = md_MsgProposeSolution.Fields().ByName("signatures")
+	fd_MsgProposeSolution_commitment = md_MsgProposeSolution.Fields().ByName("commitment")
+	fd_MsgProposeSolution_signature = md_MsgProposeSolution.Fields().ByName("signature")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgProposeSolution)(nil)
@@ -3389,9 +3345,15 @@ func (x *fastReflection_MsgProposeSolution) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &x.Signatures})
-		if !f(fd_MsgProposeSolution_signatures, value) {
+	if x.Commitment != "" {
+		value := protoreflect.ValueOfString(x.Commitment)
+		if !f(fd_MsgProposeSolution_commitment, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_MsgProposeSolution_signature, value) {
 			return
 		}
 	}
@@ -3418,8 +3380,10 @@ func (x *fastReflection_MsgProposeSolution) Has(fd protoreflect.FieldDescriptor)
 		return x.ThreadId != ""
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		return x.PublicKey != ""
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		return len(x.Signatures) != 0
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		return x.Commitment != ""
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3444,8 +3408,10 @@ func (x *fastReflection_MsgProposeSolution) Clear(fd protoreflect.FieldDescripto
 		x.ThreadId = ""
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		x.PublicKey = ""
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		x.Signatures = nil
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		x.Commitment = ""
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		x.Signature = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3474,12 +3440,12 @@ func (x *fastReflection_MsgProposeSolution) Get(descriptor protoreflect.FieldDes
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		value := x.PublicKey
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{})
-		}
-		listValue := &_MsgProposeSolution_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3508,10 +3474,10 @@ func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor,
 		x.ThreadId = value.Interface().(string)
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		x.PublicKey = value.Interface().(string)
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		lv := value.List()
-		clv := lv.(*_MsgProposeSolution_5_list)
-		x.Signatures = *clv.list
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		x.Commitment = value.Interface().(string)
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3532,12 +3498,6 @@ func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
-		}
-		value := &_MsgProposeSolution_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(value)
 	case "janction.audioStem.v1.MsgProposeSolution.creator":
 		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgProposeSolution is not mutable"))
 	case "janction.audioStem.v1.MsgProposeSolution.taskId":
@@ -3546,6 +3506,10 @@ func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgProposeSolution is not mutable"))
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		panic(fmt.Errorf("field public_key of message janction.audioStem.v1.MsgProposeSolution is not mutable"))
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		panic(fmt.Errorf("field commitment of message janction.audioStem.v1.MsgProposeSolution is not mutable"))
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		panic(fmt.Errorf("field signature of message janction.audioStem.v1.MsgProposeSolution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3567,9 +3531,10 @@ func (x *fastReflection_MsgProposeSolution) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.MsgProposeSolution.public_key":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgProposeSolution.signatures":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &list})
+	case "janction.audioStem.v1.MsgProposeSolution.commitment":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgProposeSolution.signature":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgProposeSolution"))
@@ -3655,11 +3620,13 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3690,14 +3657,19 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
-				i--
-				dAtA[i] = 0x2a
-			}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if len(x.PublicKey) > 0 {
 			i -= len(x.PublicKey)
@@ -3904,9 +3876,41 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				}
 				x.PublicKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3934,7 +3938,7 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				x.Signature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4327,49 +4331,54 @@ func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_MsgRevealSolution_4_list)(nil)
+var _ protoreflect.List = (*_MsgRevealSolution_6_list)(nil)
 
-type _MsgRevealSolution_4_list struct {
-	list *[]string
+type _MsgRevealSolution_6_list struct {
+	list *[]*StemReveal
 }
 
-func (x *_MsgRevealSolution_4_list) Len() int {
+func (x *_MsgRevealSolution_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgRevealSolution_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgRevealSolution_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgRevealSolution_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgRevealSolution_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StemReveal)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgRevealSolution_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgRevealSolution_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StemReveal)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgRevealSolution_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevealSolution at list field Stems as it is not of Message kind"))
+func (x *_MsgRevealSolution_6_list) AppendMutable() protoreflect.Value {
+	v := new(StemReveal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgRevealSolution_4_list) Truncate(n int) {
+func (x *_MsgRevealSolution_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgRevealSolution_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgRevealSolution_6_list) NewElement() protoreflect.Value {
+	v := new(StemReveal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgRevealSolution_4_list) IsValid() bool {
+func (x *_MsgRevealSolution_6_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -4378,6 +4387,7 @@ var (
 	fd_MsgRevealSolution_creator  protoreflect.FieldDescriptor
 	fd_MsgRevealSolution_taskId   protoreflect.FieldDescriptor
 	fd_MsgRevealSolution_threadId protoreflect.FieldDescriptor
+	fd_MsgRevealSolution_salt     protoreflect.FieldDescriptor
 	fd_MsgRevealSolution_stems    protoreflect.FieldDescriptor
 )
 
@@ -4387,6 +4397,7 @@ func init() {
 	fd_MsgRevealSolution_creator = md_MsgRevealSolution.Fields().ByName("creator")
 	fd_MsgRevealSolution_taskId = md_MsgRevealSolution.Fields().ByName("taskId")
 	fd_MsgRevealSolution_threadId = md_MsgRevealSolution.Fields().ByName("threadId")
+	fd_MsgRevealSolution_salt = md_MsgRevealSolution.Fields().ByName("salt")
 	fd_MsgRevealSolution_stems = md_MsgRevealSolution.Fields().ByName("stems")
 }
 
@@ -4473,8 +4484,14 @@ func (x *fastReflection_MsgRevealSolution) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_MsgRevealSolution_salt, value) {
+			return
+		}
+	}
 	if len(x.Stems) != 0 {
-		value := protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &x.Stems})
+		value := protoreflect.ValueOfList(&_MsgRevealSolution_6_list{list: &x.Stems})
 		if !f(fd_MsgRevealSolution_stems, value) {
 			return
 		}
@@ -4500,6 +4517,8 @@ func (x *fastReflection_MsgRevealSolution) Has(fd protoreflect.FieldDescriptor)
 		return x.TaskId != ""
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		return x.ThreadId != ""
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		return x.Salt != ""
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
 		return len(x.Stems) != 0
 	default:
@@ -4524,6 +4543,8 @@ func (x *fastReflection_MsgRevealSolution) Clear(fd protoreflect.FieldDescriptor
 		x.TaskId = ""
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		x.ThreadId = ""
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		x.Salt = ""
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
 		x.Stems = nil
 	default:
@@ -4551,11 +4572,14 @@ func (x *fastReflection_MsgRevealSolution) Get(descriptor protoreflect.FieldDesc
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		value := x.ThreadId
 		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		value := x.Salt
+		return protoreflect.ValueOfString(value)
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
 		if len(x.Stems) == 0 {
-			return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{})
+			return protoreflect.ValueOfList(&_MsgRevealSolution_6_list{})
 		}
-		listValue := &_MsgRevealSolution_4_list{list: &x.Stems}
+		listValue := &_MsgRevealSolution_6_list{list: &x.Stems}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
@@ -4583,9 +4607,11 @@ func (x *fastReflection_MsgRevealSolution) Set(fd protoreflect.FieldDescriptor,
 		x.TaskId = value.Interface().(string)
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		x.Salt = value.Interface().(string)
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
 		lv := value.List()
-		clv := lv.(*_MsgRevealSolution_4_list)
+		clv := lv.(*_MsgRevealSolution_6_list)
 		x.Stems = *clv.list
 	default:
 		if fd.IsExtension() {
@@ -4609,9 +4635,9 @@ func (x *fastReflection_MsgRevealSolution) Mutable(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
 		if x.Stems == nil {
-			x.Stems = []string{}
+			x.Stems = []*StemReveal{}
 		}
-		value := &_MsgRevealSolution_4_list{list: &x.Stems}
+		value := &_MsgRevealSolution_6_list{list: &x.Stems}
 		return protoreflect.ValueOfList(value)
 	case "janction.audioStem.v1.MsgRevealSolution.creator":
 		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgRevealSolution is not mutable"))
@@ -4619,6 +4645,8 @@ func (x *fastReflection_MsgRevealSolution) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgRevealSolution is not mutable"))
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgRevealSolution is not mutable"))
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		panic(fmt.Errorf("field salt of message janction.audioStem.v1.MsgRevealSolution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealSolution"))
@@ -4638,9 +4666,11 @@ func (x *fastReflection_MsgRevealSolution) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.MsgRevealSolution.threadId":
 		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgRevealSolution.salt":
+		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.MsgRevealSolution.stems":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &list})
+		list := []*StemReveal{}
+		return protoreflect.ValueOfList(&_MsgRevealSolution_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealSolution"))
@@ -4722,9 +4752,13 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if len(x.Stems) > 0 {
-			for _, s := range x.Stems {
-				l = len(s)
+			for _, e := range x.Stems {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -4759,13 +4793,27 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 		}
 		if len(x.Stems) > 0 {
 			for iNdEx := len(x.Stems) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Stems[iNdEx])
-				copy(dAtA[i:], x.Stems[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stems[iNdEx])))
+				encoded, err := options.Marshal(x.Stems[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0x32
 			}
 		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.ThreadId) > 0 {
 			i -= len(x.ThreadId)
 			copy(dAtA[i:], x.ThreadId)
@@ -4932,9 +4980,9 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 				}
 				x.ThreadId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stems", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4962,7 +5010,41 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Stems = append(x.Stems, string(dAtA[iNdEx:postIndex]))
+				x.Salt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stems", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stems = append(x.Stems, &StemReveal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stems[len(x.Stems)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5355,80 +5437,85 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitValidation_5_list)(nil)
+var _ protoreflect.List = (*_MsgRevealValidation_6_list)(nil)
 
-type _MsgSubmitValidation_5_list struct {
-	list *[]string
+type _MsgRevealValidation_6_list struct {
+	list *[]*StemReveal
 }
 
-func (x *_MsgSubmitValidation_5_list) Len() int {
+func (x *_MsgRevealValidation_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgSubmitValidation_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgRevealValidation_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgSubmitValidation_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgRevealValidation_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StemReveal)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgSubmitValidation_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgRevealValidation_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StemReveal)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgSubmitValidation_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitValidation at list field Signatures as it is not of Message kind"))
+func (x *_MsgRevealValidation_6_list) AppendMutable() protoreflect.Value {
+	v := new(StemReveal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgSubmitValidation_5_list) Truncate(n int) {
+func (x *_MsgRevealValidation_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgSubmitValidation_5_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgRevealValidation_6_list) NewElement() protoreflect.Value {
+	v := new(StemReveal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgSubmitValidation_5_list) IsValid() bool {
+func (x *_MsgRevealValidation_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgSubmitValidation            protoreflect.MessageDescriptor
-	fd_MsgSubmitValidation_creator    protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_taskId     protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_threadId   protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_public_key protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_signatures protoreflect.FieldDescriptor
+	md_MsgRevealValidation          protoreflect.MessageDescriptor
+	fd_MsgRevealValidation_creator  protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_taskId   protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_threadId protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_salt     protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_stems    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_tx_proto_init()
-	md_MsgSubmitValidation = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitValidation")
-	fd_MsgSubmitValidation_creator = md_MsgSubmitValidation.Fields().ByName("creator")
-	fd_MsgSubmitValidation_taskId = md_MsgSubmitValidation.Fields().ByName("taskId")
-	fd_MsgSubmitValidation_threadId = md_MsgSubmitValidation.Fields().ByName("threadId")
-	fd_MsgSubmitValidation_public_key = md_MsgSubmitValidation.Fields().ByName("public_key")
-	fd_MsgSubmitValidation_signatures = md_MsgSubmitValidation.Fields().ByName("signatures")
+	md_MsgRevealValidation = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgRevealValidation")
+	fd_MsgRevealValidation_creator = md_MsgRevealValidation.Fields().ByName("creator")
+	fd_MsgRevealValidation_taskId = md_MsgRevealValidation.Fields().ByName("taskId")
+	fd_MsgRevealValidation_threadId = md_MsgRevealValidation.Fields().ByName("threadId")
+	fd_MsgRevealValidation_salt = md_MsgRevealValidation.Fields().ByName("salt")
+	fd_MsgRevealValidation_stems = md_MsgRevealValidation.Fields().ByName("stems")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitValidation)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidation)(nil)
 
-type fastReflection_MsgSubmitValidation MsgSubmitValidation
+type fastReflection_MsgRevealValidation MsgRevealValidation
 
-func (x *MsgSubmitValidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidation)(x)
+func (x *MsgRevealValidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(x)
 }
 
-func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevealValidation) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5440,43 +5527,43 @@ func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitValidation_messageType fastReflection_MsgSubmitValidation_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitValidation_messageType{}
+var _fastReflection_MsgRevealValidation_messageType fastReflection_MsgRevealValidation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidation_messageType{}
 
-type fastReflection_MsgSubmitValidation_messageType struct{}
+type fastReflection_MsgRevealValidation_messageType struct{}
 
-func (x fastReflection_MsgSubmitValidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidation)(nil)
+func (x fastReflection_MsgRevealValidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(nil)
 }
-func (x fastReflection_MsgSubmitValidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidation)
+func (x fastReflection_MsgRevealValidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
 }
-func (x fastReflection_MsgSubmitValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidation
+func (x fastReflection_MsgRevealValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitValidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidation
+func (x *fastReflection_MsgRevealValidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitValidation) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitValidation_messageType
+func (x *fastReflection_MsgRevealValidation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitValidation) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidation)
+func (x *fastReflection_MsgRevealValidation) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitValidation)(x)
+func (x *fastReflection_MsgRevealValidation) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5484,34 +5571,34 @@ func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevealValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSubmitValidation_creator, value) {
+		if !f(fd_MsgRevealValidation_creator, value) {
 			return
 		}
 	}
 	if x.TaskId != "" {
 		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubmitValidation_taskId, value) {
+		if !f(fd_MsgRevealValidation_taskId, value) {
 			return
 		}
 	}
 	if x.ThreadId != "" {
 		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubmitValidation_threadId, value) {
+		if !f(fd_MsgRevealValidation_threadId, value) {
 			return
 		}
 	}
-	if x.PublicKey != "" {
-		value := protoreflect.ValueOfString(x.PublicKey)
-		if !f(fd_MsgSubmitValidation_public_key, value) {
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_MsgRevealValidation_salt, value) {
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &x.Signatures})
-		if !f(fd_MsgSubmitValidation_signatures, value) {
+	if len(x.Stems) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRevealValidation_6_list{list: &x.Stems})
+		if !f(fd_MsgRevealValidation_stems, value) {
 			return
 		}
 	}
@@ -5528,23 +5615,23 @@ func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealValidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
 		return x.Creator != ""
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
 		return x.TaskId != ""
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
 		return x.ThreadId != ""
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
-		return x.PublicKey != ""
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
-		return len(x.Signatures) != 0
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
+		return x.Salt != ""
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
+		return len(x.Stems) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5554,23 +5641,23 @@ func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealValidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
 		x.Creator = ""
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
 		x.TaskId = ""
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
 		x.ThreadId = ""
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
-		x.PublicKey = ""
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
-		x.Signatures = nil
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
+		x.Salt = ""
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
+		x.Stems = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5580,31 +5667,31 @@ func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
 		value := x.TaskId
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
 		value := x.ThreadId
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
-		value := x.PublicKey
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
+		value := x.Salt
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{})
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
+		if len(x.Stems) == 0 {
+			return protoreflect.ValueOfList(&_MsgRevealValidation_6_list{})
 		}
-		listValue := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		listValue := &_MsgRevealValidation_6_list{list: &x.Stems}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5618,25 +5705,25 @@ func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
 		x.Creator = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
 		x.TaskId = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
 		x.ThreadId = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
-		x.PublicKey = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
+		x.Salt = value.Interface().(string)
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
 		lv := value.List()
-		clv := lv.(*_MsgSubmitValidation_5_list)
-		x.Signatures = *clv.list
+		clv := lv.(*_MsgRevealValidation_6_list)
+		x.Stems = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5650,61 +5737,61 @@ func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
+		if x.Stems == nil {
+			x.Stems = []*StemReveal{}
 		}
-		value := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		value := &_MsgRevealValidation_6_list{list: &x.Stems}
 		return protoreflect.ValueOfList(value)
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
-		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
-		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
-		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
-		panic(fmt.Errorf("field public_key of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgRevealValidation is not mutable"))
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
+		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgRevealValidation is not mutable"))
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgRevealValidation is not mutable"))
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
+		panic(fmt.Errorf("field salt of message janction.audioStem.v1.MsgRevealValidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+	case "janction.audioStem.v1.MsgRevealValidation.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+	case "janction.audioStem.v1.MsgRevealValidation.taskId":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+	case "janction.audioStem.v1.MsgRevealValidation.threadId":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+	case "janction.audioStem.v1.MsgRevealValidation.salt":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitValidation.signatures":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &list})
+	case "janction.audioStem.v1.MsgRevealValidation.stems":
+		list := []*StemReveal{}
+		return protoreflect.ValueOfList(&_MsgRevealValidation_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitValidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgRevealValidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5712,7 +5799,7 @@ func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealValidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5723,7 +5810,7 @@ func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealValidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5735,7 +5822,7 @@ func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
+func (x *fastReflection_MsgRevealValidation) IsValid() bool {
 	return x != nil
 }
 
@@ -5745,9 +5832,9 @@ func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealValidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5771,13 +5858,13 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PublicKey)
+		l = len(x.Salt)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
-				l = len(s)
+		if len(x.Stems) > 0 {
+			for _, e := range x.Stems {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -5791,7 +5878,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5810,19 +5897,26 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+		if len(x.Stems) > 0 {
+			for iNdEx := len(x.Stems) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stems[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x32
 			}
 		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
 			i--
 			dAtA[i] = 0x22
 		}
@@ -5858,7 +5952,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5890,10 +5984,10 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5994,7 +6088,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6022,13 +6116,13 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				x.Salt = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stems", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6038,23 +6132,25 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				x.Stems = append(x.Stems, &StemReveal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stems[len(x.Stems)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6092,23 +6188,33 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgSubmitValidationResponse protoreflect.MessageDescriptor
+	md_StemReveal             protoreflect.MessageDescriptor
+	fd_StemReveal_filename    protoreflect.FieldDescriptor
+	fd_StemReveal_cid         protoreflect.FieldDescriptor
+	fd_StemReveal_hash        protoreflect.FieldDescriptor
+	fd_StemReveal_fingerprint protoreflect.FieldDescriptor
+	fd_StemReveal_proof       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_tx_proto_init()
-	md_MsgSubmitValidationResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitValidationResponse")
+	md_StemReveal = File_janction_audioStem_v1_tx_proto.Messages().ByName("StemReveal")
+	fd_StemReveal_filename = md_StemReveal.Fields().ByName("filename")
+	fd_StemReveal_cid = md_StemReveal.Fields().ByName("cid")
+	fd_StemReveal_hash = md_StemReveal.Fields().ByName("hash")
+	fd_StemReveal_fingerprint = md_StemReveal.Fields().ByName("fingerprint")
+	fd_StemReveal_proof = md_StemReveal.Fields().ByName("proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitValidationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_StemReveal)(nil)
 
-type fastReflection_MsgSubmitValidationResponse MsgSubmitValidationResponse
+type fastReflection_StemReveal StemReveal
 
-func (x *MsgSubmitValidationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidationResponse)(x)
+func (x *StemReveal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StemReveal)(x)
 }
 
-func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
+func (x *StemReveal) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6120,43 +6226,43 @@ func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitValidationResponse_messageType fastReflection_MsgSubmitValidationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitValidationResponse_messageType{}
+var _fastReflection_StemReveal_messageType fastReflection_StemReveal_messageType
+var _ protoreflect.MessageType = fastReflection_StemReveal_messageType{}
 
-type fastReflection_MsgSubmitValidationResponse_messageType struct{}
+type fastReflection_StemReveal_messageType struct{}
 
-func (x fastReflection_MsgSubmitValidationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidationResponse)(nil)
+func (x fastReflection_StemReveal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StemReveal)(nil)
 }
-func (x fastReflection_MsgSubmitValidationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidationResponse)
+func (x fastReflection_StemReveal_messageType) New() protoreflect.Message {
+	return new(fastReflection_StemReveal)
 }
-func (x fastReflection_MsgSubmitValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidationResponse
+func (x fastReflection_StemReveal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StemReveal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitValidationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidationResponse
+func (x *fastReflection_StemReveal) Descriptor() protoreflect.MessageDescriptor {
+	return md_StemReveal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitValidationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitValidationResponse_messageType
+func (x *fastReflection_StemReveal) Type() protoreflect.MessageType {
+	return _fastReflection_StemReveal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitValidationResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidationResponse)
+func (x *fastReflection_StemReveal) New() protoreflect.Message {
+	return new(fastReflection_StemReveal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitValidationResponse)(x)
+func (x *fastReflection_StemReveal) Interface() protoreflect.ProtoMessage {
+	return (*StemReveal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6164,7 +6270,37 @@ func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_StemReveal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_StemReveal_filename, value) {
+			return
+		}
+	}
+	if x.Cid != "" {
+		value := protoreflect.ValueOfString(x.Cid)
+		if !f(fd_StemReveal_cid, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_StemReveal_hash, value) {
+			return
+		}
+	}
+	if x.Fingerprint != "" {
+		value := protoreflect.ValueOfString(x.Fingerprint)
+		if !f(fd_StemReveal_fingerprint, value) {
+			return
+		}
+	}
+	if x.Proof != "" {
+		value := protoreflect.ValueOfString(x.Proof)
+		if !f(fd_StemReveal_proof, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6178,13 +6314,23 @@ func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StemReveal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		return x.Filename != ""
+	case "janction.audioStem.v1.StemReveal.cid":
+		return x.Cid != ""
+	case "janction.audioStem.v1.StemReveal.hash":
+		return x.Hash != ""
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		return x.Fingerprint != ""
+	case "janction.audioStem.v1.StemReveal.proof":
+		return x.Proof != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6194,13 +6340,23 @@ func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StemReveal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		x.Filename = ""
+	case "janction.audioStem.v1.StemReveal.cid":
+		x.Cid = ""
+	case "janction.audioStem.v1.StemReveal.hash":
+		x.Hash = ""
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		x.Fingerprint = ""
+	case "janction.audioStem.v1.StemReveal.proof":
+		x.Proof = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6210,13 +6366,28 @@ func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StemReveal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.StemReveal.cid":
+		value := x.Cid
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.StemReveal.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		value := x.Fingerprint
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.StemReveal.proof":
+		value := x.Proof
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6230,13 +6401,23 @@ func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StemReveal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		x.Filename = value.Interface().(string)
+	case "janction.audioStem.v1.StemReveal.cid":
+		x.Cid = value.Interface().(string)
+	case "janction.audioStem.v1.StemReveal.hash":
+		x.Hash = value.Interface().(string)
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		x.Fingerprint = value.Interface().(string)
+	case "janction.audioStem.v1.StemReveal.proof":
+		x.Proof = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6250,36 +6431,56 @@ func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StemReveal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		panic(fmt.Errorf("field filename of message janction.audioStem.v1.StemReveal is not mutable"))
+	case "janction.audioStem.v1.StemReveal.cid":
+		panic(fmt.Errorf("field cid of message janction.audioStem.v1.StemReveal is not mutable"))
+	case "janction.audioStem.v1.StemReveal.hash":
+		panic(fmt.Errorf("field hash of message janction.audioStem.v1.StemReveal is not mutable"))
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		panic(fmt.Errorf("field fingerprint of message janction.audioStem.v1.StemReveal is not mutable"))
+	case "janction.audioStem.v1.StemReveal.proof":
+		panic(fmt.Errorf("field proof of message janction.audioStem.v1.StemReveal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StemReveal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.StemReveal.filename":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.StemReveal.cid":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.StemReveal.hash":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.StemReveal.fingerprint":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.StemReveal.proof":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.StemReveal"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.StemReveal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StemReveal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitValidationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.StemReveal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6287,7 +6488,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StemReveal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6298,7 +6499,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StemReveal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6310,7 +6511,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
+func (x *fastReflection_StemReveal) IsValid() bool {
 	return x != nil
 }
 
@@ -6320,9 +6521,9 @@ func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StemReveal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*StemReveal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6334,6 +6535,26 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Cid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fingerprint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6344,7 +6565,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*StemReveal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6363,6 +6584,41 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Proof) > 0 {
+			i -= len(x.Proof)
+			copy(dAtA[i:], x.Proof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Fingerprint) > 0 {
+			i -= len(x.Fingerprint)
+			copy(dAtA[i:], x.Fingerprint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fingerprint)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Cid) > 0 {
+			i -= len(x.Cid)
+			copy(dAtA[i:], x.Cid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cid)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6374,7 +6630,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*StemReveal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6406,123 +6662,273 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StemReveal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StemReveal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgSubmitSolution                      protoreflect.MessageDescriptor
-	fd_MsgSubmitSolution_creator              protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_taskId               protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_threadId             protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_dir                  protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_average_stem_seconds protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_janction_audioStem_v1_tx_proto_init()
-	md_MsgSubmitSolution = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
-	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
-	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
-	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
-	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
-	fd_MsgSubmitSolution_average_stem_seconds = md_MsgSubmitSolution.Fields().ByName("average_stem_seconds")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
-
-type fastReflection_MsgSubmitSolution MsgSubmitSolution
-
-func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(x)
-}
-
-func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
-
-type fastReflection_MsgSubmitSolution_messageType struct{}
-
-func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(nil)
-}
-func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
-}
-func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolution_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolution)(x)
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fingerprint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proof = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevealValidationResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgRevealValidationResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgRevealValidationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidationResponse)(nil)
+
+type fastReflection_MsgRevealValidationResponse MsgRevealValidationResponse
+
+func (x *MsgRevealValidationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(x)
+}
+
+func (x *MsgRevealValidationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealValidationResponse_messageType fastReflection_MsgRevealValidationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidationResponse_messageType{}
+
+type fastReflection_MsgRevealValidationResponse_messageType struct{}
+
+func (x fastReflection_MsgRevealValidationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(nil)
+}
+func (x fastReflection_MsgRevealValidationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
+}
+func (x fastReflection_MsgRevealValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevealValidationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevealValidationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevealValidationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevealValidationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6530,37 +6936,7 @@ func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSubmitSolution_creator, value) {
-			return
-		}
-	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubmitSolution_taskId, value) {
-			return
-		}
-	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubmitSolution_threadId, value) {
-			return
-		}
-	}
-	if x.Dir != "" {
-		value := protoreflect.ValueOfString(x.Dir)
-		if !f(fd_MsgSubmitSolution_dir, value) {
-			return
-		}
-	}
-	if x.AverageStemSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.AverageStemSeconds)
-		if !f(fd_MsgSubmitSolution_average_stem_seconds, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgRevealValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6574,23 +6950,13 @@ func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		return x.Creator != ""
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		return x.TaskId != ""
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		return x.ThreadId != ""
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		return x.Dir != ""
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		return x.AverageStemSeconds != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6600,23 +6966,13 @@ func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		x.Creator = ""
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		x.TaskId = ""
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		x.ThreadId = ""
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		x.Dir = ""
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		x.AverageStemSeconds = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6626,28 +6982,13 @@ func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		value := x.TaskId
-		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		value := x.ThreadId
-		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		value := x.Dir
-		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		value := x.AverageStemSeconds
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6661,23 +7002,13 @@ func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		x.Creator = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		x.ThreadId = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		x.Dir = value.Interface().(string)
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		x.AverageStemSeconds = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6691,56 +7022,36 @@ func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		panic(fmt.Errorf("field dir of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		panic(fmt.Errorf("field average_stem_seconds of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.audioStem.v1.MsgSubmitSolution.creator":
-		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
-		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
-		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitSolution.dir":
-		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitSolution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgRevealValidationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6748,7 +7059,7 @@ func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealValidationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6759,7 +7070,7 @@ func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealValidationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6771,7 +7082,7 @@ func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+func (x *fastReflection_MsgRevealValidationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6781,9 +7092,9 @@ func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealValidationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6795,25 +7106,6 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.TaskId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ThreadId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Dir)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.AverageStemSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.AverageStemSeconds))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6824,7 +7116,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6843,39 +7135,6 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AverageStemSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageStemSeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Dir) > 0 {
-			i -= len(x.Dir)
-			copy(dAtA[i:], x.Dir)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6887,7 +7146,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6919,173 +7178,26 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Dir = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageStemSeconds", wireType)
-				}
-				x.AverageStemSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AverageStemSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -7108,23 +7220,35 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+	md_MsgSubmitValidation            protoreflect.MessageDescriptor
+	fd_MsgSubmitValidation_creator    protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_taskId     protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_threadId   protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_public_key protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_commitment protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_signature  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_tx_proto_init()
-	md_MsgSubmitSolutionResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+	md_MsgSubmitValidation = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitValidation")
+	fd_MsgSubmitValidation_creator = md_MsgSubmitValidation.Fields().ByName("creator")
+	fd_MsgSubmitValidation_taskId = md_MsgSubmitValidation.Fields().ByName("taskId")
+	fd_MsgSubmitValidation_threadId = md_MsgSubmitValidation.Fields().ByName("threadId")
+	fd_MsgSubmitValidation_public_key = md_MsgSubmitValidation.Fields().ByName("public_key")
+	fd_MsgSubmitValidation_commitment = md_MsgSubmitValidation.Fields().ByName("commitment")
+	fd_MsgSubmitValidation_signature = md_MsgSubmitValidation.Fields().ByName("signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitValidation)(nil)
 
-type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+type fastReflection_MsgSubmitValidation MsgSubmitValidation
 
-func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+func (x *MsgSubmitValidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidation)(x)
 }
 
-func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7136,43 +7260,43 @@ func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+var _fastReflection_MsgSubmitValidation_messageType fastReflection_MsgSubmitValidation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitValidation_messageType{}
 
-type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+type fastReflection_MsgSubmitValidation_messageType struct{}
 
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+func (x fastReflection_MsgSubmitValidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidation)(nil)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x fastReflection_MsgSubmitValidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidation)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x fastReflection_MsgSubmitValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x *fastReflection_MsgSubmitValidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolutionResponse_messageType
+func (x *fastReflection_MsgSubmitValidation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitValidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x *fastReflection_MsgSubmitValidation) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolutionResponse)(x)
+func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitValidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7180,7 +7304,43 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitValidation_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitValidation_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubmitValidation_threadId, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_MsgSubmitValidation_public_key, value) {
+			return
+		}
+	}
+	if x.Commitment != "" {
+		value := protoreflect.ValueOfString(x.Commitment)
+		if !f(fd_MsgSubmitValidation_commitment, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_MsgSubmitValidation_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7194,13 +7354,25 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		return x.TaskId != ""
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		return x.ThreadId != ""
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		return x.PublicKey != ""
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		return x.Commitment != ""
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7210,13 +7382,25 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		x.TaskId = ""
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		x.ThreadId = ""
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		x.PublicKey = ""
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		x.Commitment = ""
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		x.Signature = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7226,13 +7410,31 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7246,13 +7448,25 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		x.PublicKey = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		x.Commitment = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7266,36 +7480,60 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		panic(fmt.Errorf("field public_key of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		panic(fmt.Errorf("field commitment of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		panic(fmt.Errorf("field signature of message janction.audioStem.v1.MsgSubmitValidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitValidation.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitValidation.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitValidation.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitValidation.public_key":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitValidation.commitment":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitValidation.signature":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidation"))
 		}
-		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitSolutionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitValidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7303,7 +7541,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7314,7 +7552,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7326,7 +7564,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
 	return x != nil
 }
 
@@ -7336,9 +7574,9 @@ func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgSubmitValidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7350,6 +7588,30 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7360,7 +7622,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgSubmitValidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7379,18 +7641,60 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x3a
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7422,12 +7726,204 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7463,688 +7959,7102 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: janction/audioStem/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgSubmitValidationResponse protoreflect.MessageDescriptor
 )
 
-// MsgCreateGame defines the Msg/CreateGame request type.
-type MsgCreateAudioStemTask struct {
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgSubmitValidationResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitValidationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitValidationResponse)(nil)
+
+type fastReflection_MsgSubmitValidationResponse MsgSubmitValidationResponse
+
+func (x *MsgSubmitValidationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidationResponse)(x)
+}
+
+func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitValidationResponse_messageType fastReflection_MsgSubmitValidationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitValidationResponse_messageType{}
+
+type fastReflection_MsgSubmitValidationResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitValidationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidationResponse)(nil)
+}
+func (x fastReflection_MsgSubmitValidationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidationResponse)
+}
+func (x fastReflection_MsgSubmitValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitValidationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitValidationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitValidationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitValidationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitValidationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitValidationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSolution                      protoreflect.MessageDescriptor
+	fd_MsgSubmitSolution_creator              protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_taskId               protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_threadId             protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_dir                  protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_average_stem_seconds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgSubmitSolution = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
+	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
+	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
+	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
+	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
+	fd_MsgSubmitSolution_average_stem_seconds = md_MsgSubmitSolution.Fields().ByName("average_stem_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
+
+type fastReflection_MsgSubmitSolution MsgSubmitSolution
+
+func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(x)
+}
+
+func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
+
+type fastReflection_MsgSubmitSolution_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(nil)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitSolution_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitSolution_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubmitSolution_threadId, value) {
+			return
+		}
+	}
+	if x.Dir != "" {
+		value := protoreflect.ValueOfString(x.Dir)
+		if !f(fd_MsgSubmitSolution_dir, value) {
+			return
+		}
+	}
+	if x.AverageStemSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AverageStemSeconds)
+		if !f(fd_MsgSubmitSolution_average_stem_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		return x.TaskId != ""
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		return x.ThreadId != ""
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		return x.Dir != ""
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		return x.AverageStemSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		x.TaskId = ""
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = ""
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		x.Dir = ""
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		x.AverageStemSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		value := x.Dir
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		value := x.AverageStemSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		x.Dir = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		x.AverageStemSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		panic(fmt.Errorf("field dir of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		panic(fmt.Errorf("field average_stem_seconds of message janction.audioStem.v1.MsgSubmitSolution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSubmitSolution.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitSolution.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitSolution.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitSolution.dir":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSubmitSolution.average_stem_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitSolution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Dir)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AverageStemSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.AverageStemSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AverageStemSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageStemSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Dir) > 0 {
+			i -= len(x.Dir)
+			copy(dAtA[i:], x.Dir)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dir = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageStemSeconds", wireType)
+				}
+				x.AverageStemSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AverageStemSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgSubmitSolutionResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+
+type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+
+func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+}
+
+func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+
+type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSubmitSolutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportInvalidInput          protoreflect.MessageDescriptor
+	fd_MsgReportInvalidInput_creator  protoreflect.FieldDescriptor
+	fd_MsgReportInvalidInput_taskId   protoreflect.FieldDescriptor
+	fd_MsgReportInvalidInput_threadId protoreflect.FieldDescriptor
+	fd_MsgReportInvalidInput_reason   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgReportInvalidInput = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgReportInvalidInput")
+	fd_MsgReportInvalidInput_creator = md_MsgReportInvalidInput.Fields().ByName("creator")
+	fd_MsgReportInvalidInput_taskId = md_MsgReportInvalidInput.Fields().ByName("taskId")
+	fd_MsgReportInvalidInput_threadId = md_MsgReportInvalidInput.Fields().ByName("threadId")
+	fd_MsgReportInvalidInput_reason = md_MsgReportInvalidInput.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportInvalidInput)(nil)
+
+type fastReflection_MsgReportInvalidInput MsgReportInvalidInput
+
+func (x *MsgReportInvalidInput) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportInvalidInput)(x)
+}
+
+func (x *MsgReportInvalidInput) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportInvalidInput_messageType fastReflection_MsgReportInvalidInput_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportInvalidInput_messageType{}
+
+type fastReflection_MsgReportInvalidInput_messageType struct{}
+
+func (x fastReflection_MsgReportInvalidInput_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportInvalidInput)(nil)
+}
+func (x fastReflection_MsgReportInvalidInput_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportInvalidInput)
+}
+func (x fastReflection_MsgReportInvalidInput_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportInvalidInput
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportInvalidInput) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportInvalidInput
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportInvalidInput) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportInvalidInput_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportInvalidInput) New() protoreflect.Message {
+	return new(fastReflection_MsgReportInvalidInput)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportInvalidInput) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportInvalidInput)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportInvalidInput) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgReportInvalidInput_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgReportInvalidInput_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgReportInvalidInput_threadId, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgReportInvalidInput_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportInvalidInput) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		return x.TaskId != ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		return x.ThreadId != ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInput) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		x.TaskId = ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		x.ThreadId = ""
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportInvalidInput) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInput) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInput) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgReportInvalidInput is not mutable"))
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgReportInvalidInput is not mutable"))
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgReportInvalidInput is not mutable"))
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		panic(fmt.Errorf("field reason of message janction.audioStem.v1.MsgReportInvalidInput is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportInvalidInput) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInput.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgReportInvalidInput.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgReportInvalidInput.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgReportInvalidInput.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInput"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInput does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportInvalidInput) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgReportInvalidInput", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportInvalidInput) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInput) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportInvalidInput) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportInvalidInput) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportInvalidInput)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportInvalidInput)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportInvalidInput)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportInvalidInput: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportInvalidInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportInvalidInputResponse          protoreflect.MessageDescriptor
+	fd_MsgReportInvalidInputResponse_rejected protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgReportInvalidInputResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgReportInvalidInputResponse")
+	fd_MsgReportInvalidInputResponse_rejected = md_MsgReportInvalidInputResponse.Fields().ByName("rejected")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportInvalidInputResponse)(nil)
+
+type fastReflection_MsgReportInvalidInputResponse MsgReportInvalidInputResponse
+
+func (x *MsgReportInvalidInputResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportInvalidInputResponse)(x)
+}
+
+func (x *MsgReportInvalidInputResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportInvalidInputResponse_messageType fastReflection_MsgReportInvalidInputResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportInvalidInputResponse_messageType{}
+
+type fastReflection_MsgReportInvalidInputResponse_messageType struct{}
+
+func (x fastReflection_MsgReportInvalidInputResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportInvalidInputResponse)(nil)
+}
+func (x fastReflection_MsgReportInvalidInputResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportInvalidInputResponse)
+}
+func (x fastReflection_MsgReportInvalidInputResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportInvalidInputResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportInvalidInputResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportInvalidInputResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportInvalidInputResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportInvalidInputResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportInvalidInputResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReportInvalidInputResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportInvalidInputResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportInvalidInputResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportInvalidInputResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rejected != false {
+		value := protoreflect.ValueOfBool(x.Rejected)
+		if !f(fd_MsgReportInvalidInputResponse_rejected, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportInvalidInputResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		return x.Rejected != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInputResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		x.Rejected = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportInvalidInputResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		value := x.Rejected
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInputResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		x.Rejected = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInputResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		panic(fmt.Errorf("field rejected of message janction.audioStem.v1.MsgReportInvalidInputResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportInvalidInputResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgReportInvalidInputResponse.rejected":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgReportInvalidInputResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgReportInvalidInputResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportInvalidInputResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgReportInvalidInputResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportInvalidInputResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportInvalidInputResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportInvalidInputResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportInvalidInputResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportInvalidInputResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Rejected {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportInvalidInputResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rejected {
+			i--
+			if x.Rejected {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportInvalidInputResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportInvalidInputResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportInvalidInputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Rejected = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgLeaveThread          protoreflect.MessageDescriptor
+	fd_MsgLeaveThread_creator  protoreflect.FieldDescriptor
+	fd_MsgLeaveThread_taskId   protoreflect.FieldDescriptor
+	fd_MsgLeaveThread_threadId protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgLeaveThread = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgLeaveThread")
+	fd_MsgLeaveThread_creator = md_MsgLeaveThread.Fields().ByName("creator")
+	fd_MsgLeaveThread_taskId = md_MsgLeaveThread.Fields().ByName("taskId")
+	fd_MsgLeaveThread_threadId = md_MsgLeaveThread.Fields().ByName("threadId")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgLeaveThread)(nil)
+
+type fastReflection_MsgLeaveThread MsgLeaveThread
+
+func (x *MsgLeaveThread) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveThread)(x)
+}
+
+func (x *MsgLeaveThread) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgLeaveThread_messageType fastReflection_MsgLeaveThread_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveThread_messageType{}
+
+type fastReflection_MsgLeaveThread_messageType struct{}
+
+func (x fastReflection_MsgLeaveThread_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveThread)(nil)
+}
+func (x fastReflection_MsgLeaveThread_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveThread)
+}
+func (x fastReflection_MsgLeaveThread_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveThread
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgLeaveThread) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveThread
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgLeaveThread) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveThread_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgLeaveThread) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveThread)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgLeaveThread) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveThread)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgLeaveThread) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgLeaveThread_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgLeaveThread_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgLeaveThread_threadId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgLeaveThread) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		return x.TaskId != ""
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		return x.ThreadId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThread) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		x.TaskId = ""
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		x.ThreadId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgLeaveThread) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThread) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		x.ThreadId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThread) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgLeaveThread is not mutable"))
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.MsgLeaveThread is not mutable"))
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.MsgLeaveThread is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgLeaveThread) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgLeaveThread.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgLeaveThread.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgLeaveThread.threadId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThread"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThread does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgLeaveThread) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgLeaveThread", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgLeaveThread) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThread) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgLeaveThread) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgLeaveThread) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgLeaveThread)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveThread)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveThread)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveThread: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveThread: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgLeaveThreadResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgLeaveThreadResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgLeaveThreadResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgLeaveThreadResponse)(nil)
+
+type fastReflection_MsgLeaveThreadResponse MsgLeaveThreadResponse
+
+func (x *MsgLeaveThreadResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveThreadResponse)(x)
+}
+
+func (x *MsgLeaveThreadResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgLeaveThreadResponse_messageType fastReflection_MsgLeaveThreadResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveThreadResponse_messageType{}
+
+type fastReflection_MsgLeaveThreadResponse_messageType struct{}
+
+func (x fastReflection_MsgLeaveThreadResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveThreadResponse)(nil)
+}
+func (x fastReflection_MsgLeaveThreadResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveThreadResponse)
+}
+func (x fastReflection_MsgLeaveThreadResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveThreadResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgLeaveThreadResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveThreadResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgLeaveThreadResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveThreadResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgLeaveThreadResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveThreadResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgLeaveThreadResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveThreadResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgLeaveThreadResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgLeaveThreadResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThreadResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgLeaveThreadResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThreadResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThreadResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgLeaveThreadResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgLeaveThreadResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgLeaveThreadResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgLeaveThreadResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgLeaveThreadResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgLeaveThreadResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveThreadResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgLeaveThreadResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgLeaveThreadResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgLeaveThreadResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveThreadResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveThreadResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveThreadResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveThreadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetWorkerSigner         protoreflect.MessageDescriptor
+	fd_MsgSetWorkerSigner_creator protoreflect.FieldDescriptor
+	fd_MsgSetWorkerSigner_signer  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgSetWorkerSigner = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSetWorkerSigner")
+	fd_MsgSetWorkerSigner_creator = md_MsgSetWorkerSigner.Fields().ByName("creator")
+	fd_MsgSetWorkerSigner_signer = md_MsgSetWorkerSigner.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetWorkerSigner)(nil)
+
+type fastReflection_MsgSetWorkerSigner MsgSetWorkerSigner
+
+func (x *MsgSetWorkerSigner) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerSigner)(x)
+}
+
+func (x *MsgSetWorkerSigner) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetWorkerSigner_messageType fastReflection_MsgSetWorkerSigner_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetWorkerSigner_messageType{}
+
+type fastReflection_MsgSetWorkerSigner_messageType struct{}
+
+func (x fastReflection_MsgSetWorkerSigner_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerSigner)(nil)
+}
+func (x fastReflection_MsgSetWorkerSigner_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerSigner)
+}
+func (x fastReflection_MsgSetWorkerSigner_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerSigner
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetWorkerSigner) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerSigner
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetWorkerSigner) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetWorkerSigner_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetWorkerSigner) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerSigner)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetWorkerSigner) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetWorkerSigner)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetWorkerSigner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetWorkerSigner_creator, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetWorkerSigner_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetWorkerSigner) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSigner) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetWorkerSigner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSigner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSigner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgSetWorkerSigner is not mutable"))
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		panic(fmt.Errorf("field signer of message janction.audioStem.v1.MsgSetWorkerSigner is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetWorkerSigner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgSetWorkerSigner.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgSetWorkerSigner.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetWorkerSigner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSetWorkerSigner", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetWorkerSigner) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSigner) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetWorkerSigner) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetWorkerSigner) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetWorkerSigner)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetWorkerSigner)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetWorkerSigner)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerSigner: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetWorkerSignerResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgSetWorkerSignerResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgSetWorkerSignerResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetWorkerSignerResponse)(nil)
+
+type fastReflection_MsgSetWorkerSignerResponse MsgSetWorkerSignerResponse
+
+func (x *MsgSetWorkerSignerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerSignerResponse)(x)
+}
+
+func (x *MsgSetWorkerSignerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetWorkerSignerResponse_messageType fastReflection_MsgSetWorkerSignerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetWorkerSignerResponse_messageType{}
+
+type fastReflection_MsgSetWorkerSignerResponse_messageType struct{}
+
+func (x fastReflection_MsgSetWorkerSignerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerSignerResponse)(nil)
+}
+func (x fastReflection_MsgSetWorkerSignerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerSignerResponse)
+}
+func (x fastReflection_MsgSetWorkerSignerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerSignerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerSignerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetWorkerSignerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetWorkerSignerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerSignerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetWorkerSignerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSignerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetWorkerSignerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgSetWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgSetWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetWorkerSignerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgSetWorkerSignerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetWorkerSignerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetWorkerSignerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetWorkerSignerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetWorkerSignerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetWorkerSignerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetWorkerSignerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetWorkerSignerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerSignerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptWorkerSigner         protoreflect.MessageDescriptor
+	fd_MsgAcceptWorkerSigner_creator protoreflect.FieldDescriptor
+	fd_MsgAcceptWorkerSigner_worker  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgAcceptWorkerSigner = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgAcceptWorkerSigner")
+	fd_MsgAcceptWorkerSigner_creator = md_MsgAcceptWorkerSigner.Fields().ByName("creator")
+	fd_MsgAcceptWorkerSigner_worker = md_MsgAcceptWorkerSigner.Fields().ByName("worker")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptWorkerSigner)(nil)
+
+type fastReflection_MsgAcceptWorkerSigner MsgAcceptWorkerSigner
+
+func (x *MsgAcceptWorkerSigner) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptWorkerSigner)(x)
+}
+
+func (x *MsgAcceptWorkerSigner) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptWorkerSigner_messageType fastReflection_MsgAcceptWorkerSigner_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptWorkerSigner_messageType{}
+
+type fastReflection_MsgAcceptWorkerSigner_messageType struct{}
+
+func (x fastReflection_MsgAcceptWorkerSigner_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptWorkerSigner)(nil)
+}
+func (x fastReflection_MsgAcceptWorkerSigner_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptWorkerSigner)
+}
+func (x fastReflection_MsgAcceptWorkerSigner_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptWorkerSigner
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptWorkerSigner) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptWorkerSigner
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptWorkerSigner) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptWorkerSigner_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptWorkerSigner) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptWorkerSigner)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptWorkerSigner) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptWorkerSigner)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptWorkerSigner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgAcceptWorkerSigner_creator, value) {
+			return
+		}
+	}
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_MsgAcceptWorkerSigner_worker, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptWorkerSigner) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		return x.Creator != ""
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		return x.Worker != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSigner) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		x.Creator = ""
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		x.Worker = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptWorkerSigner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		value := x.Worker
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSigner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		x.Worker = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSigner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		panic(fmt.Errorf("field creator of message janction.audioStem.v1.MsgAcceptWorkerSigner is not mutable"))
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		panic(fmt.Errorf("field worker of message janction.audioStem.v1.MsgAcceptWorkerSigner is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptWorkerSigner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgAcceptWorkerSigner.worker":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSigner"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptWorkerSigner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgAcceptWorkerSigner", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptWorkerSigner) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSigner) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptWorkerSigner) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptWorkerSigner) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptWorkerSigner)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Worker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptWorkerSigner)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptWorkerSigner)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptWorkerSigner: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptWorkerSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Worker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptWorkerSignerResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgAcceptWorkerSignerResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgAcceptWorkerSignerResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptWorkerSignerResponse)(nil)
+
+type fastReflection_MsgAcceptWorkerSignerResponse MsgAcceptWorkerSignerResponse
+
+func (x *MsgAcceptWorkerSignerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptWorkerSignerResponse)(x)
+}
+
+func (x *MsgAcceptWorkerSignerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptWorkerSignerResponse_messageType fastReflection_MsgAcceptWorkerSignerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptWorkerSignerResponse_messageType{}
+
+type fastReflection_MsgAcceptWorkerSignerResponse_messageType struct{}
+
+func (x fastReflection_MsgAcceptWorkerSignerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptWorkerSignerResponse)(nil)
+}
+func (x fastReflection_MsgAcceptWorkerSignerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptWorkerSignerResponse)
+}
+func (x fastReflection_MsgAcceptWorkerSignerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptWorkerSignerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptWorkerSignerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptWorkerSignerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptWorkerSignerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptWorkerSignerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgAcceptWorkerSignerResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgAcceptWorkerSignerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgAcceptWorkerSignerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptWorkerSignerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptWorkerSignerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptWorkerSignerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptWorkerSignerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptWorkerSignerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptWorkerSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgUpdateParams = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message janction.audioStem.v1.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_audioStem_v1_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_janction_audioStem_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.MsgUpdateParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: janction/audioStem/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateGame defines the Msg/CreateGame request type.
+type MsgCreateAudioStemTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the message sender.
+	Creator     string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Cid         string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	AmountFiles int32         `protobuf:"varint,3,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
+	Instrument  string        `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Mp3         bool          `protobuf:"varint,5,opt,name=mp3,proto3" json:"mp3,omitempty"`
+	Reward      *v1beta1.Coin `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *MsgCreateAudioStemTask) Reset() {
+	*x = MsgCreateAudioStemTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateAudioStemTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateAudioStemTask) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateAudioStemTask.ProtoReflect.Descriptor instead.
+func (*MsgCreateAudioStemTask) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreateAudioStemTask) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateAudioStemTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *MsgCreateAudioStemTask) GetAmountFiles() int32 {
+	if x != nil {
+		return x.AmountFiles
+	}
+	return 0
+}
+
+func (x *MsgCreateAudioStemTask) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *MsgCreateAudioStemTask) GetMp3() bool {
+	if x != nil {
+		return x.Mp3
+	}
+	return false
+}
+
+func (x *MsgCreateAudioStemTask) GetReward() *v1beta1.Coin {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+// MsgCreateGameResponse defines the Msg/CreateGame response type.
+type MsgCreateAudioStemTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *MsgCreateAudioStemTaskResponse) Reset() {
+	*x = MsgCreateAudioStemTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateAudioStemTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateAudioStemTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateAudioStemTaskResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateAudioStemTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgCreateAudioStemTaskResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type MsgAddWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+}
+
+func (x *MsgAddWorker) Reset() {
+	*x = MsgAddWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddWorker) ProtoMessage() {}
+
+// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
+func (*MsgAddWorker) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgAddWorker) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetIpfsId() string {
+	if x != nil {
+		return x.IpfsId
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+type MsgAddWorkerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgAddWorkerResponse) Reset() {
+	*x = MsgAddWorkerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddWorkerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgAddWorkerResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *MsgAddWorkerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MsgSubscribeWorkerToTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+}
+
+func (x *MsgSubscribeWorkerToTask) Reset() {
+	*x = MsgSubscribeWorkerToTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubscribeWorkerToTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubscribeWorkerToTask) ProtoMessage() {}
+
+// Deprecated: Use MsgSubscribeWorkerToTask.ProtoReflect.Descriptor instead.
+func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgSubscribeWorkerToTask) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MsgSubscribeWorkerToTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgSubscribeWorkerToTask) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type MsgSubscribeWorkerToTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) Reset() {
+	*x = MsgSubscribeWorkerToTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubscribeWorkerToTaskResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+// Msg to Propose a solution to an specific thread
+// Actual solution is a map of hashes
+type MsgProposeSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId  string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the proposer
+	Commitment string `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *MsgProposeSolution) Reset() {
+	*x = MsgProposeSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProposeSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProposeSolution) ProtoMessage() {}
+
+// Deprecated: Use MsgProposeSolution.ProtoReflect.Descriptor instead.
+func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgProposeSolution) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// no response needed to a proposed solution
+type MsgProposeSolutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgProposeSolutionResponse) Reset() {
+	*x = MsgProposeSolutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProposeSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProposeSolutionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgProposeSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// Msg to Propose a solution to an specific thread
+// Actual solution is a map of hashes
+type MsgRevealSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	// salt of the commitments sent with the proposed solution
+	Salt  string        `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	Stems []*StemReveal `protobuf:"bytes,6,rep,name=stems,proto3" json:"stems,omitempty"`
+}
+
+func (x *MsgRevealSolution) Reset() {
+	*x = MsgRevealSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealSolution) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealSolution.ProtoReflect.Descriptor instead.
+func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRevealSolution) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetStems() []*StemReveal {
+	if x != nil {
+		return x.Stems
+	}
+	return nil
+}
+
+// no response needed to a proposed solution
+type MsgRevealSolutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRevealSolutionResponse) Reset() {
+	*x = MsgRevealSolutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealSolutionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// Msg to reveal the stems committed in a validation, once the thread has enough validations
+type MsgRevealValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string        `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string        `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Salt     string        `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	Stems    []*StemReveal `protobuf:"bytes,6,rep,name=stems,proto3" json:"stems,omitempty"`
+}
+
+func (x *MsgRevealValidation) Reset() {
+	*x = MsgRevealValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealValidation) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealValidation.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRevealValidation) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetStems() []*StemReveal {
+	if x != nil {
+		return x.Stems
+	}
+	return nil
+}
+
+// A revealed stem, with the proof of its inclusion in the merkle root of the commitment
+type StemReveal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Cid      string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash     string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// energy of each frequency band over time, encoded as base64
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Proof       string `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *StemReveal) Reset() {
+	*x = StemReveal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StemReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StemReveal) ProtoMessage() {}
+
+// Deprecated: Use StemReveal.ProtoReflect.Descriptor instead.
+func (*StemReveal) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StemReveal) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *StemReveal) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *StemReveal) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *StemReveal) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *StemReveal) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+type MsgRevealValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRevealValidationResponse) Reset() {
+	*x = MsgRevealValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealValidationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+type MsgSubmitValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// creator is the message sender.
-	Creator     string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Cid         string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	AmountFiles int32         `protobuf:"varint,3,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
-	Instrument  string        `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Mp3         bool          `protobuf:"varint,5,opt,name=mp3,proto3" json:"mp3,omitempty"`
-	Reward      *v1beta1.Coin `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId  string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the validator
+	Commitment string `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *MsgCreateAudioStemTask) Reset() {
-	*x = MsgCreateAudioStemTask{}
+func (x *MsgSubmitValidation) Reset() {
+	*x = MsgSubmitValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[0]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateAudioStemTask) String() string {
+func (x *MsgSubmitValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateAudioStemTask) ProtoMessage() {}
+func (*MsgSubmitValidation) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateAudioStemTask.ProtoReflect.Descriptor instead.
-func (*MsgCreateAudioStemTask) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgSubmitValidation.ProtoReflect.Descriptor instead.
+func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MsgCreateAudioStemTask) GetCreator() string {
+func (x *MsgSubmitValidation) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreateAudioStemTask) GetCid() string {
+func (x *MsgSubmitValidation) GetTaskId() string {
 	if x != nil {
-		return x.Cid
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgCreateAudioStemTask) GetAmountFiles() int32 {
+func (x *MsgSubmitValidation) GetThreadId() string {
 	if x != nil {
-		return x.AmountFiles
+		return x.ThreadId
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgCreateAudioStemTask) GetInstrument() string {
+func (x *MsgSubmitValidation) GetPublicKey() string {
 	if x != nil {
-		return x.Instrument
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *MsgCreateAudioStemTask) GetMp3() bool {
+func (x *MsgSubmitValidation) GetCommitment() string {
 	if x != nil {
-		return x.Mp3
+		return x.Commitment
 	}
-	return false
+	return ""
 }
 
-func (x *MsgCreateAudioStemTask) GetReward() *v1beta1.Coin {
+func (x *MsgSubmitValidation) GetSignature() string {
 	if x != nil {
-		return x.Reward
+		return x.Signature
 	}
-	return nil
+	return ""
 }
 
-// MsgCreateGameResponse defines the Msg/CreateGame response type.
-type MsgCreateAudioStemTaskResponse struct {
+type MsgSubmitValidationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (x *MsgCreateAudioStemTaskResponse) Reset() {
-	*x = MsgCreateAudioStemTaskResponse{}
+func (x *MsgSubmitValidationResponse) Reset() {
+	*x = MsgSubmitValidationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[1]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateAudioStemTaskResponse) String() string {
+func (x *MsgSubmitValidationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateAudioStemTaskResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateAudioStemTaskResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateAudioStemTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{1}
-}
+func (*MsgSubmitValidationResponse) ProtoMessage() {}
 
-func (x *MsgCreateAudioStemTaskResponse) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-type MsgAddWorker struct {
+type MsgSubmitSolution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
-	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
-	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId             string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId           string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Dir                string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	AverageStemSeconds int64  `protobuf:"varint,5,opt,name=average_stem_seconds,json=averageStemSeconds,proto3" json:"average_stem_seconds,omitempty"`
 }
 
-func (x *MsgAddWorker) Reset() {
-	*x = MsgAddWorker{}
+func (x *MsgSubmitSolution) Reset() {
+	*x = MsgSubmitSolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[2]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorker) String() string {
+func (x *MsgSubmitSolution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorker) ProtoMessage() {}
+func (*MsgSubmitSolution) ProtoMessage() {}
 
-// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
-func (*MsgAddWorker) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MsgAddWorker) GetCreator() string {
+func (x *MsgSubmitSolution) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetPublicIp() string {
+func (x *MsgSubmitSolution) GetTaskId() string {
 	if x != nil {
-		return x.PublicIp
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetIpfsId() string {
+func (x *MsgSubmitSolution) GetThreadId() string {
 	if x != nil {
-		return x.IpfsId
+		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+func (x *MsgSubmitSolution) GetDir() string {
 	if x != nil {
-		return x.Stake
+		return x.Dir
 	}
-	return nil
+	return ""
 }
 
-type MsgAddWorkerResponse struct {
+func (x *MsgSubmitSolution) GetAverageStemSeconds() int64 {
+	if x != nil {
+		return x.AverageStemSeconds
+	}
+	return 0
+}
+
+type MsgSubmitSolutionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MsgAddWorkerResponse) Reset() {
-	*x = MsgAddWorkerResponse{}
+func (x *MsgSubmitSolutionResponse) Reset() {
+	*x = MsgSubmitSolutionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[3]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorkerResponse) String() string {
+func (x *MsgSubmitSolutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorkerResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *MsgAddWorkerResponse) GetOk() bool {
-	if x != nil {
-		return x.Ok
-	}
-	return false
-}
+func (*MsgSubmitSolutionResponse) ProtoMessage() {}
 
-func (x *MsgAddWorkerResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-type MsgSubscribeWorkerToTask struct {
+// Msg sent by a worker subscribed to a thread when its input isn't valid audio
+type MsgReportInvalidInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
 	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgSubscribeWorkerToTask) Reset() {
-	*x = MsgSubscribeWorkerToTask{}
+func (x *MsgReportInvalidInput) Reset() {
+	*x = MsgReportInvalidInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[4]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubscribeWorkerToTask) String() string {
+func (x *MsgReportInvalidInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubscribeWorkerToTask) ProtoMessage() {}
+func (*MsgReportInvalidInput) ProtoMessage() {}
 
-// Deprecated: Use MsgSubscribeWorkerToTask.ProtoReflect.Descriptor instead.
-func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgReportInvalidInput.ProtoReflect.Descriptor instead.
+func (*MsgReportInvalidInput) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgSubscribeWorkerToTask) GetAddress() string {
+func (x *MsgReportInvalidInput) GetCreator() string {
 	if x != nil {
-		return x.Address
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgSubscribeWorkerToTask) GetTaskId() string {
+func (x *MsgReportInvalidInput) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgSubscribeWorkerToTask) GetThreadId() string {
+func (x *MsgReportInvalidInput) GetThreadId() string {
 	if x != nil {
 		return x.ThreadId
 	}
 	return ""
 }
 
-type MsgSubscribeWorkerToTaskResponse struct {
+func (x *MsgReportInvalidInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MsgReportInvalidInputResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	// true once enough workers reported the input and the task was rejected
+	Rejected bool `protobuf:"varint,1,opt,name=rejected,proto3" json:"rejected,omitempty"`
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) Reset() {
-	*x = MsgSubscribeWorkerToTaskResponse{}
+func (x *MsgReportInvalidInputResponse) Reset() {
+	*x = MsgReportInvalidInputResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[5]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) String() string {
+func (x *MsgReportInvalidInputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage() {}
+func (*MsgReportInvalidInputResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubscribeWorkerToTaskResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgReportInvalidInputResponse.ProtoReflect.Descriptor instead.
+func (*MsgReportInvalidInputResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
+func (x *MsgReportInvalidInputResponse) GetRejected() bool {
 	if x != nil {
-		return x.ThreadId
+		return x.Rejected
 	}
-	return ""
+	return false
 }
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgProposeSolution struct {
+// Msg sent by a worker subscribed to a thread whose input it can't process for reasons of its
+// own, like an input above the size the node downloads. Unlike a report, it says nothing
+// about the input.
+type MsgLeaveThread struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
 }
 
-func (x *MsgProposeSolution) Reset() {
-	*x = MsgProposeSolution{}
+func (x *MsgLeaveThread) Reset() {
+	*x = MsgLeaveThread{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[6]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgProposeSolution) String() string {
+func (x *MsgLeaveThread) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgProposeSolution) ProtoMessage() {}
+func (*MsgLeaveThread) ProtoMessage() {}
 
-// Deprecated: Use MsgProposeSolution.ProtoReflect.Descriptor instead.
-func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgLeaveThread.ProtoReflect.Descriptor instead.
+func (*MsgLeaveThread) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgProposeSolution) GetCreator() string {
+func (x *MsgLeaveThread) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetTaskId() string {
+func (x *MsgLeaveThread) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetThreadId() string {
+func (x *MsgLeaveThread) GetThreadId() string {
 	if x != nil {
 		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetPublicKey() string {
-	if x != nil {
-		return x.PublicKey
-	}
-	return ""
-}
-
-func (x *MsgProposeSolution) GetSignatures() []string {
-	if x != nil {
-		return x.Signatures
-	}
-	return nil
-}
-
-// no response needed to a proposed solution
-type MsgProposeSolutionResponse struct {
+type MsgLeaveThreadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgProposeSolutionResponse) Reset() {
-	*x = MsgProposeSolutionResponse{}
+func (x *MsgLeaveThreadResponse) Reset() {
+	*x = MsgLeaveThreadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[7]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgProposeSolutionResponse) String() string {
+func (x *MsgLeaveThreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgProposeSolutionResponse) ProtoMessage() {}
+func (*MsgLeaveThreadResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgProposeSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgLeaveThreadResponse.ProtoReflect.Descriptor instead.
+func (*MsgLeaveThreadResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgRevealSolution struct {
+// Msg sent by the operator of a worker, the account that staked, to let a hot key of the
+// worker node send its messages once it accepts. Winnings and slashes still apply to the operator.
+type MsgSetWorkerSigner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Stems    []string `protobuf:"bytes,4,rep,name=stems,proto3" json:"stems,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// empty to remove the signer of the worker
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *MsgRevealSolution) Reset() {
-	*x = MsgRevealSolution{}
+func (x *MsgSetWorkerSigner) Reset() {
+	*x = MsgSetWorkerSigner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[8]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealSolution) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgRevealSolution) ProtoMessage() {}
-
-// Deprecated: Use MsgRevealSolution.ProtoReflect.Descriptor instead.
-func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *MsgRevealSolution) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
-
-func (x *MsgRevealSolution) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+func (x *MsgSetWorkerSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgRevealSolution) GetThreadId() string {
+func (*MsgSetWorkerSigner) ProtoMessage() {}
+
+// Deprecated: Use MsgSetWorkerSigner.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkerSigner) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MsgSetWorkerSigner) GetCreator() string {
 	if x != nil {
-		return x.ThreadId
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRevealSolution) GetStems() []string {
+func (x *MsgSetWorkerSigner) GetSigner() string {
 	if x != nil {
-		return x.Stems
+		return x.Signer
 	}
-	return nil
+	return ""
 }
 
-// no response needed to a proposed solution
-type MsgRevealSolutionResponse struct {
+type MsgSetWorkerSignerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRevealSolutionResponse) Reset() {
-	*x = MsgRevealSolutionResponse{}
+func (x *MsgSetWorkerSignerResponse) Reset() {
+	*x = MsgSetWorkerSignerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[9]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealSolutionResponse) String() string {
+func (x *MsgSetWorkerSignerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevealSolutionResponse) ProtoMessage() {}
+func (*MsgSetWorkerSignerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevealSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgSetWorkerSignerResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkerSignerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-type MsgSubmitValidation struct {
+// Msg sent by the signer an operator set, to start sending the messages of its worker.
+type MsgAcceptWorkerSigner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Worker  string `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
 }
 
-func (x *MsgSubmitValidation) Reset() {
-	*x = MsgSubmitValidation{}
+func (x *MsgAcceptWorkerSigner) Reset() {
+	*x = MsgAcceptWorkerSigner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[10]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitValidation) String() string {
+func (x *MsgAcceptWorkerSigner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitValidation) ProtoMessage() {}
+func (*MsgAcceptWorkerSigner) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitValidation.ProtoReflect.Descriptor instead.
-func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgAcceptWorkerSigner.ProtoReflect.Descriptor instead.
+func (*MsgAcceptWorkerSigner) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgSubmitValidation) GetCreator() string {
+func (x *MsgAcceptWorkerSigner) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgSubmitValidation) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *MsgSubmitValidation) GetThreadId() string {
-	if x != nil {
-		return x.ThreadId
-	}
-	return ""
-}
-
-func (x *MsgSubmitValidation) GetPublicKey() string {
+func (x *MsgAcceptWorkerSigner) GetWorker() string {
 	if x != nil {
-		return x.PublicKey
+		return x.Worker
 	}
 	return ""
 }
 
-func (x *MsgSubmitValidation) GetSignatures() []string {
-	if x != nil {
-		return x.Signatures
-	}
-	return nil
-}
-
-type MsgSubmitValidationResponse struct {
+type MsgAcceptWorkerSignerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitValidationResponse) Reset() {
-	*x = MsgSubmitValidationResponse{}
+func (x *MsgAcceptWorkerSignerResponse) Reset() {
+	*x = MsgAcceptWorkerSignerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[11]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitValidationResponse) String() string {
+func (x *MsgAcceptWorkerSignerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitValidationResponse) ProtoMessage() {}
+func (*MsgAcceptWorkerSignerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgAcceptWorkerSignerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptWorkerSignerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-type MsgSubmitSolution struct {
+type MsgUpdateParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId             string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId           string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Dir                string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	AverageStemSeconds int64  `protobuf:"varint,5,opt,name=average_stem_seconds,json=averageStemSeconds,proto3" json:"average_stem_seconds,omitempty"`
+	// the governance module unless the app sets another authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// every param must be set, the current ones are replaced
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *MsgSubmitSolution) Reset() {
-	*x = MsgSubmitSolution{}
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[12]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolution) String() string {
+func (x *MsgUpdateParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolution) ProtoMessage() {}
-
-// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *MsgSubmitSolution) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
-
-func (x *MsgSubmitSolution) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
+func (*MsgUpdateParams) ProtoMessage() {}
 
-func (x *MsgSubmitSolution) GetThreadId() string {
-	if x != nil {
-		return x.ThreadId
-	}
-	return ""
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MsgSubmitSolution) GetDir() string {
+func (x *MsgUpdateParams) GetAuthority() string {
 	if x != nil {
-		return x.Dir
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetAverageStemSeconds() int64 {
+func (x *MsgUpdateParams) GetParams() *Params {
 	if x != nil {
-		return x.AverageStemSeconds
+		return x.Params
 	}
-	return 0
+	return nil
 }
 
-type MsgSubmitSolutionResponse struct {
+type MsgUpdateParamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitSolutionResponse) Reset() {
-	*x = MsgSubmitSolutionResponse{}
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[13]
+		mi := &file_janction_audioStem_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolutionResponse) String() string {
+func (x *MsgUpdateParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolutionResponse) ProtoMessage() {}
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
 var File_janction_audioStem_v1_tx_proto protoreflect.FileDescriptor
@@ -8207,7 +15117,7 @@ var file_janction_audioStem_v1_tx_proto_rawDesc = []byte{
 	0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
 	0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
 	0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x4d,
+	0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x4d,
 	0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74,
@@ -8216,113 +15126,239 @@ var file_janction_audioStem_v1_tx_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
 	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0c,
-	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
 	0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x4d,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x4d,
 	0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
 	0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
 	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x65, 0x6d, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xb0, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
-	0x65, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xad, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x7b, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61,
-	0x73, 0x6b, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x09, 0x41, 0x64, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a, 0x2b, 0x2e, 0x6a, 0x61,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x52, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22,
+	0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x1d, 0x0a, 0x1b,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x13,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x05,
+	0x10, 0x06, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x1d,
+	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x01,
+	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x69, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x65, 0x6d, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8b, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3b,
+	0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x0e, 0x4d,
+	0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x71, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19,
+	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd3, 0x0b, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x12, 0x7b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d,
+	0x0a, 0x09, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6a, 0x61,
 	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
 	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54,
-	0x61, 0x73, 0x6b, 0x1a, 0x37, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0f,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x29, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x1a, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01,
+	0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x37, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6f, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
 	0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x31, 0x2e, 0x6a, 0x61, 0x6e,
+	0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x30, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
-	0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x30, 0x2e,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x30, 0x2e, 0x6a, 0x61,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80,
-	0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xdf, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x30, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12,
+	0x25, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
-	0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4a, 0x61, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
-	0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x66, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x26, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42,
+	0xdf, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
+	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76,
+	0x31, 0x3b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4a, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8337,7 +15373,7 @@ func file_janction_audioStem_v1_tx_proto_rawDescGZIP() []byte {
 	return file_janction_audioStem_v1_tx_proto_rawDescData
 }
 
-var file_janction_audioStem_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_janction_audioStem_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_janction_audioStem_v1_tx_proto_goTypes = []interface{}{
 	(*MsgCreateAudioStemTask)(nil),           // 0: janction.audioStem.v1.MsgCreateAudioStemTask
 	(*MsgCreateAudioStemTaskResponse)(nil),   // 1: janction.audioStem.v1.MsgCreateAudioStemTaskResponse
@@ -8349,34 +15385,63 @@ var file_janction_audioStem_v1_tx_proto_goTypes = []interface{}{
 	(*MsgProposeSolutionResponse)(nil),       // 7: janction.audioStem.v1.MsgProposeSolutionResponse
 	(*MsgRevealSolution)(nil),                // 8: janction.audioStem.v1.MsgRevealSolution
 	(*MsgRevealSolutionResponse)(nil),        // 9: janction.audioStem.v1.MsgRevealSolutionResponse
-	(*MsgSubmitValidation)(nil),              // 10: janction.audioStem.v1.MsgSubmitValidation
-	(*MsgSubmitValidationResponse)(nil),      // 11: janction.audioStem.v1.MsgSubmitValidationResponse
-	(*MsgSubmitSolution)(nil),                // 12: janction.audioStem.v1.MsgSubmitSolution
-	(*MsgSubmitSolutionResponse)(nil),        // 13: janction.audioStem.v1.MsgSubmitSolutionResponse
-	(*v1beta1.Coin)(nil),                     // 14: cosmos.base.v1beta1.Coin
+	(*MsgRevealValidation)(nil),              // 10: janction.audioStem.v1.MsgRevealValidation
+	(*StemReveal)(nil),                       // 11: janction.audioStem.v1.StemReveal
+	(*MsgRevealValidationResponse)(nil),      // 12: janction.audioStem.v1.MsgRevealValidationResponse
+	(*MsgSubmitValidation)(nil),              // 13: janction.audioStem.v1.MsgSubmitValidation
+	(*MsgSubmitValidationResponse)(nil),      // 14: janction.audioStem.v1.MsgSubmitValidationResponse
+	(*MsgSubmitSolution)(nil),                // 15: janction.audioStem.v1.MsgSubmitSolution
+	(*MsgSubmitSolutionResponse)(nil),        // 16: janction.audioStem.v1.MsgSubmitSolutionResponse
+	(*MsgReportInvalidInput)(nil),            // 17: janction.audioStem.v1.MsgReportInvalidInput
+	(*MsgReportInvalidInputResponse)(nil),    // 18: janction.audioStem.v1.MsgReportInvalidInputResponse
+	(*MsgLeaveThread)(nil),                   // 19: janction.audioStem.v1.MsgLeaveThread
+	(*MsgLeaveThreadResponse)(nil),           // 20: janction.audioStem.v1.MsgLeaveThreadResponse
+	(*MsgSetWorkerSigner)(nil),               // 21: janction.audioStem.v1.MsgSetWorkerSigner
+	(*MsgSetWorkerSignerResponse)(nil),       // 22: janction.audioStem.v1.MsgSetWorkerSignerResponse
+	(*MsgAcceptWorkerSigner)(nil),            // 23: janction.audioStem.v1.MsgAcceptWorkerSigner
+	(*MsgAcceptWorkerSignerResponse)(nil),    // 24: janction.audioStem.v1.MsgAcceptWorkerSignerResponse
+	(*MsgUpdateParams)(nil),                  // 25: janction.audioStem.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),          // 26: janction.audioStem.v1.MsgUpdateParamsResponse
+	(*v1beta1.Coin)(nil),                     // 27: cosmos.base.v1beta1.Coin
+	(*Params)(nil),                           // 28: janction.audioStem.v1.Params
 }
 var file_janction_audioStem_v1_tx_proto_depIdxs = []int32{
-	14, // 0: janction.audioStem.v1.MsgCreateAudioStemTask.reward:type_name -> cosmos.base.v1beta1.Coin
-	14, // 1: janction.audioStem.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 2: janction.audioStem.v1.Msg.CreateAudioStemTask:input_type -> janction.audioStem.v1.MsgCreateAudioStemTask
-	2,  // 3: janction.audioStem.v1.Msg.AddWorker:input_type -> janction.audioStem.v1.MsgAddWorker
-	4,  // 4: janction.audioStem.v1.Msg.SubscribeWorkerToTask:input_type -> janction.audioStem.v1.MsgSubscribeWorkerToTask
-	6,  // 5: janction.audioStem.v1.Msg.ProposeSolution:input_type -> janction.audioStem.v1.MsgProposeSolution
-	10, // 6: janction.audioStem.v1.Msg.SubmitValidation:input_type -> janction.audioStem.v1.MsgSubmitValidation
-	8,  // 7: janction.audioStem.v1.Msg.RevealSolution:input_type -> janction.audioStem.v1.MsgRevealSolution
-	12, // 8: janction.audioStem.v1.Msg.SubmitSolution:input_type -> janction.audioStem.v1.MsgSubmitSolution
-	1,  // 9: janction.audioStem.v1.Msg.CreateAudioStemTask:output_type -> janction.audioStem.v1.MsgCreateAudioStemTaskResponse
-	3,  // 10: janction.audioStem.v1.Msg.AddWorker:output_type -> janction.audioStem.v1.MsgAddWorkerResponse
-	5,  // 11: janction.audioStem.v1.Msg.SubscribeWorkerToTask:output_type -> janction.audioStem.v1.MsgSubscribeWorkerToTaskResponse
-	7,  // 12: janction.audioStem.v1.Msg.ProposeSolution:output_type -> janction.audioStem.v1.MsgProposeSolutionResponse
-	11, // 13: janction.audioStem.v1.Msg.SubmitValidation:output_type -> janction.audioStem.v1.MsgSubmitValidationResponse
-	9,  // 14: janction.audioStem.v1.Msg.RevealSolution:output_type -> janction.audioStem.v1.MsgRevealSolutionResponse
-	13, // 15: janction.audioStem.v1.Msg.SubmitSolution:output_type -> janction.audioStem.v1.MsgSubmitSolutionResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	27, // 0: janction.audioStem.v1.MsgCreateAudioStemTask.reward:type_name -> cosmos.base.v1beta1.Coin
+	27, // 1: janction.audioStem.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
+	11, // 2: janction.audioStem.v1.MsgRevealSolution.stems:type_name -> janction.audioStem.v1.StemReveal
+	11, // 3: janction.audioStem.v1.MsgRevealValidation.stems:type_name -> janction.audioStem.v1.StemReveal
+	28, // 4: janction.audioStem.v1.MsgUpdateParams.params:type_name -> janction.audioStem.v1.Params
+	0,  // 5: janction.audioStem.v1.Msg.CreateAudioStemTask:input_type -> janction.audioStem.v1.MsgCreateAudioStemTask
+	2,  // 6: janction.audioStem.v1.Msg.AddWorker:input_type -> janction.audioStem.v1.MsgAddWorker
+	4,  // 7: janction.audioStem.v1.Msg.SubscribeWorkerToTask:input_type -> janction.audioStem.v1.MsgSubscribeWorkerToTask
+	6,  // 8: janction.audioStem.v1.Msg.ProposeSolution:input_type -> janction.audioStem.v1.MsgProposeSolution
+	13, // 9: janction.audioStem.v1.Msg.SubmitValidation:input_type -> janction.audioStem.v1.MsgSubmitValidation
+	8,  // 10: janction.audioStem.v1.Msg.RevealSolution:input_type -> janction.audioStem.v1.MsgRevealSolution
+	10, // 11: janction.audioStem.v1.Msg.RevealValidation:input_type -> janction.audioStem.v1.MsgRevealValidation
+	15, // 12: janction.audioStem.v1.Msg.SubmitSolution:input_type -> janction.audioStem.v1.MsgSubmitSolution
+	17, // 13: janction.audioStem.v1.Msg.ReportInvalidInput:input_type -> janction.audioStem.v1.MsgReportInvalidInput
+	19, // 14: janction.audioStem.v1.Msg.LeaveThread:input_type -> janction.audioStem.v1.MsgLeaveThread
+	21, // 15: janction.audioStem.v1.Msg.SetWorkerSigner:input_type -> janction.audioStem.v1.MsgSetWorkerSigner
+	23, // 16: janction.audioStem.v1.Msg.AcceptWorkerSigner:input_type -> janction.audioStem.v1.MsgAcceptWorkerSigner
+	25, // 17: janction.audioStem.v1.Msg.UpdateParams:input_type -> janction.audioStem.v1.MsgUpdateParams
+	1,  // 18: janction.audioStem.v1.Msg.CreateAudioStemTask:output_type -> janction.audioStem.v1.MsgCreateAudioStemTaskResponse
+	3,  // 19: janction.audioStem.v1.Msg.AddWorker:output_type -> janction.audioStem.v1.MsgAddWorkerResponse
+	5,  // 20: janction.audioStem.v1.Msg.SubscribeWorkerToTask:output_type -> janction.audioStem.v1.MsgSubscribeWorkerToTaskResponse
+	7,  // 21: janction.audioStem.v1.Msg.ProposeSolution:output_type -> janction.audioStem.v1.MsgProposeSolutionResponse
+	14, // 22: janction.audioStem.v1.Msg.SubmitValidation:output_type -> janction.audioStem.v1.MsgSubmitValidationResponse
+	9,  // 23: janction.audioStem.v1.Msg.RevealSolution:output_type -> janction.audioStem.v1.MsgRevealSolutionResponse
+	12, // 24: janction.audioStem.v1.Msg.RevealValidation:output_type -> janction.audioStem.v1.MsgRevealValidationResponse
+	16, // 25: janction.audioStem.v1.Msg.SubmitSolution:output_type -> janction.audioStem.v1.MsgSubmitSolutionResponse
+	18, // 26: janction.audioStem.v1.Msg.ReportInvalidInput:output_type -> janction.audioStem.v1.MsgReportInvalidInputResponse
+	20, // 27: janction.audioStem.v1.Msg.LeaveThread:output_type -> janction.audioStem.v1.MsgLeaveThreadResponse
+	22, // 28: janction.audioStem.v1.Msg.SetWorkerSigner:output_type -> janction.audioStem.v1.MsgSetWorkerSignerResponse
+	24, // 29: janction.audioStem.v1.Msg.AcceptWorkerSigner:output_type -> janction.audioStem.v1.MsgAcceptWorkerSignerResponse
+	26, // 30: janction.audioStem.v1.Msg.UpdateParams:output_type -> janction.audioStem.v1.MsgUpdateParamsResponse
+	18, // [18:31] is the sub-list for method output_type
+	5,  // [5:18] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_janction_audioStem_v1_tx_proto_init() }
@@ -8507,7 +15572,7 @@ func file_janction_audioStem_v1_tx_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitValidation); i {
+			switch v := v.(*MsgRevealValidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8519,7 +15584,7 @@ func file_janction_audioStem_v1_tx_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitValidationResponse); i {
+			switch v := v.(*StemReveal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8531,7 +15596,7 @@ func file_janction_audioStem_v1_tx_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitSolution); i {
+			switch v := v.(*MsgRevealValidationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8543,6 +15608,42 @@ func file_janction_audioStem_v1_tx_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitValidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgSubmitSolutionResponse); i {
 			case 0:
 				return &v.state
@@ -8554,6 +15655,126 @@ func file_janction_audioStem_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportInvalidInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportInvalidInputResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveThread); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveThreadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetWorkerSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetWorkerSignerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptWorkerSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptWorkerSignerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8561,7 +15782,7 @@ func file_janction_audioStem_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_janction_audioStem_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,7 +25,13 @@ const (
 	Msg_ProposeSolution_FullMethodName       = "/janction.audioStem.v1.Msg/ProposeSolution"
 	Msg_SubmitValidation_FullMethodName      = "/janction.audioStem.v1.Msg/SubmitValidation"
 	Msg_RevealSolution_FullMethodName        = "/janction.audioStem.v1.Msg/RevealSolution"
+	Msg_RevealValidation_FullMethodName      = "/janction.audioStem.v1.Msg/RevealValidation"
 	Msg_SubmitSolution_FullMethodName        = "/janction.audioStem.v1.Msg/SubmitSolution"
+	Msg_ReportInvalidInput_FullMethodName    = "/janction.audioStem.v1.Msg/ReportInvalidInput"
+	Msg_LeaveThread_FullMethodName           = "/janction.audioStem.v1.Msg/LeaveThread"
+	Msg_SetWorkerSigner_FullMethodName       = "/janction.audioStem.v1.Msg/SetWorkerSigner"
+	Msg_AcceptWorkerSigner_FullMethodName    = "/janction.audioStem.v1.Msg/AcceptWorkerSigner"
+	Msg_UpdateParams_FullMethodName          = "/janction.audioStem.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,8 +49,19 @@ type MsgClient interface {
 	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+	// Reports that the input of a task can't be processed
+	ReportInvalidInput(ctx context.Context, in *MsgReportInvalidInput, opts ...grpc.CallOption) (*MsgReportInvalidInputResponse, error)
+	// Leaves a thread whose input the worker can't process, so another worker can take it
+	LeaveThread(ctx context.Context, in *MsgLeaveThread, opts ...grpc.CallOption) (*MsgLeaveThreadResponse, error)
+	// Sets the key that sends the messages of a worker on behalf of its operator
+	SetWorkerSigner(ctx context.Context, in *MsgSetWorkerSigner, opts ...grpc.CallOption) (*MsgSetWorkerSignerResponse, error)
+	// Accepts to send the messages of the worker whose operator set it as signer
+	AcceptWorkerSigner(ctx context.Context, in *MsgAcceptWorkerSigner, opts ...grpc.CallOption) (*MsgAcceptWorkerSignerResponse, error)
+	// Updates the params of the module, only the authority can send it
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -109,6 +126,15 @@ func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, o
 	return out, nil
 }
 
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealValidation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
 	out := new(MsgSubmitSolutionResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSolution_FullMethodName, in, out, opts...)
@@ -118,6 +144,51 @@ func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, o
 	return out, nil
 }
 
+func (c *msgClient) ReportInvalidInput(ctx context.Context, in *MsgReportInvalidInput, opts ...grpc.CallOption) (*MsgReportInvalidInputResponse, error) {
+	out := new(MsgReportInvalidInputResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportInvalidInput_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveThread(ctx context.Context, in *MsgLeaveThread, opts ...grpc.CallOption) (*MsgLeaveThreadResponse, error) {
+	out := new(MsgLeaveThreadResponse)
+	err := c.cc.Invoke(ctx, Msg_LeaveThread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWorkerSigner(ctx context.Context, in *MsgSetWorkerSigner, opts ...grpc.CallOption) (*MsgSetWorkerSignerResponse, error) {
+	out := new(MsgSetWorkerSignerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWorkerSigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptWorkerSigner(ctx context.Context, in *MsgAcceptWorkerSigner, opts ...grpc.CallOption) (*MsgAcceptWorkerSignerResponse, error) {
+	out := new(MsgAcceptWorkerSignerResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptWorkerSigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -133,8 +204,19 @@ type MsgServer interface {
 	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+	// Reports that the input of a task can't be processed
+	ReportInvalidInput(context.Context, *MsgReportInvalidInput) (*MsgReportInvalidInputResponse, error)
+	// Leaves a thread whose input the worker can't process, so another worker can take it
+	LeaveThread(context.Context, *MsgLeaveThread) (*MsgLeaveThreadResponse, error)
+	// Sets the key that sends the messages of a worker on behalf of its operator
+	SetWorkerSigner(context.Context, *MsgSetWorkerSigner) (*MsgSetWorkerSignerResponse, error)
+	// Accepts to send the messages of the worker whose operator set it as signer
+	AcceptWorkerSigner(context.Context, *MsgAcceptWorkerSigner) (*MsgAcceptWorkerSignerResponse, error)
+	// Updates the params of the module, only the authority can send it
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -160,9 +242,27 @@ func (UnimplementedMsgServer) SubmitValidation(context.Context, *MsgSubmitValida
 func (UnimplementedMsgServer) RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
 }
+func (UnimplementedMsgServer) RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealValidation not implemented")
+}
 func (UnimplementedMsgServer) SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
 }
+func (UnimplementedMsgServer) ReportInvalidInput(context.Context, *MsgReportInvalidInput) (*MsgReportInvalidInputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportInvalidInput not implemented")
+}
+func (UnimplementedMsgServer) LeaveThread(context.Context, *MsgLeaveThread) (*MsgLeaveThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveThread not implemented")
+}
+func (UnimplementedMsgServer) SetWorkerSigner(context.Context, *MsgSetWorkerSigner) (*MsgSetWorkerSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkerSigner not implemented")
+}
+func (UnimplementedMsgServer) AcceptWorkerSigner(context.Context, *MsgAcceptWorkerSigner) (*MsgAcceptWorkerSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWorkerSigner not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +384,24 @@ func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSolution)
 	if err := dec(in); err != nil {
@@ -302,6 +420,96 @@ func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportInvalidInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportInvalidInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportInvalidInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportInvalidInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportInvalidInput(ctx, req.(*MsgReportInvalidInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveThread)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LeaveThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveThread(ctx, req.(*MsgLeaveThread))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWorkerSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWorkerSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWorkerSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWorkerSigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWorkerSigner(ctx, req.(*MsgSetWorkerSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptWorkerSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptWorkerSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptWorkerSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptWorkerSigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptWorkerSigner(ctx, req.(*MsgAcceptWorkerSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,10 +541,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevealSolution",
 			Handler:    _Msg_RevealSolution_Handler,
 		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
 		{
 			MethodName: "SubmitSolution",
 			Handler:    _Msg_SubmitSolution_Handler,
 		},
+		{
+			MethodName: "ReportInvalidInput",
+			Handler:    _Msg_ReportInvalidInput_Handler,
+		},
+		{
+			MethodName: "LeaveThread",
+			Handler:    _Msg_LeaveThread_Handler,
+		},
+		{
+			MethodName: "SetWorkerSigner",
+			Handler:    _Msg_SetWorkerSigner_Handler,
+		},
+		{
+			MethodName: "AcceptWorkerSigner",
+			Handler:    _Msg_AcceptWorkerSigner_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/audioStem/v1/tx.proto",
@@ -16,10 +16,18 @@ import (
 )
 
 var (
-	md_Params                        protoreflect.MessageDescriptor
-	fd_Params_min_worker_staking     protoreflect.FieldDescriptor
-	fd_Params_max_workers_per_thread protoreflect.FieldDescriptor
-	fd_Params_min_validators         protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_min_worker_staking          protoreflect.FieldDescriptor
+	fd_Params_max_workers_per_thread      protoreflect.FieldDescriptor
+	fd_Params_min_validators              protoreflect.FieldDescriptor
+	fd_Params_min_invalid_input_reports   protoreflect.FieldDescriptor
+	fd_Params_probing_fee_percent         protoreflect.FieldDescriptor
+	fd_Params_min_stem_similarity_percent protoreflect.FieldDescriptor
+	fd_Params_reveal_period_blocks        protoreflect.FieldDescriptor
+	fd_Params_max_solution_attempts       protoreflect.FieldDescriptor
+	fd_Params_rejection_slash_percent     protoreflect.FieldDescriptor
+	fd_Params_min_valid_validations       protoreflect.FieldDescriptor
+	fd_Params_min_valid_stems_percent     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +36,14 @@ func init() {
 	fd_Params_min_worker_staking = md_Params.Fields().ByName("min_worker_staking")
 	fd_Params_max_workers_per_thread = md_Params.Fields().ByName("max_workers_per_thread")
 	fd_Params_min_validators = md_Params.Fields().ByName("min_validators")
+	fd_Params_min_invalid_input_reports = md_Params.Fields().ByName("min_invalid_input_reports")
+	fd_Params_probing_fee_percent = md_Params.Fields().ByName("probing_fee_percent")
+	fd_Params_min_stem_similarity_percent = md_Params.Fields().ByName("min_stem_similarity_percent")
+	fd_Params_reveal_period_blocks = md_Params.Fields().ByName("reveal_period_blocks")
+	fd_Params_max_solution_attempts = md_Params.Fields().ByName("max_solution_attempts")
+	fd_Params_rejection_slash_percent = md_Params.Fields().ByName("rejection_slash_percent")
+	fd_Params_min_valid_validations = md_Params.Fields().ByName("min_valid_validations")
+	fd_Params_min_valid_stems_percent = md_Params.Fields().ByName("min_valid_stems_percent")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -113,6 +129,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MinInvalidInputReports != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinInvalidInputReports)
+		if !f(fd_Params_min_invalid_input_reports, value) {
+			return
+		}
+	}
+	if x.ProbingFeePercent != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProbingFeePercent)
+		if !f(fd_Params_probing_fee_percent, value) {
+			return
+		}
+	}
+	if x.MinStemSimilarityPercent != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinStemSimilarityPercent)
+		if !f(fd_Params_min_stem_similarity_percent, value) {
+			return
+		}
+	}
+	if x.RevealPeriodBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RevealPeriodBlocks)
+		if !f(fd_Params_reveal_period_blocks, value) {
+			return
+		}
+	}
+	if x.MaxSolutionAttempts != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxSolutionAttempts)
+		if !f(fd_Params_max_solution_attempts, value) {
+			return
+		}
+	}
+	if x.RejectionSlashPercent != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RejectionSlashPercent)
+		if !f(fd_Params_rejection_slash_percent, value) {
+			return
+		}
+	}
+	if x.MinValidValidations != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinValidValidations)
+		if !f(fd_Params_min_valid_validations, value) {
+			return
+		}
+	}
+	if x.MinValidStemsPercent != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinValidStemsPercent)
+		if !f(fd_Params_min_valid_stems_percent, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -134,6 +198,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxWorkersPerThread != int64(0)
 	case "janction.audioStem.v1.Params.min_validators":
 		return x.MinValidators != int64(0)
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		return x.MinInvalidInputReports != int64(0)
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		return x.ProbingFeePercent != int64(0)
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		return x.MinStemSimilarityPercent != int64(0)
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		return x.RevealPeriodBlocks != int64(0)
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		return x.MaxSolutionAttempts != int64(0)
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		return x.RejectionSlashPercent != int64(0)
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		return x.MinValidValidations != int64(0)
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		return x.MinValidStemsPercent != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -156,6 +236,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxWorkersPerThread = int64(0)
 	case "janction.audioStem.v1.Params.min_validators":
 		x.MinValidators = int64(0)
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		x.MinInvalidInputReports = int64(0)
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		x.ProbingFeePercent = int64(0)
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		x.MinStemSimilarityPercent = int64(0)
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		x.RevealPeriodBlocks = int64(0)
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		x.MaxSolutionAttempts = int64(0)
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		x.RejectionSlashPercent = int64(0)
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		x.MinValidValidations = int64(0)
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		x.MinValidStemsPercent = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -181,6 +277,30 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "janction.audioStem.v1.Params.min_validators":
 		value := x.MinValidators
 		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		value := x.MinInvalidInputReports
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		value := x.ProbingFeePercent
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		value := x.MinStemSimilarityPercent
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		value := x.RevealPeriodBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		value := x.MaxSolutionAttempts
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		value := x.RejectionSlashPercent
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		value := x.MinValidValidations
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		value := x.MinValidStemsPercent
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -207,6 +327,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxWorkersPerThread = value.Int()
 	case "janction.audioStem.v1.Params.min_validators":
 		x.MinValidators = value.Int()
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		x.MinInvalidInputReports = value.Int()
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		x.ProbingFeePercent = value.Int()
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		x.MinStemSimilarityPercent = value.Int()
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		x.RevealPeriodBlocks = value.Int()
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		x.MaxSolutionAttempts = value.Int()
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		x.RejectionSlashPercent = value.Int()
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		x.MinValidValidations = value.Int()
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		x.MinValidStemsPercent = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -236,6 +372,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_workers_per_thread of message janction.audioStem.v1.Params is not mutable"))
 	case "janction.audioStem.v1.Params.min_validators":
 		panic(fmt.Errorf("field min_validators of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		panic(fmt.Errorf("field min_invalid_input_reports of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		panic(fmt.Errorf("field probing_fee_percent of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		panic(fmt.Errorf("field min_stem_similarity_percent of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		panic(fmt.Errorf("field reveal_period_blocks of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		panic(fmt.Errorf("field max_solution_attempts of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		panic(fmt.Errorf("field rejection_slash_percent of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		panic(fmt.Errorf("field min_valid_validations of message janction.audioStem.v1.Params is not mutable"))
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		panic(fmt.Errorf("field min_valid_stems_percent of message janction.audioStem.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -256,6 +408,22 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "janction.audioStem.v1.Params.min_validators":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.min_invalid_input_reports":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.probing_fee_percent":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.min_stem_similarity_percent":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.reveal_period_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.max_solution_attempts":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.rejection_slash_percent":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.min_valid_validations":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.Params.min_valid_stems_percent":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Params"))
@@ -335,6 +503,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MinValidators != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinValidators))
 		}
+		if x.MinInvalidInputReports != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinInvalidInputReports))
+		}
+		if x.ProbingFeePercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProbingFeePercent))
+		}
+		if x.MinStemSimilarityPercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinStemSimilarityPercent))
+		}
+		if x.RevealPeriodBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealPeriodBlocks))
+		}
+		if x.MaxSolutionAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSolutionAttempts))
+		}
+		if x.RejectionSlashPercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectionSlashPercent))
+		}
+		if x.MinValidValidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinValidValidations))
+		}
+		if x.MinValidStemsPercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinValidStemsPercent))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -364,6 +556,46 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MinValidStemsPercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinValidStemsPercent))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MinValidValidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinValidValidations))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RejectionSlashPercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectionSlashPercent))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxSolutionAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSolutionAttempts))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RevealPeriodBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealPeriodBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MinStemSimilarityPercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinStemSimilarityPercent))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ProbingFeePercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProbingFeePercent))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinInvalidInputReports != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinInvalidInputReports))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.MinValidators != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinValidators))
 			i--
@@ -511,6 +743,158 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInvalidInputReports", wireType)
+				}
+				x.MinInvalidInputReports = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinInvalidInputReports |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProbingFeePercent", wireType)
+				}
+				x.ProbingFeePercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProbingFeePercent |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinStemSimilarityPercent", wireType)
+				}
+				x.MinStemSimilarityPercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinStemSimilarityPercent |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealPeriodBlocks", wireType)
+				}
+				x.RevealPeriodBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealPeriodBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSolutionAttempts", wireType)
+				}
+				x.MaxSolutionAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSolutionAttempts |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectionSlashPercent", wireType)
+				}
+				x.RejectionSlashPercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RejectionSlashPercent |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidValidations", wireType)
+				}
+				x.MinValidValidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinValidValidations |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidStemsPercent", wireType)
+				}
+				x.MinValidStemsPercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinValidStemsPercent |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1345,6 +1729,8 @@ var (
 	fd_Worker_current_thread_index protoreflect.FieldDescriptor
 	fd_Worker_public_ip            protoreflect.FieldDescriptor
 	fd_Worker_ipfs_id              protoreflect.FieldDescriptor
+	fd_Worker_signer               protoreflect.FieldDescriptor
+	fd_Worker_pending_signer       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1357,6 +1743,8 @@ func init() {
 	fd_Worker_current_thread_index = md_Worker.Fields().ByName("current_thread_index")
 	fd_Worker_public_ip = md_Worker.Fields().ByName("public_ip")
 	fd_Worker_ipfs_id = md_Worker.Fields().ByName("ipfs_id")
+	fd_Worker_signer = md_Worker.Fields().ByName("signer")
+	fd_Worker_pending_signer = md_Worker.Fields().ByName("pending_signer")
 }
 
 var _ protoreflect.Message = (*fastReflection_Worker)(nil)
@@ -1466,6 +1854,18 @@ func (x *fastReflection_Worker) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_Worker_signer, value) {
+			return
+		}
+	}
+	if x.PendingSigner != "" {
+		value := protoreflect.ValueOfString(x.PendingSigner)
+		if !f(fd_Worker_pending_signer, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1495,6 +1895,10 @@ func (x *fastReflection_Worker) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PublicIp != ""
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		return x.IpfsId != ""
+	case "janction.audioStem.v1.Worker.signer":
+		return x.Signer != ""
+	case "janction.audioStem.v1.Worker.pending_signer":
+		return x.PendingSigner != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1525,6 +1929,10 @@ func (x *fastReflection_Worker) Clear(fd protoreflect.FieldDescriptor) {
 		x.PublicIp = ""
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		x.IpfsId = ""
+	case "janction.audioStem.v1.Worker.signer":
+		x.Signer = ""
+	case "janction.audioStem.v1.Worker.pending_signer":
+		x.PendingSigner = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1562,6 +1970,12 @@ func (x *fastReflection_Worker) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		value := x.IpfsId
 		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.Worker.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.Worker.pending_signer":
+		value := x.PendingSigner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1596,6 +2010,10 @@ func (x *fastReflection_Worker) Set(fd protoreflect.FieldDescriptor, value proto
 		x.PublicIp = value.Interface().(string)
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		x.IpfsId = value.Interface().(string)
+	case "janction.audioStem.v1.Worker.signer":
+		x.Signer = value.Interface().(string)
+	case "janction.audioStem.v1.Worker.pending_signer":
+		x.PendingSigner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1633,6 +2051,10 @@ func (x *fastReflection_Worker) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field public_ip of message janction.audioStem.v1.Worker is not mutable"))
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		panic(fmt.Errorf("field ipfs_id of message janction.audioStem.v1.Worker is not mutable"))
+	case "janction.audioStem.v1.Worker.signer":
+		panic(fmt.Errorf("field signer of message janction.audioStem.v1.Worker is not mutable"))
+	case "janction.audioStem.v1.Worker.pending_signer":
+		panic(fmt.Errorf("field pending_signer of message janction.audioStem.v1.Worker is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1661,6 +2083,10 @@ func (x *fastReflection_Worker) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.Worker.ipfs_id":
 		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.Worker.signer":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.Worker.pending_signer":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.Worker"))
@@ -1756,6 +2182,14 @@ func (x *fastReflection_Worker) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingSigner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1785,6 +2219,20 @@ func (x *fastReflection_Worker) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingSigner) > 0 {
+			i -= len(x.PendingSigner)
+			copy(dAtA[i:], x.PendingSigner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingSigner)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.IpfsId) > 0 {
 			i -= len(x.IpfsId)
 			copy(dAtA[i:], x.IpfsId)
@@ -2094,23 +2542,87 @@ func (x *fastReflection_Worker) ProtoMethods() *protoiface.Methods {
 				}
 				x.IpfsId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSigner", wireType)
 				}
-				iNdEx += skippy
-			}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingSigner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
 
 		if iNdEx > l {
@@ -3019,17 +3531,71 @@ func (x *_AudioStemTask_9_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_AudioStemTask_11_list)(nil)
+
+type _AudioStemTask_11_list struct {
+	list *[]*AudioStemTask_InvalidInputReport
+}
+
+func (x *_AudioStemTask_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AudioStemTask_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AudioStemTask_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AudioStemTask_InvalidInputReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AudioStemTask_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AudioStemTask_InvalidInputReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AudioStemTask_11_list) AppendMutable() protoreflect.Value {
+	v := new(AudioStemTask_InvalidInputReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AudioStemTask_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AudioStemTask_11_list) NewElement() protoreflect.Value {
+	v := new(AudioStemTask_InvalidInputReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AudioStemTask_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_AudioStemTask              protoreflect.MessageDescriptor
-	fd_AudioStemTask_taskId       protoreflect.FieldDescriptor
-	fd_AudioStemTask_requester    protoreflect.FieldDescriptor
-	fd_AudioStemTask_cid          protoreflect.FieldDescriptor
-	fd_AudioStemTask_amount_files protoreflect.FieldDescriptor
-	fd_AudioStemTask_instrument   protoreflect.FieldDescriptor
-	fd_AudioStemTask_mp3          protoreflect.FieldDescriptor
-	fd_AudioStemTask_completed    protoreflect.FieldDescriptor
-	fd_AudioStemTask_reward       protoreflect.FieldDescriptor
-	fd_AudioStemTask_threads      protoreflect.FieldDescriptor
+	md_AudioStemTask                       protoreflect.MessageDescriptor
+	fd_AudioStemTask_taskId                protoreflect.FieldDescriptor
+	fd_AudioStemTask_requester             protoreflect.FieldDescriptor
+	fd_AudioStemTask_cid                   protoreflect.FieldDescriptor
+	fd_AudioStemTask_amount_files          protoreflect.FieldDescriptor
+	fd_AudioStemTask_instrument            protoreflect.FieldDescriptor
+	fd_AudioStemTask_mp3                   protoreflect.FieldDescriptor
+	fd_AudioStemTask_completed             protoreflect.FieldDescriptor
+	fd_AudioStemTask_reward                protoreflect.FieldDescriptor
+	fd_AudioStemTask_threads               protoreflect.FieldDescriptor
+	fd_AudioStemTask_invalid               protoreflect.FieldDescriptor
+	fd_AudioStemTask_invalid_input_reports protoreflect.FieldDescriptor
+	fd_AudioStemTask_failed                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3044,6 +3610,9 @@ func init() {
 	fd_AudioStemTask_completed = md_AudioStemTask.Fields().ByName("completed")
 	fd_AudioStemTask_reward = md_AudioStemTask.Fields().ByName("reward")
 	fd_AudioStemTask_threads = md_AudioStemTask.Fields().ByName("threads")
+	fd_AudioStemTask_invalid = md_AudioStemTask.Fields().ByName("invalid")
+	fd_AudioStemTask_invalid_input_reports = md_AudioStemTask.Fields().ByName("invalid_input_reports")
+	fd_AudioStemTask_failed = md_AudioStemTask.Fields().ByName("failed")
 }
 
 var _ protoreflect.Message = (*fastReflection_AudioStemTask)(nil)
@@ -3165,6 +3734,24 @@ func (x *fastReflection_AudioStemTask) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.Invalid != false {
+		value := protoreflect.ValueOfBool(x.Invalid)
+		if !f(fd_AudioStemTask_invalid, value) {
+			return
+		}
+	}
+	if len(x.InvalidInputReports) != 0 {
+		value := protoreflect.ValueOfList(&_AudioStemTask_11_list{list: &x.InvalidInputReports})
+		if !f(fd_AudioStemTask_invalid_input_reports, value) {
+			return
+		}
+	}
+	if x.Failed != false {
+		value := protoreflect.ValueOfBool(x.Failed)
+		if !f(fd_AudioStemTask_failed, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3198,6 +3785,12 @@ func (x *fastReflection_AudioStemTask) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Reward != nil
 	case "janction.audioStem.v1.AudioStemTask.threads":
 		return len(x.Threads) != 0
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		return x.Invalid != false
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		return len(x.InvalidInputReports) != 0
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		return x.Failed != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3232,6 +3825,12 @@ func (x *fastReflection_AudioStemTask) Clear(fd protoreflect.FieldDescriptor) {
 		x.Reward = nil
 	case "janction.audioStem.v1.AudioStemTask.threads":
 		x.Threads = nil
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		x.Invalid = false
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		x.InvalidInputReports = nil
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		x.Failed = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3278,6 +3877,18 @@ func (x *fastReflection_AudioStemTask) Get(descriptor protoreflect.FieldDescript
 		}
 		listValue := &_AudioStemTask_9_list{list: &x.Threads}
 		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		value := x.Invalid
+		return protoreflect.ValueOfBool(value)
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		if len(x.InvalidInputReports) == 0 {
+			return protoreflect.ValueOfList(&_AudioStemTask_11_list{})
+		}
+		listValue := &_AudioStemTask_11_list{list: &x.InvalidInputReports}
+		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		value := x.Failed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3318,6 +3929,14 @@ func (x *fastReflection_AudioStemTask) Set(fd protoreflect.FieldDescriptor, valu
 		lv := value.List()
 		clv := lv.(*_AudioStemTask_9_list)
 		x.Threads = *clv.list
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		x.Invalid = value.Bool()
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		lv := value.List()
+		clv := lv.(*_AudioStemTask_11_list)
+		x.InvalidInputReports = *clv.list
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		x.Failed = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3349,6 +3968,12 @@ func (x *fastReflection_AudioStemTask) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_AudioStemTask_9_list{list: &x.Threads}
 		return protoreflect.ValueOfList(value)
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		if x.InvalidInputReports == nil {
+			x.InvalidInputReports = []*AudioStemTask_InvalidInputReport{}
+		}
+		value := &_AudioStemTask_11_list{list: &x.InvalidInputReports}
+		return protoreflect.ValueOfList(value)
 	case "janction.audioStem.v1.AudioStemTask.taskId":
 		panic(fmt.Errorf("field taskId of message janction.audioStem.v1.AudioStemTask is not mutable"))
 	case "janction.audioStem.v1.AudioStemTask.requester":
@@ -3363,6 +3988,10 @@ func (x *fastReflection_AudioStemTask) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field mp3 of message janction.audioStem.v1.AudioStemTask is not mutable"))
 	case "janction.audioStem.v1.AudioStemTask.completed":
 		panic(fmt.Errorf("field completed of message janction.audioStem.v1.AudioStemTask is not mutable"))
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		panic(fmt.Errorf("field invalid of message janction.audioStem.v1.AudioStemTask is not mutable"))
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		panic(fmt.Errorf("field failed of message janction.audioStem.v1.AudioStemTask is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3396,6 +4025,13 @@ func (x *fastReflection_AudioStemTask) NewField(fd protoreflect.FieldDescriptor)
 	case "janction.audioStem.v1.AudioStemTask.threads":
 		list := []*AudioStemThread{}
 		return protoreflect.ValueOfList(&_AudioStemTask_9_list{list: &list})
+	case "janction.audioStem.v1.AudioStemTask.invalid":
+		return protoreflect.ValueOfBool(false)
+	case "janction.audioStem.v1.AudioStemTask.invalid_input_reports":
+		list := []*AudioStemTask_InvalidInputReport{}
+		return protoreflect.ValueOfList(&_AudioStemTask_11_list{list: &list})
+	case "janction.audioStem.v1.AudioStemTask.failed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask"))
@@ -3500,6 +4136,18 @@ func (x *fastReflection_AudioStemTask) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Invalid {
+			n += 2
+		}
+		if len(x.InvalidInputReports) > 0 {
+			for _, e := range x.InvalidInputReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Failed {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3529,6 +4177,42 @@ func (x *fastReflection_AudioStemTask) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Failed {
+			i--
+			if x.Failed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.InvalidInputReports) > 0 {
+			for iNdEx := len(x.InvalidInputReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InvalidInputReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.Invalid {
+			i--
+			if x.Invalid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
 		if len(x.Threads) > 0 {
 			for iNdEx := len(x.Threads) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Threads[iNdEx])
@@ -3918,6 +4602,80 @@ func (x *fastReflection_AudioStemTask) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Invalid = bool(v != 0)
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidInputReports", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InvalidInputReports = append(x.InvalidInputReports, &AudioStemTask_InvalidInputReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InvalidInputReports[len(x.InvalidInputReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Failed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3953,56 +4711,604 @@ func (x *fastReflection_AudioStemTask) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AudioStemThread_8_list)(nil)
+var (
+	md_AudioStemTask_InvalidInputReport          protoreflect.MessageDescriptor
+	fd_AudioStemTask_InvalidInputReport_reporter protoreflect.FieldDescriptor
+	fd_AudioStemTask_InvalidInputReport_threadId protoreflect.FieldDescriptor
+	fd_AudioStemTask_InvalidInputReport_reason   protoreflect.FieldDescriptor
+)
 
-type _AudioStemThread_8_list struct {
-	list *[]string
+func init() {
+	file_janction_audioStem_v1_types_proto_init()
+	md_AudioStemTask_InvalidInputReport = File_janction_audioStem_v1_types_proto.Messages().ByName("AudioStemTask").Messages().ByName("InvalidInputReport")
+	fd_AudioStemTask_InvalidInputReport_reporter = md_AudioStemTask_InvalidInputReport.Fields().ByName("reporter")
+	fd_AudioStemTask_InvalidInputReport_threadId = md_AudioStemTask_InvalidInputReport.Fields().ByName("threadId")
+	fd_AudioStemTask_InvalidInputReport_reason = md_AudioStemTask_InvalidInputReport.Fields().ByName("reason")
 }
 
-func (x *_AudioStemThread_8_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_AudioStemTask_InvalidInputReport)(nil)
 
-func (x *_AudioStemThread_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_AudioStemTask_InvalidInputReport AudioStemTask_InvalidInputReport
 
-func (x *_AudioStemThread_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *AudioStemTask_InvalidInputReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AudioStemTask_InvalidInputReport)(x)
 }
 
-func (x *_AudioStemThread_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *AudioStemTask_InvalidInputReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_audioStem_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_AudioStemThread_8_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AudioStemThread at list field Workers as it is not of Message kind"))
-}
+var _fastReflection_AudioStemTask_InvalidInputReport_messageType fastReflection_AudioStemTask_InvalidInputReport_messageType
+var _ protoreflect.MessageType = fastReflection_AudioStemTask_InvalidInputReport_messageType{}
 
-func (x *_AudioStemThread_8_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
+type fastReflection_AudioStemTask_InvalidInputReport_messageType struct{}
+
+func (x fastReflection_AudioStemTask_InvalidInputReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AudioStemTask_InvalidInputReport)(nil)
+}
+func (x fastReflection_AudioStemTask_InvalidInputReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_AudioStemTask_InvalidInputReport)
+}
+func (x fastReflection_AudioStemTask_InvalidInputReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AudioStemTask_InvalidInputReport
 }
 
-func (x *_AudioStemThread_8_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_AudioStemTask_InvalidInputReport
 }
 
-func (x *_AudioStemThread_8_list) IsValid() bool {
-	return x.list != nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Type() protoreflect.MessageType {
+	return _fastReflection_AudioStemTask_InvalidInputReport_messageType
 }
 
-var _ protoreflect.List = (*_AudioStemThread_10_list)(nil)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) New() protoreflect.Message {
+	return new(fastReflection_AudioStemTask_InvalidInputReport)
+}
 
-type _AudioStemThread_10_list struct {
-	list *[]*AudioStemThread_Validation
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Interface() protoreflect.ProtoMessage {
+	return (*AudioStemTask_InvalidInputReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_AudioStemTask_InvalidInputReport_reporter, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_AudioStemTask_InvalidInputReport_threadId, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_AudioStemTask_InvalidInputReport_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		return x.Reporter != ""
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		return x.ThreadId != ""
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		x.Reporter = ""
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		x.ThreadId = ""
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		x.Reporter = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		panic(fmt.Errorf("field reporter of message janction.audioStem.v1.AudioStemTask.InvalidInputReport is not mutable"))
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		panic(fmt.Errorf("field threadId of message janction.audioStem.v1.AudioStemTask.InvalidInputReport is not mutable"))
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		panic(fmt.Errorf("field reason of message janction.audioStem.v1.AudioStemTask.InvalidInputReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reporter":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemTask.InvalidInputReport.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemTask.InvalidInputReport"))
+		}
+		panic(fmt.Errorf("message janction.audioStem.v1.AudioStemTask.InvalidInputReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.audioStem.v1.AudioStemTask.InvalidInputReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AudioStemTask_InvalidInputReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AudioStemTask_InvalidInputReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AudioStemTask_InvalidInputReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AudioStemTask_InvalidInputReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AudioStemTask_InvalidInputReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AudioStemTask_InvalidInputReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AudioStemThread_8_list)(nil)
+
+type _AudioStemThread_8_list struct {
+	list *[]string
+}
+
+func (x *_AudioStemThread_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AudioStemThread_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AudioStemThread_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AudioStemThread_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AudioStemThread_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AudioStemThread at list field Workers as it is not of Message kind"))
+}
+
+func (x *_AudioStemThread_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AudioStemThread_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AudioStemThread_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AudioStemThread_10_list)(nil)
+
+type _AudioStemThread_10_list struct {
+	list *[]*AudioStemThread_Validation
 }
 
 func (x *_AudioStemThread_10_list) Len() int {
@@ -4046,7 +5352,53 @@ func (x *_AudioStemThread_10_list) NewElement() protoreflect.Value {
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_AudioStemThread_10_list) IsValid() bool {
+func (x *_AudioStemThread_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AudioStemThread_14_list)(nil)
+
+type _AudioStemThread_14_list struct {
+	list *[]string
+}
+
+func (x *_AudioStemThread_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AudioStemThread_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AudioStemThread_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AudioStemThread_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AudioStemThread_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AudioStemThread at list field RejectedWorkers as it is not of Message kind"))
+}
+
+func (x *_AudioStemThread_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AudioStemThread_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AudioStemThread_14_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -4063,6 +5415,10 @@ var (
 	fd_AudioStemThread_solution             protoreflect.FieldDescriptor
 	fd_AudioStemThread_validations          protoreflect.FieldDescriptor
 	fd_AudioStemThread_average_stem_seconds protoreflect.FieldDescriptor
+	fd_AudioStemThread_reveal_deadline      protoreflect.FieldDescriptor
+	fd_AudioStemThread_attempts             protoreflect.FieldDescriptor
+	fd_AudioStemThread_rejected_workers     protoreflect.FieldDescriptor
+	fd_AudioStemThread_index                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4079,6 +5435,10 @@ func init() {
 	fd_AudioStemThread_solution = md_AudioStemThread.Fields().ByName("solution")
 	fd_AudioStemThread_validations = md_AudioStemThread.Fields().ByName("validations")
 	fd_AudioStemThread_average_stem_seconds = md_AudioStemThread.Fields().ByName("average_stem_seconds")
+	fd_AudioStemThread_reveal_deadline = md_AudioStemThread.Fields().ByName("reveal_deadline")
+	fd_AudioStemThread_attempts = md_AudioStemThread.Fields().ByName("attempts")
+	fd_AudioStemThread_rejected_workers = md_AudioStemThread.Fields().ByName("rejected_workers")
+	fd_AudioStemThread_index = md_AudioStemThread.Fields().ByName("index")
 }
 
 var _ protoreflect.Message = (*fastReflection_AudioStemThread)(nil)
@@ -4212,6 +5572,30 @@ func (x *fastReflection_AudioStemThread) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.RevealDeadline != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RevealDeadline)
+		if !f(fd_AudioStemThread_reveal_deadline, value) {
+			return
+		}
+	}
+	if x.Attempts != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Attempts)
+		if !f(fd_AudioStemThread_attempts, value) {
+			return
+		}
+	}
+	if len(x.RejectedWorkers) != 0 {
+		value := protoreflect.ValueOfList(&_AudioStemThread_14_list{list: &x.RejectedWorkers})
+		if !f(fd_AudioStemThread_rejected_workers, value) {
+			return
+		}
+	}
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_AudioStemThread_index, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4249,6 +5633,14 @@ func (x *fastReflection_AudioStemThread) Has(fd protoreflect.FieldDescriptor) bo
 		return len(x.Validations) != 0
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		return x.AverageStemSeconds != int64(0)
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		return x.RevealDeadline != int64(0)
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		return x.Attempts != int64(0)
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		return len(x.RejectedWorkers) != 0
+	case "janction.audioStem.v1.AudioStemThread.index":
+		return x.Index != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4287,6 +5679,14 @@ func (x *fastReflection_AudioStemThread) Clear(fd protoreflect.FieldDescriptor)
 		x.Validations = nil
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		x.AverageStemSeconds = int64(0)
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		x.RevealDeadline = int64(0)
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		x.Attempts = int64(0)
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		x.RejectedWorkers = nil
+	case "janction.audioStem.v1.AudioStemThread.index":
+		x.Index = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4342,6 +5742,21 @@ func (x *fastReflection_AudioStemThread) Get(descriptor protoreflect.FieldDescri
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		value := x.AverageStemSeconds
 		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		value := x.RevealDeadline
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		if len(x.RejectedWorkers) == 0 {
+			return protoreflect.ValueOfList(&_AudioStemThread_14_list{})
+		}
+		listValue := &_AudioStemThread_14_list{list: &x.RejectedWorkers}
+		return protoreflect.ValueOfList(listValue)
+	case "janction.audioStem.v1.AudioStemThread.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4388,6 +5803,16 @@ func (x *fastReflection_AudioStemThread) Set(fd protoreflect.FieldDescriptor, va
 		x.Validations = *clv.list
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		x.AverageStemSeconds = value.Int()
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		x.RevealDeadline = value.Int()
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		x.Attempts = value.Int()
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		lv := value.List()
+		clv := lv.(*_AudioStemThread_14_list)
+		x.RejectedWorkers = *clv.list
+	case "janction.audioStem.v1.AudioStemThread.index":
+		x.Index = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4425,6 +5850,12 @@ func (x *fastReflection_AudioStemThread) Mutable(fd protoreflect.FieldDescriptor
 		}
 		value := &_AudioStemThread_10_list{list: &x.Validations}
 		return protoreflect.ValueOfList(value)
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		if x.RejectedWorkers == nil {
+			x.RejectedWorkers = []string{}
+		}
+		value := &_AudioStemThread_14_list{list: &x.RejectedWorkers}
+		return protoreflect.ValueOfList(value)
 	case "janction.audioStem.v1.AudioStemThread.thread_id":
 		panic(fmt.Errorf("field thread_id of message janction.audioStem.v1.AudioStemThread is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.task_id":
@@ -4441,6 +5872,12 @@ func (x *fastReflection_AudioStemThread) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field completed of message janction.audioStem.v1.AudioStemThread is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		panic(fmt.Errorf("field average_stem_seconds of message janction.audioStem.v1.AudioStemThread is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		panic(fmt.Errorf("field reveal_deadline of message janction.audioStem.v1.AudioStemThread is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		panic(fmt.Errorf("field attempts of message janction.audioStem.v1.AudioStemThread is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.index":
+		panic(fmt.Errorf("field index of message janction.audioStem.v1.AudioStemThread is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4479,6 +5916,15 @@ func (x *fastReflection_AudioStemThread) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfList(&_AudioStemThread_10_list{list: &list})
 	case "janction.audioStem.v1.AudioStemThread.average_stem_seconds":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.AudioStemThread.reveal_deadline":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.AudioStemThread.attempts":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.AudioStemThread.rejected_workers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AudioStemThread_14_list{list: &list})
+	case "janction.audioStem.v1.AudioStemThread.index":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread"))
@@ -4593,6 +6039,21 @@ func (x *fastReflection_AudioStemThread) ProtoMethods() *protoiface.Methods {
 		if x.AverageStemSeconds != 0 {
 			n += 1 + runtime.Sov(uint64(x.AverageStemSeconds))
 		}
+		if x.RevealDeadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealDeadline))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		if len(x.RejectedWorkers) > 0 {
+			for _, s := range x.RejectedWorkers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4622,6 +6083,30 @@ func (x *fastReflection_AudioStemThread) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.RejectedWorkers) > 0 {
+			for iNdEx := len(x.RejectedWorkers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RejectedWorkers[iNdEx])
+				copy(dAtA[i:], x.RejectedWorkers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RejectedWorkers[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.RevealDeadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealDeadline))
+			i--
+			dAtA[i] = 0x60
+		}
 		if x.AverageStemSeconds != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageStemSeconds))
 			i--
@@ -5091,6 +6576,95 @@ func (x *fastReflection_AudioStemThread) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealDeadline", wireType)
+				}
+				x.RevealDeadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealDeadline |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedWorkers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RejectedWorkers = append(x.RejectedWorkers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5184,6 +6758,9 @@ var (
 	fd_AudioStemThread_Solution_public_key  protoreflect.FieldDescriptor
 	fd_AudioStemThread_Solution_dir         protoreflect.FieldDescriptor
 	fd_AudioStemThread_Solution_accepted    protoreflect.FieldDescriptor
+	fd_AudioStemThread_Solution_salt        protoreflect.FieldDescriptor
+	fd_AudioStemThread_Solution_commitment  protoreflect.FieldDescriptor
+	fd_AudioStemThread_Solution_signature   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5194,6 +6771,9 @@ func init() {
 	fd_AudioStemThread_Solution_public_key = md_AudioStemThread_Solution.Fields().ByName("public_key")
 	fd_AudioStemThread_Solution_dir = md_AudioStemThread_Solution.Fields().ByName("dir")
 	fd_AudioStemThread_Solution_accepted = md_AudioStemThread_Solution.Fields().ByName("accepted")
+	fd_AudioStemThread_Solution_salt = md_AudioStemThread_Solution.Fields().ByName("salt")
+	fd_AudioStemThread_Solution_commitment = md_AudioStemThread_Solution.Fields().ByName("commitment")
+	fd_AudioStemThread_Solution_signature = md_AudioStemThread_Solution.Fields().ByName("signature")
 }
 
 var _ protoreflect.Message = (*fastReflection_AudioStemThread_Solution)(nil)
@@ -5205,7 +6785,7 @@ func (x *AudioStemThread_Solution) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AudioStemThread_Solution) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_types_proto_msgTypes[9]
+	mi := &file_janction_audioStem_v1_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5291,6 +6871,24 @@ func (x *fastReflection_AudioStemThread_Solution) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_AudioStemThread_Solution_salt, value) {
+			return
+		}
+	}
+	if x.Commitment != "" {
+		value := protoreflect.ValueOfString(x.Commitment)
+		if !f(fd_AudioStemThread_Solution_commitment, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_AudioStemThread_Solution_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5316,6 +6914,12 @@ func (x *fastReflection_AudioStemThread_Solution) Has(fd protoreflect.FieldDescr
 		return x.Dir != ""
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		return x.Accepted != false
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		return x.Salt != ""
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		return x.Commitment != ""
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5342,6 +6946,12 @@ func (x *fastReflection_AudioStemThread_Solution) Clear(fd protoreflect.FieldDes
 		x.Dir = ""
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		x.Accepted = false
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		x.Salt = ""
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		x.Commitment = ""
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		x.Signature = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5376,6 +6986,15 @@ func (x *fastReflection_AudioStemThread_Solution) Get(descriptor protoreflect.Fi
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		value := x.Accepted
 		return protoreflect.ValueOfBool(value)
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		value := x.Salt
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5408,6 +7027,12 @@ func (x *fastReflection_AudioStemThread_Solution) Set(fd protoreflect.FieldDescr
 		x.Dir = value.Interface().(string)
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		x.Accepted = value.Bool()
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		x.Salt = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		x.Commitment = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5442,6 +7067,12 @@ func (x *fastReflection_AudioStemThread_Solution) Mutable(fd protoreflect.FieldD
 		panic(fmt.Errorf("field dir of message janction.audioStem.v1.AudioStemThread.Solution is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		panic(fmt.Errorf("field accepted of message janction.audioStem.v1.AudioStemThread.Solution is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		panic(fmt.Errorf("field salt of message janction.audioStem.v1.AudioStemThread.Solution is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		panic(fmt.Errorf("field commitment of message janction.audioStem.v1.AudioStemThread.Solution is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		panic(fmt.Errorf("field signature of message janction.audioStem.v1.AudioStemThread.Solution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5466,6 +7097,12 @@ func (x *fastReflection_AudioStemThread_Solution) NewField(fd protoreflect.Field
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.AudioStemThread.Solution.accepted":
 		return protoreflect.ValueOfBool(false)
+	case "janction.audioStem.v1.AudioStemThread.Solution.salt":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemThread.Solution.commitment":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemThread.Solution.signature":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Solution"))
@@ -5556,6 +7193,18 @@ func (x *fastReflection_AudioStemThread_Solution) ProtoMethods() *protoiface.Met
 		if x.Accepted {
 			n += 2
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5585,6 +7234,27 @@ func (x *fastReflection_AudioStemThread_Solution) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.Accepted {
 			i--
 			if x.Accepted {
@@ -5831,6 +7501,102 @@ func (x *fastReflection_AudioStemThread_Solution) ProtoMethods() *protoiface.Met
 					}
 				}
 				x.Accepted = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5923,6 +7689,9 @@ var (
 	fd_AudioStemThread_Validation_stems      protoreflect.FieldDescriptor
 	fd_AudioStemThread_Validation_public_key protoreflect.FieldDescriptor
 	fd_AudioStemThread_Validation_is_reverse protoreflect.FieldDescriptor
+	fd_AudioStemThread_Validation_salt       protoreflect.FieldDescriptor
+	fd_AudioStemThread_Validation_commitment protoreflect.FieldDescriptor
+	fd_AudioStemThread_Validation_signature  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5932,6 +7701,9 @@ func init() {
 	fd_AudioStemThread_Validation_stems = md_AudioStemThread_Validation.Fields().ByName("stems")
 	fd_AudioStemThread_Validation_public_key = md_AudioStemThread_Validation.Fields().ByName("public_key")
 	fd_AudioStemThread_Validation_is_reverse = md_AudioStemThread_Validation.Fields().ByName("is_reverse")
+	fd_AudioStemThread_Validation_salt = md_AudioStemThread_Validation.Fields().ByName("salt")
+	fd_AudioStemThread_Validation_commitment = md_AudioStemThread_Validation.Fields().ByName("commitment")
+	fd_AudioStemThread_Validation_signature = md_AudioStemThread_Validation.Fields().ByName("signature")
 }
 
 var _ protoreflect.Message = (*fastReflection_AudioStemThread_Validation)(nil)
@@ -5943,7 +7715,7 @@ func (x *AudioStemThread_Validation) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AudioStemThread_Validation) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_types_proto_msgTypes[10]
+	mi := &file_janction_audioStem_v1_types_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6023,6 +7795,24 @@ func (x *fastReflection_AudioStemThread_Validation) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_AudioStemThread_Validation_salt, value) {
+			return
+		}
+	}
+	if x.Commitment != "" {
+		value := protoreflect.ValueOfString(x.Commitment)
+		if !f(fd_AudioStemThread_Validation_commitment, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_AudioStemThread_Validation_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6046,6 +7836,12 @@ func (x *fastReflection_AudioStemThread_Validation) Has(fd protoreflect.FieldDes
 		return x.PublicKey != ""
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		return x.IsReverse != false
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		return x.Salt != ""
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		return x.Commitment != ""
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		return x.Signature != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6070,6 +7866,12 @@ func (x *fastReflection_AudioStemThread_Validation) Clear(fd protoreflect.FieldD
 		x.PublicKey = ""
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		x.IsReverse = false
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		x.Salt = ""
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		x.Commitment = ""
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		x.Signature = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6101,6 +7903,15 @@ func (x *fastReflection_AudioStemThread_Validation) Get(descriptor protoreflect.
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		value := x.IsReverse
 		return protoreflect.ValueOfBool(value)
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		value := x.Salt
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6131,6 +7942,12 @@ func (x *fastReflection_AudioStemThread_Validation) Set(fd protoreflect.FieldDes
 		x.PublicKey = value.Interface().(string)
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		x.IsReverse = value.Bool()
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		x.Salt = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		x.Commitment = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		x.Signature = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6163,6 +7980,12 @@ func (x *fastReflection_AudioStemThread_Validation) Mutable(fd protoreflect.Fiel
 		panic(fmt.Errorf("field public_key of message janction.audioStem.v1.AudioStemThread.Validation is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		panic(fmt.Errorf("field is_reverse of message janction.audioStem.v1.AudioStemThread.Validation is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		panic(fmt.Errorf("field salt of message janction.audioStem.v1.AudioStemThread.Validation is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		panic(fmt.Errorf("field commitment of message janction.audioStem.v1.AudioStemThread.Validation is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		panic(fmt.Errorf("field signature of message janction.audioStem.v1.AudioStemThread.Validation is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6185,6 +8008,12 @@ func (x *fastReflection_AudioStemThread_Validation) NewField(fd protoreflect.Fie
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.AudioStemThread.Validation.is_reverse":
 		return protoreflect.ValueOfBool(false)
+	case "janction.audioStem.v1.AudioStemThread.Validation.salt":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemThread.Validation.commitment":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemThread.Validation.signature":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Validation"))
@@ -6271,6 +8100,18 @@ func (x *fastReflection_AudioStemThread_Validation) ProtoMethods() *protoiface.M
 		if x.IsReverse {
 			n += 2
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6300,6 +8141,27 @@ func (x *fastReflection_AudioStemThread_Validation) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.IsReverse {
 			i--
 			if x.IsReverse {
@@ -6507,6 +8369,102 @@ func (x *fastReflection_AudioStemThread_Validation) ProtoMethods() *protoiface.M
 					}
 				}
 				x.IsReverse = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6545,22 +8503,24 @@ func (x *fastReflection_AudioStemThread_Validation) ProtoMethods() *protoiface.M
 var (
 	md_AudioStemThread_Stem              protoreflect.MessageDescriptor
 	fd_AudioStemThread_Stem_filename     protoreflect.FieldDescriptor
-	fd_AudioStemThread_Stem_signature    protoreflect.FieldDescriptor
 	fd_AudioStemThread_Stem_cid          protoreflect.FieldDescriptor
 	fd_AudioStemThread_Stem_hash         protoreflect.FieldDescriptor
 	fd_AudioStemThread_Stem_validCount   protoreflect.FieldDescriptor
 	fd_AudioStemThread_Stem_invalidCount protoreflect.FieldDescriptor
+	fd_AudioStemThread_Stem_fingerprint  protoreflect.FieldDescriptor
+	fd_AudioStemThread_Stem_proof        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_audioStem_v1_types_proto_init()
 	md_AudioStemThread_Stem = File_janction_audioStem_v1_types_proto.Messages().ByName("AudioStemThread").Messages().ByName("Stem")
 	fd_AudioStemThread_Stem_filename = md_AudioStemThread_Stem.Fields().ByName("filename")
-	fd_AudioStemThread_Stem_signature = md_AudioStemThread_Stem.Fields().ByName("signature")
 	fd_AudioStemThread_Stem_cid = md_AudioStemThread_Stem.Fields().ByName("cid")
 	fd_AudioStemThread_Stem_hash = md_AudioStemThread_Stem.Fields().ByName("hash")
 	fd_AudioStemThread_Stem_validCount = md_AudioStemThread_Stem.Fields().ByName("validCount")
 	fd_AudioStemThread_Stem_invalidCount = md_AudioStemThread_Stem.Fields().ByName("invalidCount")
+	fd_AudioStemThread_Stem_fingerprint = md_AudioStemThread_Stem.Fields().ByName("fingerprint")
+	fd_AudioStemThread_Stem_proof = md_AudioStemThread_Stem.Fields().ByName("proof")
 }
 
 var _ protoreflect.Message = (*fastReflection_AudioStemThread_Stem)(nil)
@@ -6572,7 +8532,7 @@ func (x *AudioStemThread_Stem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AudioStemThread_Stem) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_types_proto_msgTypes[11]
+	mi := &file_janction_audioStem_v1_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6634,12 +8594,6 @@ func (x *fastReflection_AudioStemThread_Stem) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
-	if x.Signature != "" {
-		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_AudioStemThread_Stem_signature, value) {
-			return
-		}
-	}
 	if x.Cid != "" {
 		value := protoreflect.ValueOfString(x.Cid)
 		if !f(fd_AudioStemThread_Stem_cid, value) {
@@ -6664,6 +8618,18 @@ func (x *fastReflection_AudioStemThread_Stem) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.Fingerprint != "" {
+		value := protoreflect.ValueOfString(x.Fingerprint)
+		if !f(fd_AudioStemThread_Stem_fingerprint, value) {
+			return
+		}
+	}
+	if x.Proof != "" {
+		value := protoreflect.ValueOfString(x.Proof)
+		if !f(fd_AudioStemThread_Stem_proof, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6681,8 +8647,6 @@ func (x *fastReflection_AudioStemThread_Stem) Has(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		return x.Filename != ""
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		return x.Signature != ""
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		return x.Cid != ""
 	case "janction.audioStem.v1.AudioStemThread.Stem.hash":
@@ -6691,6 +8655,10 @@ func (x *fastReflection_AudioStemThread_Stem) Has(fd protoreflect.FieldDescripto
 		return x.ValidCount != int64(0)
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		return x.InvalidCount != int64(0)
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		return x.Fingerprint != ""
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		return x.Proof != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6709,8 +8677,6 @@ func (x *fastReflection_AudioStemThread_Stem) Clear(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		x.Filename = ""
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		x.Signature = ""
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		x.Cid = ""
 	case "janction.audioStem.v1.AudioStemThread.Stem.hash":
@@ -6719,6 +8685,10 @@ func (x *fastReflection_AudioStemThread_Stem) Clear(fd protoreflect.FieldDescrip
 		x.ValidCount = int64(0)
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		x.InvalidCount = int64(0)
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		x.Fingerprint = ""
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		x.Proof = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6738,9 +8708,6 @@ func (x *fastReflection_AudioStemThread_Stem) Get(descriptor protoreflect.FieldD
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		value := x.Filename
 		return protoreflect.ValueOfString(value)
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		value := x.Signature
-		return protoreflect.ValueOfString(value)
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		value := x.Cid
 		return protoreflect.ValueOfString(value)
@@ -6753,6 +8720,12 @@ func (x *fastReflection_AudioStemThread_Stem) Get(descriptor protoreflect.FieldD
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		value := x.InvalidCount
 		return protoreflect.ValueOfInt64(value)
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		value := x.Fingerprint
+		return protoreflect.ValueOfString(value)
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		value := x.Proof
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6775,8 +8748,6 @@ func (x *fastReflection_AudioStemThread_Stem) Set(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		x.Filename = value.Interface().(string)
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		x.Signature = value.Interface().(string)
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		x.Cid = value.Interface().(string)
 	case "janction.audioStem.v1.AudioStemThread.Stem.hash":
@@ -6785,6 +8756,10 @@ func (x *fastReflection_AudioStemThread_Stem) Set(fd protoreflect.FieldDescripto
 		x.ValidCount = value.Int()
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		x.InvalidCount = value.Int()
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		x.Fingerprint = value.Interface().(string)
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		x.Proof = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6807,8 +8782,6 @@ func (x *fastReflection_AudioStemThread_Stem) Mutable(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		panic(fmt.Errorf("field filename of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		panic(fmt.Errorf("field signature of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		panic(fmt.Errorf("field cid of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.Stem.hash":
@@ -6817,6 +8790,10 @@ func (x *fastReflection_AudioStemThread_Stem) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field validCount of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		panic(fmt.Errorf("field invalidCount of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		panic(fmt.Errorf("field fingerprint of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		panic(fmt.Errorf("field proof of message janction.audioStem.v1.AudioStemThread.Stem is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6832,8 +8809,6 @@ func (x *fastReflection_AudioStemThread_Stem) NewField(fd protoreflect.FieldDesc
 	switch fd.FullName() {
 	case "janction.audioStem.v1.AudioStemThread.Stem.filename":
 		return protoreflect.ValueOfString("")
-	case "janction.audioStem.v1.AudioStemThread.Stem.signature":
-		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.AudioStemThread.Stem.cid":
 		return protoreflect.ValueOfString("")
 	case "janction.audioStem.v1.AudioStemThread.Stem.hash":
@@ -6842,6 +8817,10 @@ func (x *fastReflection_AudioStemThread_Stem) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfInt64(int64(0))
 	case "janction.audioStem.v1.AudioStemThread.Stem.invalidCount":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.audioStem.v1.AudioStemThread.Stem.fingerprint":
+		return protoreflect.ValueOfString("")
+	case "janction.audioStem.v1.AudioStemThread.Stem.proof":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.audioStem.v1.AudioStemThread.Stem"))
@@ -6915,10 +8894,6 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		l = len(x.Cid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -6933,6 +8908,14 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 		if x.InvalidCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.InvalidCount))
 		}
+		l = len(x.Fingerprint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6962,6 +8945,20 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Proof) > 0 {
+			i -= len(x.Proof)
+			copy(dAtA[i:], x.Proof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Fingerprint) > 0 {
+			i -= len(x.Fingerprint)
+			copy(dAtA[i:], x.Fingerprint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fingerprint)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.InvalidCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvalidCount))
 			i--
@@ -6986,13 +8983,6 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.Filename) > 0 {
 			i -= len(x.Filename)
 			copy(dAtA[i:], x.Filename)
@@ -7081,9 +9071,41 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 				}
 				x.Filename = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7111,11 +9133,49 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signature = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
+				}
+				x.ValidCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidCount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+				}
+				x.InvalidCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvalidCount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7143,11 +9203,11 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Cid = string(dAtA[iNdEx:postIndex])
+				x.Fingerprint = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7175,46 +9235,8 @@ func (x *fastReflection_AudioStemThread_Stem) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Proof = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
-				}
-				x.ValidCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ValidCount |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
-				}
-				x.InvalidCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InvalidCount |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8735,7 +10757,7 @@ func (x *AudioStemLogs_AudioStemLog) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AudioStemLogs_AudioStemLog) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_audioStem_v1_types_proto_msgTypes[12]
+	mi := &file_janction_audioStem_v1_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9246,6 +11268,7 @@ const (
 	AudioStemLogs_AudioStemLog_INFO    AudioStemLogs_AudioStemLog_SEVERITY = 0
 	AudioStemLogs_AudioStemLog_SUCCESS AudioStemLogs_AudioStemLog_SEVERITY = 1
 	AudioStemLogs_AudioStemLog_ERROR   AudioStemLogs_AudioStemLog_SEVERITY = 2
+	AudioStemLogs_AudioStemLog_WARNING AudioStemLogs_AudioStemLog_SEVERITY = 3
 )
 
 // Enum value maps for AudioStemLogs_AudioStemLog_SEVERITY.
@@ -9254,11 +11277,13 @@ var (
 		0: "INFO",
 		1: "SUCCESS",
 		2: "ERROR",
+		3: "WARNING",
 	}
 	AudioStemLogs_AudioStemLog_SEVERITY_value = map[string]int32{
 		"INFO":    0,
 		"SUCCESS": 1,
 		"ERROR":   2,
+		"WARNING": 3,
 	}
 )
 
@@ -9297,7 +11322,24 @@ type Params struct {
 
 	MinWorkerStaking    *v1beta1.Coin `protobuf:"bytes,1,opt,name=min_worker_staking,json=minWorkerStaking,proto3" json:"min_worker_staking,omitempty"`
 	MaxWorkersPerThread int64         `protobuf:"varint,2,opt,name=max_workers_per_thread,json=maxWorkersPerThread,proto3" json:"max_workers_per_thread,omitempty"`
-	MinValidators       int64         `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// validations a thread waits for before its commitments are revealed
+	MinValidators int64 `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// amount of workers that must report the input of a task as invalid to reject it
+	MinInvalidInputReports int64 `protobuf:"varint,4,opt,name=min_invalid_input_reports,json=minInvalidInputReports,proto3" json:"min_invalid_input_reports,omitempty"`
+	// percentage of the reward of a rejected task paid to the workers that probed it
+	ProbingFeePercent int64 `protobuf:"varint,5,opt,name=probing_fee_percent,json=probingFeePercent,proto3" json:"probing_fee_percent,omitempty"`
+	// minimum similarity, as a percentage, between the fingerprints of a validated stem and the solution
+	MinStemSimilarityPercent int64 `protobuf:"varint,6,opt,name=min_stem_similarity_percent,json=minStemSimilarityPercent,proto3" json:"min_stem_similarity_percent,omitempty"`
+	// blocks workers have to reveal their commitments once a thread has enough validations
+	RevealPeriodBlocks int64 `protobuf:"varint,7,opt,name=reveal_period_blocks,json=revealPeriodBlocks,proto3" json:"reveal_period_blocks,omitempty"`
+	// solutions a thread can reject before its task is refunded
+	MaxSolutionAttempts int64 `protobuf:"varint,8,opt,name=max_solution_attempts,json=maxSolutionAttempts,proto3" json:"max_solution_attempts,omitempty"`
+	// percentage of the stake taken from a worker whose solution is rejected
+	RejectionSlashPercent int64 `protobuf:"varint,9,opt,name=rejection_slash_percent,json=rejectionSlashPercent,proto3" json:"rejection_slash_percent,omitempty"`
+	// validations that must match a stem of the solution for the stem to be valid
+	MinValidValidations int64 `protobuf:"varint,10,opt,name=min_valid_validations,json=minValidValidations,proto3" json:"min_valid_validations,omitempty"`
+	// minimum percentage of the stems of a solution that must be valid for it to be accepted
+	MinValidStemsPercent int64 `protobuf:"varint,11,opt,name=min_valid_stems_percent,json=minValidStemsPercent,proto3" json:"min_valid_stems_percent,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -9341,6 +11383,62 @@ func (x *Params) GetMinValidators() int64 {
 	return 0
 }
 
+func (x *Params) GetMinInvalidInputReports() int64 {
+	if x != nil {
+		return x.MinInvalidInputReports
+	}
+	return 0
+}
+
+func (x *Params) GetProbingFeePercent() int64 {
+	if x != nil {
+		return x.ProbingFeePercent
+	}
+	return 0
+}
+
+func (x *Params) GetMinStemSimilarityPercent() int64 {
+	if x != nil {
+		return x.MinStemSimilarityPercent
+	}
+	return 0
+}
+
+func (x *Params) GetRevealPeriodBlocks() int64 {
+	if x != nil {
+		return x.RevealPeriodBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSolutionAttempts() int64 {
+	if x != nil {
+		return x.MaxSolutionAttempts
+	}
+	return 0
+}
+
+func (x *Params) GetRejectionSlashPercent() int64 {
+	if x != nil {
+		return x.RejectionSlashPercent
+	}
+	return 0
+}
+
+func (x *Params) GetMinValidValidations() int64 {
+	if x != nil {
+		return x.MinValidValidations
+	}
+	return 0
+}
+
+func (x *Params) GetMinValidStemsPercent() int64 {
+	if x != nil {
+		return x.MinValidStemsPercent
+	}
+	return 0
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	state         protoimpl.MessageState
@@ -9417,6 +11515,10 @@ type Worker struct {
 	CurrentThreadIndex int32              `protobuf:"varint,6,opt,name=current_thread_index,json=currentThreadIndex,proto3" json:"current_thread_index,omitempty"`
 	PublicIp           string             `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	IpfsId             string             `protobuf:"bytes,8,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	// hot key that sends the messages of the worker, which can't move its stake
+	Signer string `protobuf:"bytes,9,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signer the operator set, which sends the messages of the worker once it accepts
+	PendingSigner string `protobuf:"bytes,10,opt,name=pending_signer,json=pendingSigner,proto3" json:"pending_signer,omitempty"`
 }
 
 func (x *Worker) Reset() {
@@ -9488,6 +11590,20 @@ func (x *Worker) GetIpfsId() string {
 	return ""
 }
 
+func (x *Worker) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Worker) GetPendingSigner() string {
+	if x != nil {
+		return x.PendingSigner
+	}
+	return ""
+}
+
 // Audio Stem Task
 // @cid the IPFS CID submitted by a task requester
 type AudioStemTask struct {
@@ -9495,15 +11611,21 @@ type AudioStemTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId      string             `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	Requester   string             `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
-	Cid         string             `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	AmountFiles int32              `protobuf:"varint,4,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
-	Instrument  string             `protobuf:"bytes,5,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Mp3         bool               `protobuf:"varint,6,opt,name=mp3,proto3" json:"mp3,omitempty"`
-	Completed   bool               `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
-	Reward      *v1beta1.Coin      `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
-	Threads     []*AudioStemThread `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	TaskId      string        `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Requester   string        `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Cid         string        `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	AmountFiles int32         `protobuf:"varint,4,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
+	Instrument  string        `protobuf:"bytes,5,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Mp3         bool          `protobuf:"varint,6,opt,name=mp3,proto3" json:"mp3,omitempty"`
+	Completed   bool          `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Reward      *v1beta1.Coin `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
+	// threads are stored in their own collection, only filled in query responses
+	Threads []*AudioStemThread `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	// the input was rejected by the workers as not being valid audio
+	Invalid             bool                                `protobuf:"varint,10,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	InvalidInputReports []*AudioStemTask_InvalidInputReport `protobuf:"bytes,11,rep,name=invalid_input_reports,json=invalidInputReports,proto3" json:"invalid_input_reports,omitempty"`
+	// no solution was accepted within the attempts, so the reward was refunded
+	Failed bool `protobuf:"varint,12,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (x *AudioStemTask) Reset() {
@@ -9589,6 +11711,27 @@ func (x *AudioStemTask) GetThreads() []*AudioStemThread {
 	return nil
 }
 
+func (x *AudioStemTask) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+func (x *AudioStemTask) GetInvalidInputReports() []*AudioStemTask_InvalidInputReport {
+	if x != nil {
+		return x.InvalidInputReports
+	}
+	return nil
+}
+
+func (x *AudioStemTask) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
 // A Video Rendering Thread is the smallest unit of work for a Task.
 // Workers will try to complete a thread as soon as possible to submit first a solution
 type AudioStemThread struct {
@@ -9607,6 +11750,13 @@ type AudioStemThread struct {
 	Solution           *AudioStemThread_Solution     `protobuf:"bytes,9,opt,name=solution,proto3" json:"solution,omitempty"`
 	Validations        []*AudioStemThread_Validation `protobuf:"bytes,10,rep,name=validations,proto3" json:"validations,omitempty"`
 	AverageStemSeconds int64                         `protobuf:"varint,11,opt,name=average_stem_seconds,json=averageStemSeconds,proto3" json:"average_stem_seconds,omitempty"`
+	// block height until which commitments can be revealed, zero while they are still accepted
+	RevealDeadline int64 `protobuf:"varint,12,opt,name=reveal_deadline,json=revealDeadline,proto3" json:"reveal_deadline,omitempty"`
+	// solutions rejected so far, the workers that proposed them can't work on the thread again
+	Attempts        int64    `protobuf:"varint,13,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	RejectedWorkers []string `protobuf:"bytes,14,rep,name=rejected_workers,json=rejectedWorkers,proto3" json:"rejected_workers,omitempty"`
+	// position of the thread in the task, together with the task id it keys the thread
+	Index uint32 `protobuf:"varint,15,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (x *AudioStemThread) Reset() {
@@ -9706,6 +11856,34 @@ func (x *AudioStemThread) GetAverageStemSeconds() int64 {
 	return 0
 }
 
+func (x *AudioStemThread) GetRevealDeadline() int64 {
+	if x != nil {
+		return x.RevealDeadline
+	}
+	return 0
+}
+
+func (x *AudioStemThread) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AudioStemThread) GetRejectedWorkers() []string {
+	if x != nil {
+		return x.RejectedWorkers
+	}
+	return nil
+}
+
+func (x *AudioStemThread) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
 // Stores information about the Audio stem  task
 type AudioStemTaskInfo struct {
 	state         protoimpl.MessageState
@@ -9904,6 +12082,57 @@ func (x *Worker_Reputation) GetRenderDurations() []int64 {
 	return nil
 }
 
+type AudioStemTask_InvalidInputReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ThreadId string `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AudioStemTask_InvalidInputReport) Reset() {
+	*x = AudioStemTask_InvalidInputReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_audioStem_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AudioStemTask_InvalidInputReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioStemTask_InvalidInputReport) ProtoMessage() {}
+
+// Deprecated: Use AudioStemTask_InvalidInputReport.ProtoReflect.Descriptor instead.
+func (*AudioStemTask_InvalidInputReport) Descriptor() ([]byte, []int) {
+	return file_janction_audioStem_v1_types_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AudioStemTask_InvalidInputReport) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *AudioStemTask_InvalidInputReport) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *AudioStemTask_InvalidInputReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type AudioStemThread_Solution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -9914,12 +12143,17 @@ type AudioStemThread_Solution struct {
 	PublicKey  string                  `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	Dir        string                  `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
 	Accepted   bool                    `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Salt       string                  `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the proposer
+	Commitment string `protobuf:"bytes,7,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *AudioStemThread_Solution) Reset() {
 	*x = AudioStemThread_Solution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_types_proto_msgTypes[9]
+		mi := &file_janction_audioStem_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9971,6 +12205,27 @@ func (x *AudioStemThread_Solution) GetAccepted() bool {
 	return false
 }
 
+func (x *AudioStemThread_Solution) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *AudioStemThread_Solution) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *AudioStemThread_Solution) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 type AudioStemThread_Validation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -9980,12 +12235,17 @@ type AudioStemThread_Validation struct {
 	Stems     []*AudioStemThread_Stem `protobuf:"bytes,2,rep,name=stems,proto3" json:"stems,omitempty"`
 	PublicKey string                  `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	IsReverse bool                    `protobuf:"varint,4,opt,name=is_reverse,json=isReverse,proto3" json:"is_reverse,omitempty"`
+	Salt      string                  `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the validator
+	Commitment string `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *AudioStemThread_Validation) Reset() {
 	*x = AudioStemThread_Validation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_types_proto_msgTypes[10]
+		mi := &file_janction_audioStem_v1_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10030,23 +12290,47 @@ func (x *AudioStemThread_Validation) GetIsReverse() bool {
 	return false
 }
 
+func (x *AudioStemThread_Validation) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *AudioStemThread_Validation) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *AudioStemThread_Validation) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 type AudioStemThread_Stem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
 	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
 	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	// energy of each frequency band over time, encoded as base64
+	Fingerprint string `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// proof of inclusion of the stem in the merkle root of the commitment
+	Proof string `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (x *AudioStemThread_Stem) Reset() {
 	*x = AudioStemThread_Stem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_types_proto_msgTypes[11]
+		mi := &file_janction_audioStem_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10070,13 +12354,6 @@ func (x *AudioStemThread_Stem) GetFilename() string {
 	return ""
 }
 
-func (x *AudioStemThread_Stem) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
-}
-
 func (x *AudioStemThread_Stem) GetCid() string {
 	if x != nil {
 		return x.Cid
@@ -10105,6 +12382,20 @@ func (x *AudioStemThread_Stem) GetInvalidCount() int64 {
 	return 0
 }
 
+func (x *AudioStemThread_Stem) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AudioStemThread_Stem) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
 type AudioStemLogs_AudioStemLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -10118,7 +12409,7 @@ type AudioStemLogs_AudioStemLog struct {
 func (x *AudioStemLogs_AudioStemLog) Reset() {
 	*x = AudioStemLogs_AudioStemLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_audioStem_v1_types_proto_msgTypes[12]
+		mi := &file_janction_audioStem_v1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10167,7 +12458,7 @@ var file_janction_audioStem_v1_types_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x06,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x04, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x6f,
 	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
@@ -10178,195 +12469,270 @@ var file_janction_audioStem_v1_types_proto_rawDesc = []byte{
 	0x13, 0x6d, 0x61, 0x78, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x50, 0x65, 0x72, 0x54, 0x68,
 	0x72, 0x65, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69,
-	0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x11, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
-	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
-	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0xb2, 0x04, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x61, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69,
-	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x66, 0x73, 0x49, 0x64, 0x1a, 0xff, 0x01, 0x0a, 0x0a, 0x52,
-	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd9, 0x02, 0x0a,
-	0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x70, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x03, 0x6d, 0x70, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d,
+	0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x62, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x65, 0x6d, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x53, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xc9,
+	0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x11,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
-	0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x80, 0x08, 0x0a, 0x0f, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x70, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6d,
-	0x70, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x73, 0x6f,
-	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6a,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x11, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61,
+	0x73, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a,
 	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14,
-	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x53, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0xd5,
-	0x01, 0x0a, 0x08, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x74,
-	0x65, 0x6d, 0x52, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x1a, 0xc5, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a,
-	0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x1a, 0xaa,
-	0x01, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x14, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x50, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0xa5, 0x05, 0x0a, 0x06, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x66, 0x73, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x3f, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x1a, 0xff, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x77, 0x69,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x77,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xf8, 0x04, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x70, 0x33,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6d, 0x70, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x07,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
 	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
-	0x61, 0x73, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x22, 0xb9, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x1a, 0xc4, 0x01,
-	0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x12, 0x10,
-	0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x56,
-	0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x3a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x6b, 0x0a, 0x15, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x13, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x1a, 0x7e, 0x0a,
+	0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe7, 0x0a,
+	0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x70, 0x33, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x6d, 0x70, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x4b, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0xa7, 0x02, 0x0a, 0x08, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x1a, 0x97, 0x02, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x2e, 0x53, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xe7, 0x01,
+	0x0a, 0x04, 0x53, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65,
+	0x78, 0x74, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x14, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x50, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
+	0x54, 0x61, 0x73, 0x6b, 0x22, 0xc6, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
+	0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x45, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69,
 	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74,
 	0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x4c, 0x6f, 0x67, 0x2e, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x52, 0x08, 0x73, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x08, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49,
-	0x54, 0x59, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x02, 0x42, 0xe2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e,
+	0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x1a, 0xd1, 0x01, 0x0a, 0x0c, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x56, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f,
+	0x67, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x2e,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0x39, 0x0a, 0x08, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x42, 0xe2, 0x01,
+	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x74, 0x65, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x4a, 0x61,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4a, 0x61,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x17, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x76, 0x31, 0x3b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4a, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65,
+	0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x6d, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10382,7 +12748,7 @@ func file_janction_audioStem_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_janction_audioStem_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_janction_audioStem_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_janction_audioStem_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_janction_audioStem_v1_types_proto_goTypes = []interface{}{
 	(AudioStemLogs_AudioStemLog_SEVERITY)(0), // 0: janction.audioStem.v1.AudioStemLogs.AudioStemLog.SEVERITY
 	(*Params)(nil),                           // 1: janction.audioStem.v1.Params
@@ -10394,35 +12760,37 @@ var file_janction_audioStem_v1_types_proto_goTypes = []interface{}{
 	(*IndexedAudioStemTask)(nil),             // 7: janction.audioStem.v1.IndexedAudioStemTask
 	(*AudioStemLogs)(nil),                    // 8: janction.audioStem.v1.AudioStemLogs
 	(*Worker_Reputation)(nil),                // 9: janction.audioStem.v1.Worker.Reputation
-	(*AudioStemThread_Solution)(nil),         // 10: janction.audioStem.v1.AudioStemThread.Solution
-	(*AudioStemThread_Validation)(nil),       // 11: janction.audioStem.v1.AudioStemThread.Validation
-	(*AudioStemThread_Stem)(nil),             // 12: janction.audioStem.v1.AudioStemThread.Stem
-	(*AudioStemLogs_AudioStemLog)(nil),       // 13: janction.audioStem.v1.AudioStemLogs.AudioStemLog
-	(*v1beta1.Coin)(nil),                     // 14: cosmos.base.v1beta1.Coin
+	(*AudioStemTask_InvalidInputReport)(nil), // 10: janction.audioStem.v1.AudioStemTask.InvalidInputReport
+	(*AudioStemThread_Solution)(nil),         // 11: janction.audioStem.v1.AudioStemThread.Solution
+	(*AudioStemThread_Validation)(nil),       // 12: janction.audioStem.v1.AudioStemThread.Validation
+	(*AudioStemThread_Stem)(nil),             // 13: janction.audioStem.v1.AudioStemThread.Stem
+	(*AudioStemLogs_AudioStemLog)(nil),       // 14: janction.audioStem.v1.AudioStemLogs.AudioStemLog
+	(*v1beta1.Coin)(nil),                     // 15: cosmos.base.v1beta1.Coin
 }
 var file_janction_audioStem_v1_types_proto_depIdxs = []int32{
-	14, // 0: janction.audioStem.v1.Params.min_worker_staking:type_name -> cosmos.base.v1beta1.Coin
+	15, // 0: janction.audioStem.v1.Params.min_worker_staking:type_name -> cosmos.base.v1beta1.Coin
 	1,  // 1: janction.audioStem.v1.GenesisState.params:type_name -> janction.audioStem.v1.Params
 	6,  // 2: janction.audioStem.v1.GenesisState.audioStemTaskInfo:type_name -> janction.audioStem.v1.AudioStemTaskInfo
 	7,  // 3: janction.audioStem.v1.GenesisState.audioStemTaskList:type_name -> janction.audioStem.v1.IndexedAudioStemTask
 	3,  // 4: janction.audioStem.v1.GenesisState.workers:type_name -> janction.audioStem.v1.Worker
 	9,  // 5: janction.audioStem.v1.Worker.reputation:type_name -> janction.audioStem.v1.Worker.Reputation
-	14, // 6: janction.audioStem.v1.AudioStemTask.reward:type_name -> cosmos.base.v1beta1.Coin
+	15, // 6: janction.audioStem.v1.AudioStemTask.reward:type_name -> cosmos.base.v1beta1.Coin
 	5,  // 7: janction.audioStem.v1.AudioStemTask.threads:type_name -> janction.audioStem.v1.AudioStemThread
-	10, // 8: janction.audioStem.v1.AudioStemThread.solution:type_name -> janction.audioStem.v1.AudioStemThread.Solution
-	11, // 9: janction.audioStem.v1.AudioStemThread.validations:type_name -> janction.audioStem.v1.AudioStemThread.Validation
-	4,  // 10: janction.audioStem.v1.IndexedAudioStemTask.audioStemTask:type_name -> janction.audioStem.v1.AudioStemTask
-	13, // 11: janction.audioStem.v1.AudioStemLogs.logs:type_name -> janction.audioStem.v1.AudioStemLogs.AudioStemLog
-	14, // 12: janction.audioStem.v1.Worker.Reputation.staked:type_name -> cosmos.base.v1beta1.Coin
-	14, // 13: janction.audioStem.v1.Worker.Reputation.winnings:type_name -> cosmos.base.v1beta1.Coin
-	12, // 14: janction.audioStem.v1.AudioStemThread.Solution.stems:type_name -> janction.audioStem.v1.AudioStemThread.Stem
-	12, // 15: janction.audioStem.v1.AudioStemThread.Validation.stems:type_name -> janction.audioStem.v1.AudioStemThread.Stem
-	0,  // 16: janction.audioStem.v1.AudioStemLogs.AudioStemLog.severity:type_name -> janction.audioStem.v1.AudioStemLogs.AudioStemLog.SEVERITY
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	10, // 8: janction.audioStem.v1.AudioStemTask.invalid_input_reports:type_name -> janction.audioStem.v1.AudioStemTask.InvalidInputReport
+	11, // 9: janction.audioStem.v1.AudioStemThread.solution:type_name -> janction.audioStem.v1.AudioStemThread.Solution
+	12, // 10: janction.audioStem.v1.AudioStemThread.validations:type_name -> janction.audioStem.v1.AudioStemThread.Validation
+	4,  // 11: janction.audioStem.v1.IndexedAudioStemTask.audioStemTask:type_name -> janction.audioStem.v1.AudioStemTask
+	14, // 12: janction.audioStem.v1.AudioStemLogs.logs:type_name -> janction.audioStem.v1.AudioStemLogs.AudioStemLog
+	15, // 13: janction.audioStem.v1.Worker.Reputation.staked:type_name -> cosmos.base.v1beta1.Coin
+	15, // 14: janction.audioStem.v1.Worker.Reputation.winnings:type_name -> cosmos.base.v1beta1.Coin
+	13, // 15: janction.audioStem.v1.AudioStemThread.Solution.stems:type_name -> janction.audioStem.v1.AudioStemThread.Stem
+	13, // 16: janction.audioStem.v1.AudioStemThread.Validation.stems:type_name -> janction.audioStem.v1.AudioStemThread.Stem
+	0,  // 17: janction.audioStem.v1.AudioStemLogs.AudioStemLog.severity:type_name -> janction.audioStem.v1.AudioStemLogs.AudioStemLog.SEVERITY
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_janction_audioStem_v1_types_proto_init() }
@@ -10540,7 +12908,7 @@ func file_janction_audioStem_v1_types_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AudioStemThread_Solution); i {
+			switch v := v.(*AudioStemTask_InvalidInputReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10552,7 +12920,7 @@ func file_janction_audioStem_v1_types_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AudioStemThread_Validation); i {
+			switch v := v.(*AudioStemThread_Solution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10564,7 +12932,7 @@ func file_janction_audioStem_v1_types_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AudioStemThread_Stem); i {
+			switch v := v.(*AudioStemThread_Validation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10576,6 +12944,18 @@ func file_janction_audioStem_v1_types_proto_init() {
 			}
 		}
 		file_janction_audioStem_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudioStemThread_Stem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_audioStem_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioStemLogs_AudioStemLog); i {
 			case 0:
 				return &v.state
@@ -10594,7 +12974,7 @@ func file_janction_audioStem_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_janction_audioStem_v1_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
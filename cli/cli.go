@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Commands returns the audioStem commands that run on this machine rather than through a
+// query or a transaction, to be added to the root command of the node.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "audioStem",
+		Short:                      "Off-chain commands of the audioStem module",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+	}
+	cmd.AddCommand(
+		CollectStorageCmd(),
+		CommitmentCmd(),
+		FetchResultsCmd(),
+		SubmitCmd(),
+		WorkerCmd(),
+	)
+	return cmd
+}
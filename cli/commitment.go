@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+	"github.com/spf13/cobra"
+)
+
+// CommitmentCmd returns the commands that sign commitments with a multisig key, which the
+// worker can't do by itself because no single machine holds the whole key.
+func CommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commitment",
+		Short: "Signs the commitments of a worker whose key is a multisig",
+		Long: `Each member signs the commitment with sign, and one of them joins the signatures
+with combine. The public key and the signature printed by combine are the ones taken
+by propose-solution and submit-validation.`,
+		RunE: func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+	}
+	cmd.AddCommand(
+		SignCommitmentCmd(),
+		CombineSignaturesCmd(),
+	)
+	return cmd
+}
+
+// SignCommitmentCmd returns the command with which a member of a multisig key signs a
+// commitment of the worker.
+func SignCommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [commitment] [workerAddress] --from [member]",
+		Short: "Signs a commitment with the key of a member of the multisig",
+		Long: `Signs the commitment of the worker with the key of a member of its multisig key,
+and prints the public key of the member and the signature as publicKey:signature, the
+form taken by combine.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.FromName == "" {
+				return fmt.Errorf("set the key of the member with --%s", flags.FlagFrom)
+			}
+
+			message, err := audioStemCrypto.GenerateSignableMessage(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.FromName, message, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+			encodedKey, err := audioStemCrypto.EncodePublicKeyForCLI(pubKey)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("%s:%s\n", encodedKey, audioStemCrypto.EncodeSignatureForCLI(signature))
+			return nil
+		},
+	}
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the key of the member")
+	flags.AddKeyringFlags(cmd.Flags())
+	return cmd
+}
+
+// CombineSignaturesCmd returns the command that joins the signatures of the members into a
+// signature of the multisig key.
+func CombineSignaturesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine [multisigKey] [publicKey:signature]...",
+		Short: "Joins the signatures of the members into a signature of the multisig key",
+		Long: `Joins the signatures printed by sign into a signature of the multisig key, which is
+the name of the key in the keyring, as created by keys add --multisig. Prints the public
+key of the multisig and the signature, to be passed to propose-solution or
+submit-validation. The signature is only valid with at least as many members as the
+threshold of the key.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multiKey, ok := pubKey.(multisig.PubKey)
+			if !ok {
+				return fmt.Errorf("key %s is not a multisig key", args[0])
+			}
+
+			signatures, err := memberSignatures(multiKey, args[1:])
+			if err != nil {
+				return err
+			}
+			signature, err := audioStemCrypto.CombineSignatures(multiKey, signatures)
+			if err != nil {
+				return err
+			}
+			encodedKey, err := audioStemCrypto.EncodePublicKeyForCLI(multiKey)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("%s %s\n", encodedKey, audioStemCrypto.EncodeSignatureForCLI(signature))
+			return nil
+		},
+	}
+	flags.AddKeyringFlags(cmd.Flags())
+	return cmd
+}
+
+// memberSignatures indexes the publicKey:signature arguments by the position of the key of
+// the member in the multisig key.
+func memberSignatures(multiKey multisig.PubKey, args []string) (map[int][]byte, error) {
+	members := multiKey.GetPubKeys()
+	signatures := make(map[int][]byte, len(args))
+	for _, arg := range args {
+		encodedKey, encodedSignature, ok := strings.Cut(arg, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid signature %s, expected publicKey:signature", arg)
+		}
+		memberKey, err := audioStemCrypto.DecodePublicKeyFromCLI(encodedKey)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := audioStemCrypto.DecodeSignatureFromCLI(encodedSignature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of %s: %w", encodedKey, err)
+		}
+
+		index := -1
+		for i, member := range members {
+			if member.Equals(memberKey) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("key %s is not a member of the multisig key", encodedKey)
+		}
+		if _, ok := signatures[index]; ok {
+			return nil, fmt.Errorf("key %s signed more than once", encodedKey)
+		}
+		signatures[index] = signature
+	}
+	return signatures, nil
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommitmentCmd(t *testing.T, cmd *cobra.Command, kr keyring.Keyring, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	cmd.SetContext(ctx)
+	require.NoError(t, client.SetCmdClientContext(cmd, client.Context{}.WithKeyring(kr).WithCodec(moduletestutil.MakeTestEncodingConfig().Codec)))
+	err := cmd.Execute()
+	return strings.TrimSpace(out.String()), err
+}
+
+func newMultisigKeyring(t *testing.T) keyring.Keyring {
+	t.Helper()
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig().Codec)
+	var members []types.PubKey
+	for _, name := range []string{"alice", "bob", "carol"} {
+		record, _, err := kr.NewMnemonic(name, keyring.English, "", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		members = append(members, pubKey)
+	}
+	_, err := kr.SaveMultisig("worker", kmultisig.NewLegacyAminoPubKey(2, members))
+	require.NoError(t, err)
+	return kr
+}
+
+func TestCombineSignatures(t *testing.T) {
+	kr := newMultisigKeyring(t)
+	var signatures []string
+	for _, member := range []string{"alice", "carol"} {
+		out, err := runCommitmentCmd(t, SignCommitmentCmd(), kr, "commitment", "worker-address", "--from", member)
+		require.NoError(t, err)
+		signatures = append(signatures, out)
+	}
+
+	out, err := runCommitmentCmd(t, CombineSignaturesCmd(), kr, append([]string{"worker"}, signatures...)...)
+	require.NoError(t, err)
+	encodedKey, encodedSignature, ok := strings.Cut(out, " ")
+	require.True(t, ok, out)
+	pubKey, err := audioStemCrypto.DecodePublicKeyFromCLI(encodedKey)
+	require.NoError(t, err)
+	record, err := kr.Key("worker")
+	require.NoError(t, err)
+	multiKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, multiKey.Equals(pubKey))
+
+	signature, err := audioStemCrypto.DecodeSignatureFromCLI(encodedSignature)
+	require.NoError(t, err)
+	message, err := audioStemCrypto.GenerateSignableMessage("commitment", "worker-address")
+	require.NoError(t, err)
+	require.True(t, audioStemCrypto.VerifyMessage(pubKey, message, signature))
+	other, err := audioStemCrypto.GenerateSignableMessage("other", "worker-address")
+	require.NoError(t, err)
+	require.False(t, audioStemCrypto.VerifyMessage(pubKey, other, signature))
+}
+
+func TestCombineSignatures_Invalid(t *testing.T) {
+	kr := newMultisigKeyring(t)
+	signature, err := runCommitmentCmd(t, SignCommitmentCmd(), kr, "commitment", "worker-address", "--from", "alice")
+	require.NoError(t, err)
+
+	_, err = runCommitmentCmd(t, CombineSignaturesCmd(), kr, "alice", signature)
+	require.ErrorContains(t, err, "not a multisig key")
+
+	_, err = runCommitmentCmd(t, CombineSignaturesCmd(), kr, "worker", signature, signature)
+	require.ErrorContains(t, err, "signed more than once")
+
+	_, err = runCommitmentCmd(t, CombineSignaturesCmd(), kr, "worker", strings.Replace(signature, ":", "", 1))
+	require.Error(t, err)
+
+	_, _, err = kr.NewMnemonic("dave", keyring.English, "", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	outsider, err := runCommitmentCmd(t, SignCommitmentCmd(), kr, "commitment", "worker-address", "--from", "dave")
+	require.NoError(t, err)
+	_, err = runCommitmentCmd(t, CombineSignaturesCmd(), kr, "worker", outsider)
+	require.ErrorContains(t, err, "not a member")
+}
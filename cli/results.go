@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/worker"
+	"github.com/spf13/cobra"
+)
+
+const flagIPFSApi = "ipfs-api"
+
+// ManifestFilename is the name of the manifest written next to the fetched results.
+const ManifestFilename = "manifest.json"
+
+// ResultsManifest describes the results of a task fetched to a local directory.
+type ResultsManifest struct {
+	TaskId  string         `json:"task_id"`
+	Threads []ThreadResult `json:"threads"`
+	// Missing are the threads without a submitted solution
+	Missing []string `json:"missing,omitempty"`
+}
+
+// ThreadResult are the stems of one input of the task.
+type ThreadResult struct {
+	ThreadId string       `json:"thread_id"`
+	Input    string       `json:"input"`
+	Dir      string       `json:"dir"`
+	Stems    []StemResult `json:"stems"`
+}
+
+// StemResult is a stem verified against the solution revealed on chain.
+type StemResult struct {
+	Filename string `json:"filename"`
+	Path     string `json:"path"`
+	Cid      string `json:"cid"`
+	Hash     string `json:"hash"`
+}
+
+// FetchResultsCmd returns the command that downloads the stems of a task.
+func FetchResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch-results [taskId] [outdir]",
+		Short: "Downloads and verifies the stems of every thread of a task",
+		Long: `Reads the task from chain and downloads the solution directory of every thread from IPFS.
+The CID and hash of each stem are checked against the solution revealed on chain, and the
+stems are written to outdir/<input>/<stem>, together with a manifest.json describing them.`,
+		Example: "fetch-results 1 ./results --ipfs-api 127.0.0.1:5001",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			api, _ := cmd.Flags().GetString(flagIPFSApi)
+			if api == "" {
+				conf, err := worker.GetAudioStemConfiguration(clientCtx.HomeDir)
+				if err != nil {
+					return err
+				}
+				api = conf.IPFSApi
+			}
+			ipfs.SetClient(ipfs.NewHTTPClient(api))
+
+			queryClient := audioStem.NewQueryClient(clientCtx)
+			res, err := queryClient.GetAudioStemTask(cmd.Context(), &audioStem.QueryGetAudioStemTaskRequest{Index: args[0]})
+			if err != nil {
+				return err
+			}
+			if res.AudioStemTask == nil {
+				return fmt.Errorf("task %s not found", args[0])
+			}
+
+			manifest, err := FetchResults(cmd.Context(), res.AudioStemTask, args[1])
+			if err != nil {
+				return err
+			}
+			for _, thread := range manifest.Threads {
+				cmd.Printf("%s: %d stems\n", thread.Input, len(thread.Stems))
+			}
+			for _, threadId := range manifest.Missing {
+				cmd.Printf("%s: no solution submitted yet\n", threadId)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagIPFSApi, "", "address of the IPFS RPC API, defaults to ipfs_api of audioStem.toml")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FetchResults downloads the stems of every thread of the task with a submitted solution
+// into outDir/<input>/<stem>, verifying each of them against the solution on chain, and
+// writes the manifest of the results.
+func FetchResults(ctx context.Context, task *audioStem.AudioStemTask, outDir string) (*ResultsManifest, error) {
+	manifest := &ResultsManifest{TaskId: task.TaskId, Threads: []ThreadResult{}}
+	for _, thread := range task.Threads {
+		if thread.Solution == nil || thread.Solution.Dir == "" {
+			manifest.Missing = append(manifest.Missing, thread.ThreadId)
+			continue
+		}
+
+		result, err := fetchThread(ctx, thread, outDir)
+		if err != nil {
+			return nil, fmt.Errorf("thread %s: %w", thread.ThreadId, err)
+		}
+		manifest.Threads = append(manifest.Threads, *result)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(outDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(outDir, ManifestFilename), data, 0o644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func fetchThread(ctx context.Context, thread *audioStem.AudioStemThread, outDir string) (*ThreadResult, error) {
+	input := thread.Filename
+	if input == "" {
+		input = thread.ThreadId
+	}
+	input, err := safeName(input)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := ipfs.DefaultClient().List(ctx, thread.Solution.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list %s: %w", thread.Solution.Dir, err)
+	}
+
+	inputDir := filepath.Join(outDir, input)
+	downloads := filepath.Join(outDir, ".downloads")
+	result := &ThreadResult{ThreadId: thread.ThreadId, Input: input, Dir: thread.Solution.Dir}
+	for _, stem := range thread.Solution.Stems {
+		filename, err := safeName(stem.Filename)
+		if err != nil {
+			return nil, err
+		}
+		if listing[stem.Filename] != stem.Cid {
+			return nil, fmt.Errorf("stem %s [%s] doesn't exist in %s", stem.Filename, stem.Cid, thread.Solution.Dir)
+		}
+
+		if err := ipfs.IPFSGet(ctx, stem.Cid, downloads, ipfs.DownloadOptions{}); err != nil {
+			return nil, err
+		}
+		downloaded := filepath.Join(downloads, stem.Cid)
+		if err := verifyStem(downloaded, stem); err != nil {
+			os.Remove(downloaded)
+			return nil, err
+		}
+
+		if err := os.MkdirAll(inputDir, os.ModePerm); err != nil {
+			return nil, err
+		}
+		target := filepath.Join(inputDir, filename)
+		if err := os.Rename(downloaded, target); err != nil {
+			return nil, err
+		}
+		result.Stems = append(result.Stems, StemResult{Filename: filename, Path: filepath.Join(input, filename), Cid: stem.Cid, Hash: stem.Hash})
+	}
+
+	os.Remove(downloads)
+	return result, nil
+}
+
+// verifyStem checks that the downloaded file is the stem revealed on chain.
+func verifyStem(path string, stem *audioStem.AudioStemThread_Stem) error {
+	cid, err := ipfs.FileCID(path)
+	if err != nil {
+		return err
+	}
+	if cid != stem.Cid {
+		return fmt.Errorf("stem %s has CID %s, expected %s", stem.Filename, cid, stem.Cid)
+	}
+
+	hash, err := audioStem.CalculateFileHash(path)
+	if err != nil {
+		return err
+	}
+	if hash != stem.Hash {
+		return fmt.Errorf("stem %s has hash %s, expected %s", stem.Filename, hash, stem.Hash)
+	}
+	return nil
+}
+
+// safeName makes sure a name from chain can't write outside of its directory.
+func safeName(name string) (string, error) {
+	base := filepath.Base(name)
+	if base != name || base == "." || base == ".." || base == string(filepath.Separator) {
+		return "", fmt.Errorf("invalid file name %q", name)
+	}
+	return base, nil
+}
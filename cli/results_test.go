@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/stretchr/testify/require"
+)
+
+func writeWav(t *testing.T, path string, samples []int) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	encoder := wav.NewEncoder(file, 8000, 16, 1, 1)
+	buf := &audio.IntBuffer{Format: &audio.Format{NumChannels: 1, SampleRate: 8000}, Data: samples, SourceBitDepth: 16}
+	require.NoError(t, encoder.Write(buf))
+	require.NoError(t, encoder.Close())
+}
+
+// newSolvedThread uploads the stems of a thread to the node and returns the thread with
+// its solution.
+func newSolvedThread(t *testing.T, node *ipfs.Fake, threadId string) *audioStem.AudioStemThread {
+	dir := t.TempDir()
+	writeWav(t, filepath.Join(dir, "vocals.wav"), []int{1, 2, 3, 4})
+	writeWav(t, filepath.Join(dir, "no_vocals.wav"), []int{5, 6, 7, 8})
+
+	solution := &audioStem.AudioStemThread_Solution{}
+	for _, name := range []string{"vocals.wav", "no_vocals.wav"} {
+		path := filepath.Join(dir, name)
+		cid, err := ipfs.FileCID(path)
+		require.NoError(t, err)
+		hash, err := audioStem.CalculateFileHash(path)
+		require.NoError(t, err)
+		solution.Stems = append(solution.Stems, &audioStem.AudioStemThread_Stem{Filename: name, Cid: cid, Hash: hash})
+	}
+	var err error
+	solution.Dir, err = node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+
+	return &audioStem.AudioStemThread{ThreadId: threadId, Solution: solution}
+}
+
+func useFakeNode(t *testing.T) *ipfs.Fake {
+	node := ipfs.NewFake()
+	previous := ipfs.DefaultClient()
+	ipfs.SetClient(node)
+	t.Cleanup(func() { ipfs.SetClient(previous) })
+	return node
+}
+
+func TestFetchResults(t *testing.T) {
+	node := useFakeNode(t)
+	solved := newSolvedThread(t, node, "1-0")
+	solved.Filename = "song.mp3"
+	task := &audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		solved,
+		newSolvedThread(t, node, "1-1"),
+		{ThreadId: "1-2"},
+	}}
+
+	outDir := t.TempDir()
+	manifest, err := FetchResults(context.Background(), task, outDir)
+	require.NoError(t, err)
+	require.Len(t, manifest.Threads, 2)
+	require.Equal(t, []string{"1-2"}, manifest.Missing)
+
+	require.FileExists(t, filepath.Join(outDir, "song.mp3", "vocals.wav"))
+	require.FileExists(t, filepath.Join(outDir, "song.mp3", "no_vocals.wav"))
+	require.FileExists(t, filepath.Join(outDir, "1-1", "vocals.wav"))
+	require.NoDirExists(t, filepath.Join(outDir, ".downloads"))
+
+	data, err := os.ReadFile(filepath.Join(outDir, ManifestFilename))
+	require.NoError(t, err)
+	var written ResultsManifest
+	require.NoError(t, json.Unmarshal(data, &written))
+	require.Equal(t, *manifest, written)
+	require.Equal(t, "song.mp3/vocals.wav", written.Threads[0].Stems[0].Path)
+	require.Equal(t, solved.Solution.Stems[0].Hash, written.Threads[0].Stems[0].Hash)
+}
+
+func TestFetchResults_WrongHash(t *testing.T) {
+	node := useFakeNode(t)
+	thread := newSolvedThread(t, node, "1-0")
+	thread.Solution.Stems[0].Hash = "tampered"
+	task := &audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{thread}}
+
+	outDir := t.TempDir()
+	_, err := FetchResults(context.Background(), task, outDir)
+	require.ErrorContains(t, err, "expected tampered")
+	require.NoFileExists(t, filepath.Join(outDir, "1-0", "vocals.wav"))
+}
+
+func TestFetchResults_StemNotInDir(t *testing.T) {
+	node := useFakeNode(t)
+	thread := newSolvedThread(t, node, "1-0")
+	thread.Solution.Stems[0].Cid = node.AddFile([]byte("other"))
+	task := &audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{thread}}
+
+	_, err := FetchResults(context.Background(), task, t.TempDir())
+	require.ErrorContains(t, err, "doesn't exist")
+}
+
+func TestFetchResults_UnsafeName(t *testing.T) {
+	node := useFakeNode(t)
+	thread := newSolvedThread(t, node, "1-0")
+	thread.Filename = "../escape"
+	task := &audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{thread}}
+
+	_, err := FetchResults(context.Background(), task, t.TempDir())
+	require.ErrorContains(t, err, "invalid file name")
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/retention"
+	"github.com/janction/audioStem/worker"
+	"github.com/spf13/cobra"
+)
+
+// CollectStorageCmd returns the command that collects the storage of completed threads
+// right away, instead of waiting for the worker to do it.
+func CollectStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-storage",
+		Short: "Unpins the solutions and removes the files of completed threads",
+		Long: `Unpins the solutions and removes the files of the threads completed longer than
+retention_grace_hours ago. If disk_quota_mb is set, the oldest completed threads are
+also removed until the storage of the worker is under the quota.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home := client.GetClientContextFromCmd(cmd).HomeDir
+			conf, err := worker.GetAudioStemConfiguration(home)
+			if err != nil {
+				return err
+			}
+			ipfs.SetClient(ipfs.NewHTTPClient(conf.IPFSApi))
+
+			database, err := openWorkerDB(conf)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			report, err := retention.NewManager(database, conf.RetentionConfig()).Collect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			for _, threadId := range report.Collected {
+				cmd.Printf("collected thread %s\n", threadId)
+			}
+			cmd.Printf("%d threads collected, %d CIDs unpinned, %d bytes freed, %d bytes used\n", len(report.Collected), report.Unpinned, report.Freed, report.Used)
+			return nil
+		},
+	}
+	return cmd
+}
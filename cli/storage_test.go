@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/janction/audioStem/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectStorageCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "audioStem.toml"), []byte("retention_grace_hours = 0\n"), 0644))
+	for _, threadId := range []string{"completed", "active"} {
+		dir := filepath.Join(home, "audioStems", threadId)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "input.mp3"), make([]byte, 10), 0644))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "worker"), 0755))
+	database, err := db.Open(filepath.Join(home, "worker"))
+	require.NoError(t, err)
+	_, err = database.ReadThread("active")
+	require.NoError(t, err)
+	_, err = database.ReadThread("completed")
+	require.NoError(t, err)
+	require.NoError(t, database.TransitionThread("completed", db.ThreadIdle, db.ThreadCompleted))
+	require.NoError(t, database.Close())
+
+	cmd := CollectStorageCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{})
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	cmd.SetContext(ctx)
+	require.NoError(t, client.SetCmdClientContext(cmd, client.Context{}.WithHomeDir(home)))
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "collected thread completed")
+	require.NoDirExists(t, filepath.Join(home, "audioStems", "completed"))
+	require.DirExists(t, filepath.Join(home, "audioStems", "active"))
+}
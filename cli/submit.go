@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/worker"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagReward     = "reward"
+	flagInstrument = "instrument"
+	flagMp3        = "mp3"
+)
+
+// SubmitCmd returns the command that creates a task from local audio files.
+func SubmitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [path...] --reward [amount] --from [requester]",
+		Short: "Creates an audio stem task from local WAV or MP3 files",
+		Long: `Checks that every file can be decoded as WAV or MP3, adds them to IPFS as one directory
+and creates a task for them, with one thread per file.`,
+		Example: "submit song1.wav song2.mp3 --reward 100stake --from alice",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardFlag, _ := cmd.Flags().GetString(flagReward)
+			reward, err := sdk.ParseCoinNormalized(rewardFlag)
+			if err != nil {
+				return fmt.Errorf("invalid reward %q: %w", rewardFlag, err)
+			}
+			instrument, _ := cmd.Flags().GetString(flagInstrument)
+			mp3, _ := cmd.Flags().GetBool(flagMp3)
+
+			api, _ := cmd.Flags().GetString(flagIPFSApi)
+			if api == "" {
+				conf, err := worker.GetAudioStemConfiguration(clientCtx.HomeDir)
+				if err != nil {
+					return err
+				}
+				api = conf.IPFSApi
+			}
+			ipfs.SetClient(ipfs.NewHTTPClient(api))
+
+			cid, inputs, err := AddInputs(cmd.Context(), args)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("added %d files to IPFS as %s\n", len(inputs), cid)
+			for i, input := range inputs {
+				cmd.PrintErrf("thread %d: %s\n", i, input)
+			}
+
+			msg := &audioStem.MsgCreateAudioStemTask{
+				Creator:     clientCtx.GetFromAddress().String(),
+				Cid:         cid,
+				AmountFiles: int32(len(inputs)),
+				Instrument:  instrument,
+				Mp3:         mp3,
+				Reward:      &reward,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReward, "", "reward paid for the task, for example 100stake")
+	cmd.Flags().String(flagInstrument, "vocals", "instrument to separate, one of vocals, drums, bass or other")
+	cmd.Flags().Bool(flagMp3, false, "produce the stems as MP3 instead of WAV")
+	cmd.Flags().String(flagIPFSApi, "", "address of the IPFS RPC API, defaults to ipfs_api of audioStem.toml")
+	cmd.MarkFlagRequired(flagReward)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddInputs validates the audio files and adds them to IPFS as one directory, returning
+// its CID and the names of its files. Threads take the files in the order of their names,
+// the one the names are returned in.
+func AddInputs(ctx context.Context, paths []string) (string, []string, error) {
+	staging, err := os.MkdirTemp("", "audioStem-submit")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	names := make([]string, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", nil, err
+		}
+		if !info.Mode().IsRegular() {
+			return "", nil, fmt.Errorf("%s is not a file", path)
+		}
+		if err := audioStem.ValidateAudioFile(path); err != nil {
+			return "", nil, fmt.Errorf("%s is not a valid WAV or MP3 file: %w", path, err)
+		}
+
+		target := filepath.Join(staging, filepath.Base(path))
+		if _, err := os.Stat(target); err == nil {
+			return "", nil, fmt.Errorf("more than one file is named %s", filepath.Base(path))
+		}
+		if err := linkOrCopy(path, target); err != nil {
+			return "", nil, err
+		}
+		names = append(names, filepath.Base(path))
+	}
+	sort.Strings(names)
+
+	cid, err := ipfs.DefaultClient().AddDir(ctx, staging)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to add the files to IPFS: %w", err)
+	}
+	return cid, names, nil
+}
+
+// linkOrCopy places the file at target without copying it, unless it is on another device.
+func linkOrCopy(source, target string) error {
+	if err := os.Link(source, target); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddInputs(t *testing.T) {
+	node := useFakeNode(t)
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.wav")
+	second := filepath.Join(dir, "second.wav")
+	writeWav(t, first, []int{1, 2, 3, 4})
+	writeWav(t, second, []int{5, 6, 7, 8})
+
+	cid, inputs, err := AddInputs(context.Background(), []string{second, first})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first.wav", "second.wav"}, inputs)
+
+	listing, err := node.List(context.Background(), cid)
+	require.NoError(t, err)
+	require.Len(t, listing, 2)
+	require.True(t, node.Pinned(cid))
+}
+
+func TestAddInputs_Download(t *testing.T) {
+	useFakeNode(t)
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.wav")
+	second := filepath.Join(dir, "second.wav")
+	writeWav(t, first, []int{1, 2, 3, 4})
+	writeWav(t, second, []int{5, 6, 7, 8})
+
+	cid, inputs, err := AddInputs(context.Background(), []string{second, first})
+	require.NoError(t, err)
+	task := &audioStem.AudioStemTask{TaskId: "1", Cid: cid, AmountFiles: int32(len(inputs))}
+
+	// every thread downloads the file of its index into its own work directory
+	for _, thread := range task.GenerateThreads(task.TaskId, task.Cid) {
+		path := filepath.Join(t.TempDir(), thread.ThreadId)
+		require.NoError(t, ipfs.GetInput(context.Background(), thread.Cid, thread.Index, path, ipfs.DownloadOptions{}))
+
+		downloaded, err := os.ReadFile(filepath.Join(path, thread.Cid))
+		require.NoError(t, err)
+		input, err := os.ReadFile(filepath.Join(dir, inputs[thread.Index]))
+		require.NoError(t, err)
+		require.Equal(t, input, downloaded)
+	}
+}
+
+func TestAddInputs_Invalid(t *testing.T) {
+	useFakeNode(t)
+	dir := t.TempDir()
+	song := filepath.Join(dir, "song.wav")
+	writeWav(t, song, []int{1, 2, 3, 4})
+
+	notAudio := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(notAudio, []byte("not audio"), 0644))
+	_, _, err := AddInputs(context.Background(), []string{song, notAudio})
+	require.ErrorContains(t, err, "not a valid WAV or MP3 file")
+
+	_, _, err = AddInputs(context.Background(), []string{dir})
+	require.ErrorContains(t, err, "is not a file")
+
+	other := filepath.Join(dir, "other")
+	require.NoError(t, os.Mkdir(other, 0755))
+	writeWav(t, filepath.Join(other, "song.wav"), []int{5, 6, 7, 8})
+	_, _, err = AddInputs(context.Background(), []string{song, filepath.Join(other, "song.wav")})
+	require.ErrorContains(t, err, "more than one file is named song.wav")
+}
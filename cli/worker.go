@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/worker"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSince       = "since"
+	flagMinSeverity = "min-severity"
+)
+
+// WorkerCmd returns the commands of the worker daemon.
+func WorkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "worker",
+		Short:                      "Off-chain worker that performs audio stem tasks",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+	}
+	cmd.AddCommand(
+		StartWorkerCmd(),
+		WorkerLogsCmd(),
+	)
+	return cmd
+}
+
+// StartWorkerCmd returns the command that runs the worker configured in audioStem.toml.
+func StartWorkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Runs the worker configured in audioStem.toml until it is stopped",
+		Long: `Follows the chain through the node, registers the worker and subscribes it to tasks,
+and runs the stemming, proposals, validations and reveals they require, submitting their
+results as transactions. The progress of the worker is kept in its database, under the
+worker directory of the home unless database_dir is set, so the worker picks up where it
+was when restarted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Codec == nil {
+				return errors.New("the client has no codec")
+			}
+
+			conf, err := worker.GetAudioStemConfiguration(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+			if !conf.Enabled || conf.WorkerAddress == "" {
+				return fmt.Errorf("the worker is not enabled, set enabled and worker_address in %s", conf.ConfigPath)
+			}
+			ipfs.SetClient(ipfs.NewHTTPClient(conf.IPFSApi))
+
+			database, err := openWorkerDB(conf)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			daemon := worker.NewDaemon(clientCtx.Codec, *conf, database, audioStem.NewQueryClient(clientCtx), latestHeight(clientCtx))
+			return daemon.Run(ctx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WorkerLogsCmd returns the command that prints the logs the worker keeps for a thread.
+func WorkerLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [threadId]",
+		Short: "Prints the logs the worker keeps for a thread",
+		Long:  "Prints the logs the worker keeps for a thread in its database. Use --since and --min-severity to filter them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			conf, err := worker.GetAudioStemConfiguration(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			since, _ := cmd.Flags().GetInt64(flagSince)
+			severityName, _ := cmd.Flags().GetString(flagMinSeverity)
+			severity, ok := audioStem.AudioStemLogs_AudioStemLog_SEVERITY_value[strings.ToUpper(severityName)]
+			if !ok {
+				return fmt.Errorf("unknown severity %s", severityName)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			database, err := openWorkerDB(conf)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			res, err := worker.ReadLogs(database, &audioStem.QueryGetAudioStemLogsRequest{
+				ThreadId:    args[0],
+				Since:       since,
+				MinSeverity: audioStem.AudioStemLogs_AudioStemLog_SEVERITY(severity),
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(flagSince, 0, "skip the logs before this unix timestamp")
+	cmd.Flags().String(flagMinSeverity, "info", "skip the logs less severe than this one, one of info, success, warning or error")
+	flags.AddPaginationFlagsToCmd(cmd, "logs")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+	return cmd
+}
+
+// openWorkerDB opens the database of the worker, moving the one older versions kept in the
+// node home to it.
+func openWorkerDB(conf *worker.VideoConfiguration) (db.Database, error) {
+	path := conf.DatabasePath()
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, err
+	}
+	legacy, current := filepath.Join(conf.RootPath, "audioStem.db"), filepath.Join(path, "audioStem.db")
+	if _, err := os.Stat(current); errors.Is(err, fs.ErrNotExist) {
+		if err := os.Rename(legacy, current); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return db.Open(path)
+}
+
+// latestHeight returns the height of the latest block of the node. A node that is still
+// catching up doesn't have the state the worker acts on yet.
+func latestHeight(clientCtx client.Context) worker.HeightFunc {
+	return func(ctx context.Context) (int64, error) {
+		node, err := clientCtx.GetNode()
+		if err != nil {
+			return 0, err
+		}
+		status, err := node.Status(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if status.SyncInfo.CatchingUp {
+			return 0, errors.New("the node is catching up")
+		}
+		return status.SyncInfo.LatestBlockHeight, nil
+	}
+}
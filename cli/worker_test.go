@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/janction/audioStem/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerLogsCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+
+	// older versions kept the database of the worker in the node home
+	database, err := db.Open(home)
+	require.NoError(t, err)
+	require.NoError(t, database.AddLogEntry("10", "downloading input", 100, db.SeverityInfo))
+	require.NoError(t, database.AddLogEntry("10", "unable to stem", 101, db.SeverityError))
+	require.NoError(t, database.Close())
+
+	cmd := WorkerLogsCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"10", "--min-severity", "warning", "--output", "json"})
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	cmd.SetContext(ctx)
+	require.NoError(t, client.SetCmdClientContext(cmd, client.Context{}.WithHomeDir(home).WithCodec(moduletestutil.MakeTestEncodingConfig().Codec).WithOutput(&out)))
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "unable to stem")
+	require.NotContains(t, out.String(), "downloading input")
+	require.FileExists(t, filepath.Join(home, "worker", "audioStem.db"))
+	require.NoFileExists(t, filepath.Join(home, "audioStem.db"))
+}
@@ -1,6 +1,6 @@
 import { createProtobufRpcClient, QueryClient } from "@cosmjs/stargate"
-import {AudioStemLogs, AudioStemTask, Worker} from '../types/generated/janction/audioStem/v1/types'
-import {QueryClientImpl, QueryGetAudioStemLogsResponse, QueryGetWorkerResponse} from '../types/generated/janction/audioStem/v1/query'
+import {AudioStemTask, Worker} from '../types/generated/janction/audioStem/v1/types'
+import {QueryClientImpl, QueryGetWorkerResponse} from '../types/generated/janction/audioStem/v1/query'
 import {QueryGetAudioStemTaskResponse} from '../../src/types/generated/janction/audioStem/v1/query'
 
 export interface AudioStemExtension {
@@ -9,10 +9,6 @@ export interface AudioStemExtension {
             index: string
         ) => Promise<AudioStemTask | undefined>;
 
-        readonly GetAudioStemLog: (
-            threadId: string
-        ) => Promise<AudioStemLogs | undefined>;
-        
         readonly GetWorker: (
             worker: string
         ) => Promise<Worker | undefined>;
@@ -32,13 +28,6 @@ export function setupAudioStemExtension(base: QueryClient): AudioStemExtension {
                 return response.audioStemTask;
             },
 
-            GetAudioStemLog: async (threadId: string): Promise<AudioStemLogs | undefined> => {
-                const response: QueryGetAudioStemLogsResponse = await queryService.GetAudioStemLogs({
-                    threadId: threadId,
-                });
-                return response.audioStemLogs;
-            },
-
             GetWorker: async (worker: string): Promise<Worker | undefined> => {
                 const response: QueryGetWorkerResponse = await queryService.GetWorker({
                     worker: worker,
@@ -15,7 +15,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgProposeSolution{},
 		&MsgSubmitValidation{},
 		&MsgRevealSolution{},
+		&MsgRevealValidation{},
 		&MsgSubmitSolution{},
+		&MsgReportInvalidInput{},
+		&MsgLeaveThread{},
+		&MsgSetWorkerSigner{},
+		&MsgAcceptWorkerSigner{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,25 +1,68 @@
 package audioStemCrypto
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/janction/audioStem/audioStemLogger"
 )
 
+// KeyLocation is the keyring that holds the key of the worker.
+type KeyLocation struct {
+	Backend string
+	Dir     string
+	// Passphrase unlocks the file backend. The worker runs unattended, so it is never asked
+	// for on the standard input.
+	Passphrase string
+}
+
+// ParseKeyLocation reads a location in the form backend[:dir], like file:/home/worker/.janctiond.
+// An empty location is the test backend, and a location without directory uses defaultDir.
+// Ledger keys are not supported: the Ledger app only signs transactions, not the hash of a
+// commitment, so a worker with a Ledger key sets a local signer with set-worker-signer.
+func ParseKeyLocation(location, defaultDir string) (KeyLocation, error) {
+	backend, dir, _ := strings.Cut(location, ":")
+	if backend == "" {
+		backend = keyring.BackendTest
+	}
+	if dir == "" {
+		dir = defaultDir
+	}
+
+	switch backend {
+	case keyring.BackendFile, keyring.BackendOS, keyring.BackendKWallet, keyring.BackendPass, keyring.BackendTest, keyring.BackendMemory:
+		return KeyLocation{Backend: backend, Dir: dir}, nil
+	case "ledger":
+		// a Ledger only signs transactions, while the worker signs the hash of its commitments
+		return KeyLocation{}, errors.New("ledger keys can't sign the commitments of a worker, set a signer with a local key instead")
+	}
+	return KeyLocation{}, fmt.Errorf("unknown keyring backend %s", backend)
+}
+
 // Loads the janctiond Keyring
-func getKeyRing(rootDir string, codec codec.Codec) (keyring.Keyring, error) {
-	// Use BackendFile to access persistent keys stored in ~/.janctiond/keyring-file
-	kr, err := keyring.New("janction", keyring.BackendTest, rootDir, nil, codec)
+func getKeyRing(location KeyLocation, codec codec.Codec) (keyring.Keyring, error) {
+	// the file backend reads its passphrase, twice when the keyring is new, from the input
+	input := strings.NewReader(strings.Repeat(location.Passphrase+"\n", 2))
+	if location.Passphrase == "" {
+		input = strings.NewReader("")
+	}
+	kr, err := keyring.New("janction", location.Backend, location.Dir, input, codec)
 	if err != nil {
-		audioStemLogger.Logger.Error("Unable to load keyring at %s: %s", rootDir, err.Error())
+		audioStemLogger.Logger.Error("Unable to load %s keyring at %s: %s", location.Backend, location.Dir, err.Error())
 		return nil, err
 	}
 
@@ -31,7 +74,7 @@ func getKeyRing(rootDir string, codec codec.Codec) (keyring.Keyring, error) {
 	}
 
 	if len(keys) == 0 {
-		audioStemLogger.Logger.Info("No keys found in keyring at dir %s", rootDir)
+		audioStemLogger.Logger.Info("No keys found in keyring at dir %s", location.Dir)
 	} else {
 		audioStemLogger.Logger.Info("Loaded %v keys succesfully", len(keys))
 	}
@@ -39,10 +82,10 @@ func getKeyRing(rootDir string, codec codec.Codec) (keyring.Keyring, error) {
 	return kr, nil
 }
 
-func GetPublicKey(rootDir, alias string, codec codec.Codec) (types.PubKey, error) {
-	keyRing, err := getKeyRing(rootDir, codec)
+func GetPublicKey(location KeyLocation, alias string, codec codec.Codec) (types.PubKey, error) {
+	keyRing, err := getKeyRing(location, codec)
 	if err != nil {
-		audioStemLogger.Logger.Error("Unable to load key ring at %s: %s", rootDir, err.Error())
+		audioStemLogger.Logger.Error("Unable to load key ring at %s: %s", location.Dir, err.Error())
 		return nil, err
 	}
 
@@ -55,10 +98,10 @@ func GetPublicKey(rootDir, alias string, codec codec.Codec) (types.PubKey, error
 	return pk, nil
 }
 
-func SignMessage(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, types.PubKey, error) {
-	keyRing, err := getKeyRing(rootDir, codec)
+func SignMessage(location KeyLocation, alias string, message []byte, codec codec.Codec) ([]byte, types.PubKey, error) {
+	keyRing, err := getKeyRing(location, codec)
 	if err != nil {
-		audioStemLogger.Logger.Error("Unable to load key ring at %s: %s", rootDir, err.Error())
+		audioStemLogger.Logger.Error("Unable to load key ring at %s: %s", location.Dir, err.Error())
 		return nil, nil, err
 	}
 
@@ -78,16 +121,46 @@ func SignMessage(rootDir, alias string, message []byte, codec codec.Codec) ([]by
 
 }
 
-// checks if the signed message, correspond to the publick key
+// checks if the signed message, correspond to the publick key. Signatures of multisig keys
+// are the ones returned by CombineSignatures.
 func VerifyMessage(pubKey types.PubKey, message []byte, signature []byte) bool {
-	return pubKey.VerifySignature(message, signature)
+	multiKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return pubKey.VerifySignature(message, signature)
+	}
+
+	var data signing.SignatureDescriptor_Data
+	if err := data.Unmarshal(signature); err != nil {
+		return false
+	}
+	multiSig, ok := signing.SignatureDataFromProto(&data).(*signing.MultiSignatureData)
+	if !ok {
+		return false
+	}
+	getSignBytes := func(signing.SignMode) ([]byte, error) { return message, nil }
+	return multiKey.VerifyMultisignature(getSignBytes, multiSig) == nil
+}
+
+// CombineSignatures joins the signatures of the members of a multisig key, indexed by the
+// position of their key in it, into a signature of the multisig key. It backs the
+// audioStem commitment combine command.
+func CombineSignatures(pubKey multisig.PubKey, signatures map[int][]byte) ([]byte, error) {
+	keys := pubKey.GetPubKeys()
+	multiSig := multisig.NewMultisig(len(keys))
+	for index, signature := range signatures {
+		if index < 0 || index >= len(keys) {
+			return nil, fmt.Errorf("invalid index %d of multisig key with %d keys", index, len(keys))
+		}
+		multisig.AddSignature(multiSig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: signature}, index)
+	}
+	return signing.SignatureDataToProto(multiSig).Marshal()
 }
 
 // extract public key for the specified alias from the Key ring
-func ExtractPublicKey(rootDir, alias string, codec codec.Codec) (types.PubKey, error) {
-	kr, err := getKeyRing(rootDir, codec)
+func ExtractPublicKey(location KeyLocation, alias string, codec codec.Codec) (types.PubKey, error) {
+	kr, err := getKeyRing(location, codec)
 	if err != nil {
-		audioStemLogger.Logger.Error("ExtractPublicKey rootDir: %s, alias %s", rootDir, alias)
+		audioStemLogger.Logger.Error("ExtractPublicKey keyring: %s, alias %s", location.Dir, alias)
 		return nil, err
 	}
 
@@ -125,6 +198,25 @@ func GenerateSignableMessage(hash, workerAddr string) ([]byte, error) {
 	return hashed[:], nil
 }
 
+// SaltSize is the amount of random bytes of a salt.
+const SaltSize = 32
+
+// GenerateSalt returns a new random salt encoded as hex.
+func GenerateSalt() (string, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt), nil
+}
+
+// GenerateCommitment hides a value until the salt is revealed. Including the address prevents
+// a worker from committing to the value another worker revealed.
+func GenerateCommitment(value, salt, workerAddr string) string {
+	hashed := sha256.Sum256([]byte(value + salt + workerAddr))
+	return hex.EncodeToString(hashed[:])
+}
+
 // Convert signature bytes to a Base64 string for CLI usage
 func EncodeSignatureForCLI(signature []byte) string {
 	return base64.StdEncoding.EncodeToString(signature)
@@ -135,8 +227,20 @@ func DecodeSignatureFromCLI(encodedSig string) ([]byte, error) {
 	return base64.StdEncoding.DecodeString(encodedSig)
 }
 
-func EncodePublicKeyForCLI(publicKey types.PubKey) string {
-	return base64.StdEncoding.EncodeToString(publicKey.Bytes())
+// pubKeyCodec knows every key type of the SDK, so keys can be sent as Any.
+var pubKeyCodec = func() *codec.ProtoCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}()
+
+// EncodePublicKeyForCLI returns the key as a base64 Any, which carries the type of the key.
+func EncodePublicKeyForCLI(publicKey types.PubKey) (string, error) {
+	encoded, err := pubKeyCodec.MarshalInterface(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(encoded), nil
 }
 
 func DecodePublicKeyFromCLI(encodedPubKey string) (types.PubKey, error) {
@@ -149,8 +253,17 @@ func DecodePublicKeyFromCLI(encodedPubKey string) (types.PubKey, error) {
 
 // FromBytes converts a byte slice back to a types.PubKey
 func fromBytes(pubKeyBytes []byte) (types.PubKey, error) {
-	// Create the pubKey from the byte slice (secp256k1 in this case)
-	var _ types.PubKey = (*secp256k1.PubKey)(nil)
-	pubKey := &secp256k1.PubKey{Key: pubKeyBytes}
-	return pubKey, nil
+	var pubKey types.PubKey
+	err := pubKeyCodec.UnmarshalInterface(pubKeyBytes, &pubKey)
+	if err == nil && pubKey == nil {
+		err = errors.New("empty key")
+	}
+	if err == nil {
+		return pubKey, nil
+	}
+	// keys were sent as raw secp256k1 bytes before they were encoded as Any
+	if len(pubKeyBytes) == secp256k1.PubKeySize {
+		return &secp256k1.PubKey{Key: pubKeyBytes}, nil
+	}
+	return nil, fmt.Errorf("invalid public key: %w", err)
 }
@@ -1,21 +1,45 @@
 package audioStemCrypto_test
 
 import (
+	"encoding/base64"
+	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	audioStemCrypto "github.com/janction/audioStem/crypto"
 )
 
+// newAliceKeys returns a test keyring in a temporary directory holding the key of alice.
+func newAliceKeys(t *testing.T) audioStemCrypto.KeyLocation {
+	location := audioStemCrypto.KeyLocation{Backend: keyring.BackendTest, Dir: t.TempDir()}
+	kr, err := keyring.New("janction", location.Backend, location.Dir, nil, moduletestutil.MakeTestEncodingConfig().Codec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1); err != nil {
+		t.Fatal(err)
+	}
+	return location
+}
+
 func TestPublicKeyMatch(t *testing.T) {
+	aliceKeys := newAliceKeys(t)
 	cdc := moduletestutil.MakeTestEncodingConfig().Codec
 	message := []byte("Validate file possession") // The message to sign
-	pk, err := audioStemCrypto.GetPublicKey("/Users/rodrigoacosta/.janctiond", "alice", cdc)
+	pk, err := audioStemCrypto.GetPublicKey(aliceKeys, "alice", cdc)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, publicKey, err := audioStemCrypto.SignMessage("/Users/rodrigoacosta/.janctiond", "alice", message, cdc)
+	_, publicKey, err := audioStemCrypto.SignMessage(aliceKeys, "alice", message, cdc)
 	if err != nil {
 		t.Error(err)
 	}
@@ -23,14 +47,16 @@ func TestPublicKeyMatch(t *testing.T) {
 		t.Errorf("invalid public key %s != %s", pk.String(), publicKey.String())
 	}
 }
+
 func TestWorkerSignAndValidation(t *testing.T) {
+	aliceKeys := newAliceKeys(t)
 	message, err := audioStemCrypto.GenerateSignableMessage("cid", "address")
 	if err != nil {
 		t.Error(err)
 	}
 
 	cdc := moduletestutil.MakeTestEncodingConfig().Codec
-	signature, publicKey, err := audioStemCrypto.SignMessage("/Users/rodrigoacosta/.janctiond", "alice", message, cdc)
+	signature, publicKey, err := audioStemCrypto.SignMessage(aliceKeys, "alice", message, cdc)
 	if err != nil {
 		t.Error(err)
 	}
@@ -44,13 +70,17 @@ func TestWorkerSignAndValidation(t *testing.T) {
 }
 
 func TestSerializationPublicKey(t *testing.T) {
+	aliceKeys := newAliceKeys(t)
 	cdc := moduletestutil.MakeTestEncodingConfig().Codec
-	pk, err := audioStemCrypto.GetPublicKey("/Users/rodrigoacosta/.janctiond", "alice", cdc)
+	pk, err := audioStemCrypto.GetPublicKey(aliceKeys, "alice", cdc)
 	if err != nil {
 		t.Error(err)
 	}
 
-	encodedPk := audioStemCrypto.EncodePublicKeyForCLI(pk)
+	encodedPk, err := audioStemCrypto.EncodePublicKeyForCLI(pk)
+	if err != nil {
+		t.Error(err)
+	}
 	t.Log("base 64 publicKey", encodedPk)
 
 	pubkey, err := audioStemCrypto.DecodePublicKeyFromCLI(encodedPk)
@@ -62,15 +92,16 @@ func TestSerializationPublicKey(t *testing.T) {
 }
 
 func TestSerializationSignature(t *testing.T) {
+	aliceKeys := newAliceKeys(t)
 	message, err := audioStemCrypto.GenerateSignableMessage("QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", "janction1lxwfqmcfcwunzchskvc3vrztthkwkgst6zd9y7")
 	if err != nil {
 		t.Error(err)
 	}
 
 	cdc := moduletestutil.MakeTestEncodingConfig().Codec
-	signature, publicKey, _ := audioStemCrypto.SignMessage("/Users/rodrigoacosta/.janctiond", "alice", message, cdc)
+	signature, publicKey, _ := audioStemCrypto.SignMessage(aliceKeys, "alice", message, cdc)
 	encodedSig := audioStemCrypto.EncodeSignatureForCLI(signature)
-	encodedPubkey := audioStemCrypto.EncodePublicKeyForCLI(publicKey)
+	encodedPubkey, _ := audioStemCrypto.EncodePublicKeyForCLI(publicKey)
 
 	t.Log("Signature:", encodedSig, "pubKey:", encodedPubkey)
 	sig, err := audioStemCrypto.DecodeSignatureFromCLI(encodedSig)
@@ -91,8 +122,11 @@ func TestSerializationSignature(t *testing.T) {
 
 func TestVerifySignature(t *testing.T) {
 	message, _ := audioStemCrypto.GenerateSignableMessage("6d5be21b98ce5e647b5bb031472f4bdc3c70606508dcf0db10f968c699c330a9", "janction1ttu0v9l4mxut8cu97065htdexavn8dswq395uv")
-	signature := "Id5hOW7JqbXV8T0YKWk8vhNzQGRmHWSWGNbdUL3Khq9n5ccDMykgu3a1t5VOmPjWuegOLrl5/Huj7O+UTdLN6w=="
-	publicKey := "AgIg7GgQWR8l4ea7LvUgNuHOWlCIN2fjKUO7ERB/1Sed"
+	// the signature and the key as the worker sends them in its messages
+	key := secp256k1.GenPrivKey()
+	signed, _ := key.Sign(message)
+	signature := audioStemCrypto.EncodeSignatureForCLI(signed)
+	publicKey, _ := audioStemCrypto.EncodePublicKeyForCLI(key.PubKey())
 
 	pk, _ := audioStemCrypto.DecodePublicKeyFromCLI(publicKey)
 
@@ -106,14 +140,15 @@ func TestVerifySignature(t *testing.T) {
 }
 
 func TestPublicKey(t *testing.T) {
+	aliceKeys := newAliceKeys(t)
 	message, err := audioStemCrypto.GenerateSignableMessage("QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", "janction1rkzs8h4w5dj07fhpcc2x607nj5905vd98qyl2u")
 	if err != nil {
 		t.Error(err)
 	}
 
 	cdc := moduletestutil.MakeTestEncodingConfig().Codec
-	sig, publicKey, _ := audioStemCrypto.SignMessage("/Users/rodrigoacosta/.janctiond", "alice", message, cdc)
-	pk, _ := audioStemCrypto.GetPublicKey("/Users/rodrigoacosta/.janctiond", "alice", cdc)
+	sig, publicKey, _ := audioStemCrypto.SignMessage(aliceKeys, "alice", message, cdc)
+	pk, _ := audioStemCrypto.GetPublicKey(aliceKeys, "alice", cdc)
 
 	if pk.Address().String() != publicKey.Address().String() {
 		t.Error("Public keys are not the same")
@@ -121,10 +156,12 @@ func TestPublicKey(t *testing.T) {
 		t.Logf("pk are equal: %s = %s", publicKey.Address().String(), pk.Address().String())
 	}
 
-	if audioStemCrypto.EncodePublicKeyForCLI(publicKey) != audioStemCrypto.EncodePublicKeyForCLI(pk) {
+	encoded, _ := audioStemCrypto.EncodePublicKeyForCLI(publicKey)
+	encodedPk, _ := audioStemCrypto.EncodePublicKeyForCLI(pk)
+	if encoded != encodedPk {
 		t.Error("Not the same")
 	} else {
-		t.Logf("%s = %s", audioStemCrypto.EncodePublicKeyForCLI(publicKey), audioStemCrypto.EncodePublicKeyForCLI(pk))
+		t.Logf("%s = %s", encoded, encodedPk)
 	}
 
 	valid := pk.VerifySignature(message, sig)
@@ -137,3 +174,178 @@ func TestPublicKey(t *testing.T) {
 		t.Error("Not valid")
 	}
 }
+
+func TestGenerateCommitment(t *testing.T) {
+	salt, err := audioStemCrypto.GenerateSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(salt) != 2*audioStemCrypto.SaltSize {
+		t.Errorf("salt %s has unexpected length %d", salt, len(salt))
+	}
+	other, _ := audioStemCrypto.GenerateSalt()
+	if salt == other {
+		t.Errorf("salts are not random")
+	}
+
+	commitment := audioStemCrypto.GenerateCommitment("hash", salt, "alice")
+	if commitment != audioStemCrypto.GenerateCommitment("hash", salt, "alice") {
+		t.Errorf("commitment is not deterministic")
+	}
+	for _, c := range []string{
+		audioStemCrypto.GenerateCommitment("other", salt, "alice"),
+		audioStemCrypto.GenerateCommitment("hash", other, "alice"),
+		audioStemCrypto.GenerateCommitment("hash", salt, "bob"),
+	} {
+		if c == commitment {
+			t.Errorf("commitment doesn't depend on all its inputs")
+		}
+	}
+}
+
+func TestPublicKeyTypes(t *testing.T) {
+	secp := secp256k1.GenPrivKey().PubKey()
+	r1, err := secp256r1.GenPrivKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey := ed25519.GenPrivKey().PubKey()
+	multi := kmultisig.NewLegacyAminoPubKey(2, []types.PubKey{secp, edKey, r1.PubKey()})
+
+	for _, pk := range []types.PubKey{secp, edKey, r1.PubKey(), multi} {
+		encoded, err := audioStemCrypto.EncodePublicKeyForCLI(pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := audioStemCrypto.DecodePublicKeyFromCLI(encoded)
+		if err != nil {
+			t.Fatalf("unable to decode %s: %s", pk.Type(), err)
+		}
+		if !decoded.Equals(pk) {
+			t.Errorf("decoded %s key %s differs from %s", pk.Type(), decoded, pk)
+		}
+	}
+
+	// keys sent as raw secp256k1 bytes are still understood
+	decoded, err := audioStemCrypto.DecodePublicKeyFromCLI(base64.StdEncoding.EncodeToString(secp.Bytes()))
+	if err != nil || !decoded.Equals(secp) {
+		t.Errorf("unable to decode raw secp256k1 key: %v", err)
+	}
+	if _, err := audioStemCrypto.DecodePublicKeyFromCLI(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Error("invalid key was decoded")
+	}
+}
+
+func TestMultisigSignature(t *testing.T) {
+	message, _ := audioStemCrypto.GenerateSignableMessage("hash", "address")
+	keys := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	var pubKeys []types.PubKey
+	for _, key := range keys {
+		pubKeys = append(pubKeys, key.PubKey())
+	}
+	multi := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	signatures := map[int][]byte{}
+	for _, i := range []int{0, 2} {
+		signature, err := keys[i].Sign(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signatures[i] = signature
+	}
+	signature, err := audioStemCrypto.CombineSignatures(multi, signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !audioStemCrypto.VerifyMessage(multi, message, signature) {
+		t.Error("multisig signature is not valid")
+	}
+	other, _ := audioStemCrypto.GenerateSignableMessage("other", "address")
+	if audioStemCrypto.VerifyMessage(multi, other, signature) {
+		t.Error("multisig signature is valid for another message")
+	}
+
+	// below the threshold
+	signature, _ = audioStemCrypto.CombineSignatures(multi, map[int][]byte{0: signatures[0]})
+	if audioStemCrypto.VerifyMessage(multi, message, signature) {
+		t.Error("multisig signature below threshold is valid")
+	}
+	if _, err := audioStemCrypto.CombineSignatures(multi, map[int][]byte{3: signatures[0]}); err == nil {
+		t.Error("signature with invalid index was combined")
+	}
+}
+
+func TestParseKeyLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		want     audioStemCrypto.KeyLocation
+		err      bool
+	}{
+		{"", audioStemCrypto.KeyLocation{Backend: keyring.BackendTest, Dir: "/root"}, false},
+		{"file", audioStemCrypto.KeyLocation{Backend: keyring.BackendFile, Dir: "/root"}, false},
+		{"os:/keys", audioStemCrypto.KeyLocation{Backend: keyring.BackendOS, Dir: "/keys"}, false},
+		{":/keys", audioStemCrypto.KeyLocation{Backend: keyring.BackendTest, Dir: "/keys"}, false},
+		{"ledger:/keys", audioStemCrypto.KeyLocation{}, true},
+	}
+	for _, tt := range tests {
+		got, err := audioStemCrypto.ParseKeyLocation(tt.location, "/root")
+		if (err != nil) != tt.err {
+			t.Errorf("%q: unexpected error %v", tt.location, err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %+v, want %+v", tt.location, got, tt.want)
+		}
+	}
+}
+
+func TestSignWithKeyLocation(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	location := audioStemCrypto.KeyLocation{Backend: keyring.BackendTest, Dir: t.TempDir()}
+	kr, err := keyring.New("janction", location.Backend, location.Dir, nil, cdc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := kr.NewMnemonic("worker", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1); err != nil {
+		t.Fatal(err)
+	}
+
+	message, _ := audioStemCrypto.GenerateSignableMessage("hash", "address")
+	signature, pubKey, err := audioStemCrypto.SignMessage(location, "worker", message, cdc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := audioStemCrypto.ExtractPublicKey(location, "worker", cdc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Equals(pubKey) || !audioStemCrypto.VerifyMessage(pk, message, signature) {
+		t.Error("signature of keyring key is not valid")
+	}
+}
+
+func TestSignWithFileKeyring(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	location := audioStemCrypto.KeyLocation{Backend: keyring.BackendFile, Dir: t.TempDir(), Passphrase: "worker passphrase"}
+	kr, err := keyring.New("janction", location.Backend, location.Dir, strings.NewReader("worker passphrase\nworker passphrase\n"), cdc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := kr.NewMnemonic("worker", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1); err != nil {
+		t.Fatal(err)
+	}
+
+	message, _ := audioStemCrypto.GenerateSignableMessage("hash", "address")
+	signature, pubKey, err := audioStemCrypto.SignMessage(location, "worker", message, cdc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !audioStemCrypto.VerifyMessage(pubKey, message, signature) {
+		t.Error("signature of file keyring key is not valid")
+	}
+
+	// without the passphrase the keyring fails instead of waiting for it on the standard input
+	location.Passphrase = ""
+	if _, _, err := audioStemCrypto.SignMessage(location, "worker", message, cdc); err == nil {
+		t.Error("file keyring was unlocked without passphrase")
+	}
+}
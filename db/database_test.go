@@ -0,0 +1,272 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// databases lists the implementations the tests run against. Implementations that need
+// cgo register themselves from their own test files.
+var databases = map[string]func(t *testing.T) Database{
+	"memory": func(t *testing.T) Database { return NewMemory() },
+}
+
+func forEachDatabase(t *testing.T, test func(t *testing.T, db Database)) {
+	for name, newDB := range databases {
+		t.Run(name, func(t *testing.T) {
+			test(t, newDB(t))
+		})
+	}
+}
+
+func TestTasks(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		require.NoError(t, db.AddTask("task1", "task10"))
+		require.NoError(t, db.AddTask("task1", "task11"))
+		require.Error(t, db.AddTask("task1", "task10"))
+
+		require.NoError(t, db.UpdateTask("task1", "task11", true))
+		task, err := db.ReadTask("task1", "task11")
+		require.NoError(t, err)
+		require.True(t, task.WorkerSubscribed)
+
+		// reading an unknown task creates it
+		task, err = db.ReadTask("task2", "task20")
+		require.NoError(t, err)
+		require.False(t, task.WorkerSubscribed)
+		require.Error(t, db.AddTask("task2", "task20"))
+	})
+}
+
+func TestReadSubscribedTasks(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		for _, threadId := range []string{"task10", "task11", "task12"} {
+			require.NoError(t, db.AddTask("task1", threadId))
+			require.NoError(t, db.UpdateTask("task1", threadId, true))
+		}
+		require.NoError(t, db.AddTask("task2", "task20"))
+
+		// the local progress of completed threads is no longer followed
+		require.NoError(t, db.AddThread("task11"))
+		require.NoError(t, db.TransitionThread("task11", ThreadIdle, ThreadDownloading))
+		require.NoError(t, db.AddThread("task12"))
+		require.NoError(t, db.TransitionThread("task12", ThreadIdle, ThreadCompleted))
+
+		tasks, err := db.ReadSubscribedTasks()
+		require.NoError(t, err)
+		require.Equal(t, []Task{
+			{TaskId: "task1", ThreadId: "task10", WorkerSubscribed: true},
+			{TaskId: "task1", ThreadId: "task11", WorkerSubscribed: true},
+		}, tasks)
+	})
+}
+
+func TestRenameThread(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		require.NoError(t, db.AddTask("1", "110"))
+		require.NoError(t, db.UpdateTask("1", "110", true))
+		require.NoError(t, db.AddThread("110"))
+		require.NoError(t, db.TransitionThread("110", ThreadIdle, ThreadDownloading))
+		require.NoError(t, db.SetSalt("110", "salt"))
+		require.NoError(t, db.AddPin("110", "cid"))
+		require.NoError(t, db.AddLogEntry("110", "downloading", 100, SeverityInfo))
+		_, err := db.EnqueueJob(JobStartWork, "110", nil, 3)
+		require.NoError(t, err)
+
+		require.NoError(t, db.RenameThread("1", "110", "1-10"))
+
+		task, err := db.ReadTask("1", "1-10")
+		require.NoError(t, err)
+		require.True(t, task.WorkerSubscribed)
+		thread, err := db.ReadThread("1-10")
+		require.NoError(t, err)
+		require.Equal(t, ThreadDownloading, thread.State)
+		transitions, err := db.ReadThreadTransitions("1-10")
+		require.NoError(t, err)
+		require.Len(t, transitions, 1)
+		salt, err := db.ReadSalt("1-10")
+		require.NoError(t, err)
+		require.Equal(t, "salt", salt)
+		pins, err := db.ReadPins("1-10")
+		require.NoError(t, err)
+		require.Equal(t, []string{"cid"}, pins)
+		logs, _, err := db.ReadLogs(LogFilter{ThreadId: "1-10"})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+
+		// nothing is left under the old id, and its jobs won't run
+		thread, err = db.ReadThread("110")
+		require.NoError(t, err)
+		require.Equal(t, ThreadIdle, thread.State)
+		salt, err = db.ReadSalt("110")
+		require.NoError(t, err)
+		require.Empty(t, salt)
+		jobs, err := db.ClaimJobs(time.Now().Unix(), 10)
+		require.NoError(t, err)
+		require.Empty(t, jobs)
+	})
+}
+
+func TestWorkersAndIPFSPeers(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		registered, err := db.IsWorkerRegistered("worker1")
+		require.NoError(t, err)
+		require.False(t, registered)
+
+		require.NoError(t, db.Addworker("worker1"))
+		registered, err = db.IsWorkerRegistered("worker1")
+		require.NoError(t, err)
+		require.True(t, registered)
+
+		require.NoError(t, db.DeleteWorker("worker1"))
+		registered, err = db.IsWorkerRegistered("worker1")
+		require.NoError(t, err)
+		require.False(t, registered)
+
+		require.NoError(t, db.AddIPFSWorker("peer1"))
+		added, err := db.IsIPFSWorkerAdded("peer1")
+		require.NoError(t, err)
+		require.True(t, added)
+	})
+}
+
+func TestPins(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		require.NoError(t, db.AddPin("thread1", "QmFirst"))
+		require.NoError(t, db.AddPin("thread1", "QmSecond"))
+		require.NoError(t, db.AddPin("thread1", "QmFirst"))
+		require.NoError(t, db.AddPin("thread2", "QmOther"))
+
+		pins, err := db.ReadPins("thread1")
+		require.NoError(t, err)
+		require.Equal(t, []string{"QmFirst", "QmSecond"}, pins)
+
+		require.NoError(t, db.DeletePin("thread1", "QmFirst"))
+		pins, err = db.ReadPins("thread1")
+		require.NoError(t, err)
+		require.Equal(t, []string{"QmSecond"}, pins)
+
+		pins, err = db.ReadPins("thread3")
+		require.NoError(t, err)
+		require.Empty(t, pins)
+	})
+}
+
+func TestSalts(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		salt, err := db.ReadSalt("thread1")
+		require.NoError(t, err)
+		require.Empty(t, salt)
+
+		require.NoError(t, db.SetSalt("thread1", "first"))
+		require.NoError(t, db.SetSalt("thread1", "second"))
+		require.NoError(t, db.SetSalt("thread2", "other"))
+
+		salt, err = db.ReadSalt("thread1")
+		require.NoError(t, err)
+		require.Equal(t, "first", salt)
+
+		// the salt was revealed with the thread, so a reopened thread gets a new one
+		require.NoError(t, db.AddThread("thread1"))
+		require.NoError(t, db.DeleteThread("thread1"))
+		salt, err = db.ReadSalt("thread1")
+		require.NoError(t, err)
+		require.Empty(t, salt)
+		salt, err = db.ReadSalt("thread2")
+		require.NoError(t, err)
+		require.Equal(t, "other", salt)
+	})
+}
+
+func TestLogsAndRenderTimes(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		require.NoError(t, db.AddLogEntry("thread1", "second", 20, 0))
+		require.NoError(t, db.AddLogEntry("thread1", "first", 10, 1))
+		require.NoError(t, db.AddLogEntry("thread2", "other", 15, 0))
+
+		logs, total, err := db.ReadLogs(LogFilter{ThreadId: "thread1"})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, logs, 2)
+		require.Equal(t, "first", logs[0].Log)
+		require.Equal(t, int64(1), logs[0].Severity)
+		require.Equal(t, "second", logs[1].Log)
+
+		_, err = db.GetAverageRenderTime("thread1")
+		require.Error(t, err)
+
+		require.NoError(t, db.AddRenderDuration("thread1", 1, 10))
+		require.NoError(t, db.AddRenderDuration("thread1", 2, 20))
+		avg, err := db.GetAverageRenderTime("thread1")
+		require.NoError(t, err)
+		require.Equal(t, 15, avg)
+	})
+}
+
+func TestReadLogs_Filters(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		require.NoError(t, db.AddLogEntry("thread1", "info", 10, SeverityInfo))
+		require.NoError(t, db.AddLogEntry("thread1", "success", 20, SeveritySuccess))
+		require.NoError(t, db.AddLogEntry("thread1", "warning", 30, SeverityWarning))
+		require.NoError(t, db.AddLogEntry("thread1", "error", 40, SeverityError))
+
+		logs, total, err := db.ReadLogs(LogFilter{ThreadId: "thread1", MinSeverity: SeverityWarning})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Equal(t, "warning", logs[0].Log)
+		require.Equal(t, "error", logs[1].Log)
+
+		logs, total, err = db.ReadLogs(LogFilter{ThreadId: "thread1", Since: 20})
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.Equal(t, "success", logs[0].Log)
+
+		// pages keep the total of the whole result
+		logs, total, err = db.ReadLogs(LogFilter{ThreadId: "thread1", Offset: 1, Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, 4, total)
+		require.Len(t, logs, 2)
+		require.Equal(t, "success", logs[0].Log)
+		require.Equal(t, "warning", logs[1].Log)
+
+		logs, _, err = db.ReadLogs(LogFilter{ThreadId: "thread1", Limit: 1, Reverse: true})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		require.Equal(t, "error", logs[0].Log)
+
+		logs, total, err = db.ReadLogs(LogFilter{ThreadId: "thread1", Offset: 10})
+		require.NoError(t, err)
+		require.Equal(t, 4, total)
+		require.Empty(t, logs)
+	})
+}
+
+func TestPruneLogs(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		_, err := db.ReadThread("done")
+		require.NoError(t, err)
+		require.NoError(t, db.TransitionThread("done", ThreadIdle, ThreadCompleted))
+		_, err = db.ReadThread("running")
+		require.NoError(t, err)
+
+		require.NoError(t, db.AddLogEntry("done", "old", 10, SeverityInfo))
+		require.NoError(t, db.AddLogEntry("done", "recent", 100, SeverityInfo))
+		require.NoError(t, db.AddLogEntry("running", "old", 10, SeverityInfo))
+
+		pruned, err := db.PruneLogs(50)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), pruned)
+
+		logs, _, err := db.ReadLogs(LogFilter{ThreadId: "done"})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		require.Equal(t, "recent", logs[0].Log)
+
+		// threads still in progress keep all of their logs
+		logs, _, err = db.ReadLogs(LogFilter{ThreadId: "running"})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+	})
+}
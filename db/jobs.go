@@ -0,0 +1,48 @@
+package db
+
+// JobKind identifies the off-chain work a job performs.
+type JobKind string
+
+const (
+	JobRegisterWorker     JobKind = "register_worker"
+	JobSubscribeWorker    JobKind = "subscribe_worker"
+	JobStartWork          JobKind = "start_work"
+	JobProposeSolution    JobKind = "propose_solution"
+	JobSubmitVerification JobKind = "submit_verification"
+	JobRevealSolution     JobKind = "reveal_solution"
+	JobRevealValidation   JobKind = "reveal_validation"
+	JobSubmitSolution     JobKind = "submit_solution"
+	JobConnectIPFS        JobKind = "connect_ipfs"
+	JobPruneLogs          JobKind = "prune_logs"
+	JobCollectStorage     JobKind = "collect_storage"
+)
+
+// JobState is the lifecycle state of a queued job.
+type JobState string
+
+const (
+	JobPending JobState = "pending"
+	JobRunning JobState = "running"
+	JobDone    JobState = "done"
+	JobFailed  JobState = "failed"
+)
+
+// DefaultJobMaxAttempts is used when a job is enqueued without an explicit limit.
+const DefaultJobMaxAttempts = 5
+
+// Job is a unit of off-chain work persisted in the worker database.
+// Key identifies the subject of the job (typically a thread id) and is used,
+// together with Kind, to avoid having the same work queued twice.
+type Job struct {
+	ID          int64
+	Kind        JobKind
+	Key         string
+	Payload     []byte
+	State       JobState
+	Attempts    int
+	MaxAttempts int
+	NextRunAt   int64
+	LastError   string
+	CreatedAt   int64
+	UpdatedAt   int64
+}
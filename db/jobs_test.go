@@ -0,0 +1,122 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnqueueJob_DeduplicatesActiveJobs(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		queued, err := db.EnqueueJob(JobStartWork, "thread1", []byte("payload"), 0)
+		require.NoError(t, err)
+		require.True(t, queued)
+
+		// same kind and key while the first one is pending
+		queued, err = db.EnqueueJob(JobStartWork, "thread1", []byte("payload"), 0)
+		require.NoError(t, err)
+		require.False(t, queued)
+
+		// another kind for the same thread is a different job
+		queued, err = db.EnqueueJob(JobProposeSolution, "thread1", nil, 0)
+		require.NoError(t, err)
+		require.True(t, queued)
+
+		jobs, err := db.ClaimJobs(time.Now().Unix(), 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		require.Equal(t, []byte("payload"), jobs[0].Payload)
+		require.Equal(t, DefaultJobMaxAttempts, jobs[0].MaxAttempts)
+
+		// still running, so it can't be queued again
+		queued, err = db.EnqueueJob(JobStartWork, "thread1", nil, 0)
+		require.NoError(t, err)
+		require.False(t, queued)
+
+		// once done, the work can be queued again
+		require.NoError(t, db.CompleteJob(jobs[0].ID))
+		queued, err = db.EnqueueJob(JobStartWork, "thread1", nil, 0)
+		require.NoError(t, err)
+		require.True(t, queued)
+	})
+}
+
+func TestClaimJobs_OnlyDueJobs(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		now := time.Now().Unix()
+
+		_, err := db.EnqueueJob(JobStartWork, "thread1", nil, 3)
+		require.NoError(t, err)
+
+		jobs, err := db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, JobRunning, jobs[0].State)
+		require.Equal(t, 1, jobs[0].Attempts)
+
+		// a running job is not claimed twice
+		jobs2, err := db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Empty(t, jobs2)
+
+		// failed job is retried only once it is due
+		require.NoError(t, db.FailJob(jobs[0].ID, errors.New("boom"), now+60))
+		jobs2, err = db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Empty(t, jobs2)
+
+		job, err := db.ReadJob(jobs[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, JobPending, job.State)
+		require.Equal(t, "boom", job.LastError)
+
+		jobs2, err = db.ClaimJobs(now+60, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs2, 1)
+		require.Equal(t, 2, jobs2[0].Attempts)
+	})
+}
+
+func TestFailJob_ExhaustsAttempts(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		now := time.Now().Unix()
+
+		_, err := db.EnqueueJob(JobRevealSolution, "thread1", nil, 1)
+		require.NoError(t, err)
+
+		jobs, err := db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+
+		require.NoError(t, db.FailJob(jobs[0].ID, errors.New("boom"), now))
+		job, err := db.ReadJob(jobs[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, JobFailed, job.State)
+
+		jobs, err = db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Empty(t, jobs)
+	})
+}
+
+func TestResetRunningJobs(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		now := time.Now().Unix()
+
+		_, err := db.EnqueueJob(JobSubmitSolution, "thread1", nil, 0)
+		require.NoError(t, err)
+		jobs, err := db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+
+		// the process restarts while the job is running
+		require.NoError(t, db.ResetRunningJobs())
+
+		jobs, err = db.ClaimJobs(now, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, 2, jobs[0].Attempts)
+	})
+}
@@ -0,0 +1,484 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ Database = (*Memory)(nil)
+
+// Memory is a Database kept in memory. Nothing survives a restart, so it is meant for
+// tests and for nodes that don't run a worker.
+type Memory struct {
+	mu          sync.Mutex
+	tasks       map[[2]string]Task
+	threads     map[string]ThreadState
+	transitions map[string][]ThreadTransition
+	workers     map[string]bool
+	logs        []LogEntry
+	ipfs        map[string]bool
+	pins        map[string][]string
+	salts       map[string]string
+	renderTimes map[string][]int
+	jobs        []*Job
+}
+
+// NewMemory creates an empty in-memory database.
+func NewMemory() *Memory {
+	return &Memory{
+		tasks:       make(map[[2]string]Task),
+		threads:     make(map[string]ThreadState),
+		transitions: make(map[string][]ThreadTransition),
+		workers:     make(map[string]bool),
+		ipfs:        make(map[string]bool),
+		pins:        make(map[string][]string),
+		salts:       make(map[string]string),
+		renderTimes: make(map[string][]int),
+	}
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+func (m *Memory) AddTask(taskId, threadId string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{taskId, threadId}
+	if _, ok := m.tasks[key]; ok {
+		return fmt.Errorf("failed to insert task: thread %s of task %s already exists", threadId, taskId)
+	}
+	m.tasks[key] = Task{TaskId: taskId, ThreadId: threadId}
+	return nil
+}
+
+func (m *Memory) ReadTask(taskId, threadId string) (*Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{taskId, threadId}
+	task, ok := m.tasks[key]
+	if !ok {
+		task = Task{TaskId: taskId, ThreadId: threadId}
+		m.tasks[key] = task
+	}
+	return &task, nil
+}
+
+func (m *Memory) UpdateTask(taskId, threadId string, workerSubscribed bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{taskId, threadId}
+	if task, ok := m.tasks[key]; ok {
+		task.WorkerSubscribed = workerSubscribed
+		m.tasks[key] = task
+	}
+	return nil
+}
+
+func (m *Memory) ReadSubscribedTasks() ([]Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var tasks []Task
+	for _, task := range m.tasks {
+		if state, ok := m.threads[task.ThreadId]; task.WorkerSubscribed && (!ok || state != ThreadCompleted) {
+			tasks = append(tasks, task)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].TaskId != tasks[j].TaskId {
+			return tasks[i].TaskId < tasks[j].TaskId
+		}
+		return tasks[i].ThreadId < tasks[j].ThreadId
+	})
+	return tasks, nil
+}
+
+func (m *Memory) AddThread(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.threads[id]; ok {
+		return fmt.Errorf("failed to insert thread: %s already exists", id)
+	}
+	m.threads[id] = ThreadIdle
+	return nil
+}
+
+func (m *Memory) ReadThread(id string) (*Thread, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.threads[id]
+	if !ok {
+		m.threads[id] = ThreadIdle
+	}
+	return &Thread{ID: id, State: state}, nil
+}
+
+func (m *Memory) DeleteThread(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.threads, id)
+	delete(m.salts, id)
+	return nil
+}
+
+func (m *Memory) RenameThread(taskId, from, to string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if task, ok := m.tasks[[2]string{taskId, from}]; ok {
+		delete(m.tasks, [2]string{taskId, from})
+		task.ThreadId = to
+		m.tasks[[2]string{taskId, to}] = task
+	}
+	if state, ok := m.threads[from]; ok {
+		delete(m.threads, from)
+		m.threads[to] = state
+	}
+	if transitions, ok := m.transitions[from]; ok {
+		delete(m.transitions, from)
+		for i := range transitions {
+			transitions[i].ThreadId = to
+		}
+		m.transitions[to] = transitions
+	}
+	if salt, ok := m.salts[from]; ok {
+		delete(m.salts, from)
+		m.salts[to] = salt
+	}
+	if pins, ok := m.pins[from]; ok {
+		delete(m.pins, from)
+		m.pins[to] = pins
+	}
+	if times, ok := m.renderTimes[from]; ok {
+		delete(m.renderTimes, from)
+		m.renderTimes[to] = times
+	}
+	for i := range m.logs {
+		if m.logs[i].ThreadId == from {
+			m.logs[i].ThreadId = to
+		}
+	}
+	now := time.Now().Unix()
+	for _, job := range m.jobs {
+		if job.Key == from && (job.State == JobPending || job.State == JobRunning) {
+			job.State, job.LastError, job.UpdatedAt = JobFailed, "thread renamed to "+to, now
+		}
+	}
+	return nil
+}
+
+func (m *Memory) TransitionThread(id string, from, to ThreadState) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, to)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if state, ok := m.threads[id]; !ok || state != from {
+		return fmt.Errorf("%w: thread %s is not %s", ErrStateMismatch, id, from)
+	}
+	m.threads[id] = to
+	m.transitions[id] = append(m.transitions[id], ThreadTransition{ThreadId: id, From: from, To: to, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+func (m *Memory) ReadThreadTransitions(id string) ([]ThreadTransition, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]ThreadTransition(nil), m.transitions[id]...), nil
+}
+
+func (m *Memory) Addworker(address string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.workers[address] {
+		return fmt.Errorf("failed to insert worker: %s already exists", address)
+	}
+	m.workers[address] = true
+	return nil
+}
+
+func (m *Memory) IsWorkerRegistered(address string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.workers[address], nil
+}
+
+func (m *Memory) DeleteWorker(address string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.workers, address)
+	return nil
+}
+
+func (m *Memory) AddLogEntry(threadId, log string, timestamp, severity int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.logs = append(m.logs, LogEntry{ThreadId: threadId, Log: log, Timestamp: timestamp, Severity: severity})
+	return nil
+}
+
+func (m *Memory) ReadLogs(filter LogFilter) ([]LogEntry, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var logs []LogEntry
+	for _, log := range m.logs {
+		if log.ThreadId == filter.ThreadId && log.Timestamp >= filter.Since && SeverityRank(log.Severity) >= SeverityRank(filter.MinSeverity) {
+			logs = append(logs, log)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].Timestamp < logs[j].Timestamp })
+	if filter.Reverse {
+		slices.Reverse(logs)
+	}
+
+	total := len(logs)
+	if filter.Offset >= total {
+		return nil, total, nil
+	}
+	logs = logs[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(logs) {
+		logs = logs[:filter.Limit]
+	}
+	return logs, total, nil
+}
+
+func (m *Memory) PruneLogs(before int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var kept []LogEntry
+	var pruned int64
+	for _, log := range m.logs {
+		if log.Timestamp < before && m.threads[log.ThreadId] == ThreadCompleted {
+			pruned++
+			continue
+		}
+		kept = append(kept, log)
+	}
+	m.logs = kept
+	return pruned, nil
+}
+
+func (m *Memory) AddIPFSWorker(address string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.ipfs[address]; ok {
+		return fmt.Errorf("failed to insert worker: %s already exists", address)
+	}
+	m.ipfs[address] = true
+	return nil
+}
+
+func (m *Memory) IsIPFSWorkerAdded(address string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.ipfs[address], nil
+}
+
+func (m *Memory) AddPin(threadId, cid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !slices.Contains(m.pins[threadId], cid) {
+		m.pins[threadId] = append(m.pins[threadId], cid)
+	}
+	return nil
+}
+
+func (m *Memory) ReadPins(threadId string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.pins[threadId]), nil
+}
+
+func (m *Memory) DeletePin(threadId, cid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pins[threadId] = slices.DeleteFunc(m.pins[threadId], func(pinned string) bool { return pinned == cid })
+	if len(m.pins[threadId]) == 0 {
+		delete(m.pins, threadId)
+	}
+	return nil
+}
+
+func (m *Memory) SetSalt(threadId, salt string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.salts[threadId]; !ok {
+		m.salts[threadId] = salt
+	}
+	return nil
+}
+
+func (m *Memory) ReadSalt(threadId string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.salts[threadId], nil
+}
+
+func (m *Memory) AddRenderDuration(threadId string, threadNumber, durationInSeconds int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.renderTimes[threadId] = append(m.renderTimes[threadId], durationInSeconds)
+	return nil
+}
+
+func (m *Memory) GetAverageRenderTime(threadId string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	durations := m.renderTimes[threadId]
+	if len(durations) == 0 {
+		return 0, errors.New("failed to read thread: no render times")
+	}
+	total := 0
+	for _, duration := range durations {
+		total += duration
+	}
+	return total / len(durations), nil
+}
+
+func (m *Memory) EnqueueJob(kind JobKind, key string, payload []byte, maxAttempts int) (bool, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultJobMaxAttempts
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, job := range m.jobs {
+		if job.Kind == kind && job.Key == key && (job.State == JobPending || job.State == JobRunning) {
+			return false, nil
+		}
+	}
+	now := time.Now().Unix()
+	m.jobs = append(m.jobs, &Job{
+		ID:          int64(len(m.jobs) + 1),
+		Kind:        kind,
+		Key:         key,
+		Payload:     payload,
+		State:       JobPending,
+		MaxAttempts: maxAttempts,
+		NextRunAt:   now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	return true, nil
+}
+
+func (m *Memory) ClaimJobs(now int64, limit int) ([]Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var due []*Job
+	for _, job := range m.jobs {
+		if job.State == JobPending && job.NextRunAt <= now {
+			due = append(due, job)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextRunAt < due[j].NextRunAt })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	jobs := make([]Job, 0, len(due))
+	for _, job := range due {
+		job.State = JobRunning
+		job.Attempts++
+		job.UpdatedAt = now
+		jobs = append(jobs, *job)
+	}
+	return jobs, nil
+}
+
+func (m *Memory) CompleteJob(id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job := m.job(id); job != nil {
+		job.State = JobDone
+		job.LastError = ""
+		job.UpdatedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+func (m *Memory) FailJob(id int64, jobErr error, retryAt int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job := m.job(id)
+	if job == nil {
+		return nil
+	}
+	job.State = JobPending
+	if job.Attempts >= job.MaxAttempts {
+		job.State = JobFailed
+	}
+	job.NextRunAt = retryAt
+	job.LastError = ""
+	if jobErr != nil {
+		job.LastError = jobErr.Error()
+	}
+	job.UpdatedAt = time.Now().Unix()
+	return nil
+}
+
+func (m *Memory) ResetRunningJobs() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, job := range m.jobs {
+		if job.State == JobRunning {
+			job.State = JobPending
+			job.UpdatedAt = time.Now().Unix()
+		}
+	}
+	return nil
+}
+
+func (m *Memory) ReadJob(id int64) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job := m.job(id)
+	if job == nil {
+		return nil, fmt.Errorf("job %v not found", id)
+	}
+	result := *job
+	return &result, nil
+}
+
+// job returns the job with the given id. The caller must hold the lock.
+func (m *Memory) job(id int64) *Job {
+	if id < 1 || id > int64(len(m.jobs)) {
+		return nil
+	}
+	return m.jobs[id-1]
+}
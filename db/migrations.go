@@ -0,0 +1,278 @@
+//go:build cgo
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/janction/audioStem/audioStemLogger"
+)
+
+// migration is a change to the schema of the local database. Migrations are applied in
+// order, each one in its own transaction, and are never modified once released.
+type migration struct {
+	version     int
+	description string
+	apply       func(tx *sql.Tx) error
+}
+
+var migrations = []migration{
+	{1, "initial schema", migrateInitialSchema},
+	{2, "jobs queue", migrateJobs},
+	{3, "thread state machine", migrateThreadState},
+	{4, "tasks keyed by task and thread", migrateTasksKey},
+	{5, "logs index", migrateLogsIndex},
+	{6, "pins of uploaded solutions", migratePins},
+	{7, "salts of commitments", migrateSalts},
+}
+
+// SchemaVersion returns the latest migration applied to the database.
+func (db *DB) SchemaVersion() (int, error) {
+	return schemaVersion(db.conn)
+}
+
+func schemaVersion(conn *sql.DB) (int, error) {
+	if _, err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_version (
+		version INTEGER PRIMARY KEY,
+		description TEXT,
+		applied_at INTEGER NOT NULL
+	)`); err != nil {
+		return 0, fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	var version int
+	if err := conn.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_version`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// migrate applies every pending migration. If the database already had data, a copy
+// of it is kept at backupPath before anything is changed.
+func migrate(conn *sql.DB, backupPath string) error {
+	current, err := schemaVersion(conn)
+	if err != nil {
+		return err
+	}
+
+	latest := migrations[len(migrations)-1].version
+	if current >= latest {
+		return nil
+	}
+
+	existing, err := hasTable(conn, "threads")
+	if err != nil {
+		return err
+	}
+	if existing {
+		if err := backup(conn, backupPath); err != nil {
+			return err
+		}
+		audioStemLogger.Logger.Info("database backed up at %s before migrating from version %v", backupPath, current)
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		tx, err := conn.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to start migration %v: %w", m.version, err)
+		}
+		if err := m.apply(tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %v (%s): %w", m.version, m.description, err)
+		}
+		insertQuery := `INSERT INTO schema_version (version, description, applied_at) VALUES (?,?,?)`
+		if _, err := tx.Exec(insertQuery, m.version, m.description, time.Now().Unix()); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %v: %w", m.version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %v: %w", m.version, err)
+		}
+		audioStemLogger.Logger.Info("database migrated to version %v: %s", m.version, m.description)
+	}
+	return nil
+}
+
+// backup writes a consistent copy of the database to path, replacing any previous one.
+func backup(conn *sql.DB, path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove previous backup: %w", err)
+	}
+	if _, err := conn.Exec(`VACUUM INTO ?`, path); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	return nil
+}
+
+func hasTable(conn *sql.DB, table string) (bool, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`
+	if err := conn.QueryRow(query, table).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to look up table %s: %w", table, err)
+	}
+	return count > 0, nil
+}
+
+func hasColumn(tx *sql.Tx, table, column string) (bool, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`
+	if err := tx.QueryRow(query, table, column).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to look up column %s.%s: %w", table, column, err)
+	}
+	return count > 0, nil
+}
+
+// migrateInitialSchema creates the tables as they were before versioning existed, so
+// nodes that already have them go through the same migrations as new ones.
+func migrateInitialSchema(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS tasks (
+		taskId TEXT PRIMARY KEY,
+		threadId TEXT,
+		worker_subscribed BOOLEAN
+	);
+	CREATE TABLE IF NOT EXISTS threads (
+		id TEXT PRIMARY KEY,
+		download_started BOOLEAN,
+		download_completed BOOLEAN,
+		work_started BOOLEAN,
+		work_completed BOOLEAN,
+		solution_proposed BOOLEAN,
+		solution_revealed BOOLEAN,
+		verification_started BOOLEAN,
+		submition_started BOOLEAN
+	);
+	CREATE TABLE IF NOT EXISTS workers (
+		address TEXT PRIMARY KEY,
+		registered BOOLEAN
+	);
+	CREATE TABLE IF NOT EXISTS logs (
+		threadId TEXT,
+		log TEXT,
+		timestamp NUMBER,
+		severity NUMBER
+	);
+	CREATE TABLE IF NOT EXISTS ipfs (
+		address TEXT PRIMARY KEY,
+		added BOOLEAN
+	);
+	CREATE TABLE IF NOT EXISTS render_times (
+		thread_id TEXT ,
+		frame_number NUMBER,
+		render_duration NUMBER
+	);
+	`)
+	return err
+}
+
+func migrateJobs(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS jobs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		kind TEXT NOT NULL,
+		job_key TEXT NOT NULL,
+		payload BLOB,
+		state TEXT NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		max_attempts INTEGER NOT NULL,
+		next_run_at INTEGER NOT NULL,
+		last_error TEXT,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS jobs_active ON jobs (kind, job_key) WHERE state IN ('pending', 'running');
+	`)
+	return err
+}
+
+// migrateThreadState replaces the progress booleans of the threads table by a single state.
+// The flags were set when each step started, so a thread keeps the most advanced step
+// that was flagged as done and any work in progress is started again. A verification
+// that was started was not necessarily submitted, so it goes back to the state it was
+// started from.
+func migrateThreadState(tx *sql.Tx) error {
+	if _, err := tx.Exec(`CREATE TABLE IF NOT EXISTS thread_transitions (
+		thread_id TEXT NOT NULL,
+		from_state INTEGER NOT NULL,
+		to_state INTEGER NOT NULL,
+		timestamp INTEGER NOT NULL
+	)`); err != nil {
+		return err
+	}
+
+	migrated, err := hasColumn(tx, "threads", "state")
+	if err != nil || migrated {
+		return err
+	}
+
+	_, err = tx.Exec(fmt.Sprintf(`
+	ALTER TABLE threads RENAME TO threads_v2;
+	CREATE TABLE threads (
+		id TEXT PRIMARY KEY,
+		state INTEGER NOT NULL DEFAULT 0
+	);
+	INSERT INTO threads (id, state)
+	SELECT id, CASE
+		WHEN submition_started THEN %d
+		WHEN solution_revealed THEN %d
+		WHEN solution_proposed THEN %d
+		WHEN work_completed THEN %d
+		WHEN download_completed THEN %d
+		ELSE %d
+	END FROM threads_v2;
+	DROP TABLE threads_v2;
+	`, int(ThreadSubmitted), int(ThreadRevealed), int(ThreadProposed), int(ThreadStemmed), int(ThreadDownloaded), int(ThreadIdle)))
+	return err
+}
+
+// migrateTasksKey changes the primary key of tasks to (taskId, threadId), since a task
+// has one row per thread the worker is subscribed to.
+func migrateTasksKey(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE tasks_v4 (
+		taskId TEXT NOT NULL,
+		threadId TEXT NOT NULL,
+		worker_subscribed BOOLEAN,
+		PRIMARY KEY (taskId, threadId)
+	);
+	INSERT OR IGNORE INTO tasks_v4 (taskId, threadId, worker_subscribed)
+	SELECT taskId, threadId, worker_subscribed FROM tasks WHERE threadId IS NOT NULL;
+	DROP TABLE tasks;
+	ALTER TABLE tasks_v4 RENAME TO tasks;
+	`)
+	return err
+}
+
+func migrateLogsIndex(tx *sql.Tx) error {
+	_, err := tx.Exec(`CREATE INDEX IF NOT EXISTS logs_thread ON logs (threadId, timestamp)`)
+	return err
+}
+
+func migratePins(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS pins (
+		threadId TEXT NOT NULL,
+		cid TEXT NOT NULL,
+		createdAt INTEGER NOT NULL,
+		PRIMARY KEY (threadId, cid)
+	);
+	`)
+	return err
+}
+
+func migrateSalts(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS salts (
+		threadId TEXT PRIMARY KEY,
+		salt TEXT NOT NULL
+	);
+	`)
+	return err
+}
@@ -0,0 +1,99 @@
+//go:build cgo
+
+package db
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit_NewDatabaseIsAtLatestVersion(t *testing.T) {
+	dir := t.TempDir()
+	db, err := Init(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, migrations[len(migrations)-1].version, version)
+
+	// nothing to back up on a new database
+	_, err = os.Stat(filepath.Join(dir, "audioStem.db.bak"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestInit_MigratesBaselineDatabase(t *testing.T) {
+	dir := t.TempDir()
+
+	// database as created before migrations existed
+	conn, err := sql.Open("sqlite3", filepath.Join(dir, "audioStem.db"))
+	require.NoError(t, err)
+	tx, err := conn.Begin()
+	require.NoError(t, err)
+	require.NoError(t, migrateInitialSchema(tx))
+	require.NoError(t, tx.Commit())
+	_, err = conn.Exec(`
+	INSERT INTO tasks (taskId, threadId, worker_subscribed) VALUES ('task1', 'task10', true);
+	INSERT INTO threads VALUES ('idle', false, false, false, false, false, false, false, false);
+	INSERT INTO threads VALUES ('stemming', true, true, true, false, false, false, false, false);
+	INSERT INTO threads VALUES ('stemmed', true, true, true, true, false, false, false, false);
+	INSERT INTO threads VALUES ('verifying', true, true, true, true, false, false, true, false);
+	INSERT INTO threads VALUES ('proposed verifying', true, true, true, true, true, false, true, false);
+	INSERT INTO threads VALUES ('revealed', true, true, true, true, true, true, true, false);
+	`)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	db, err := Init(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, migrations[len(migrations)-1].version, version)
+
+	_, err = os.Stat(filepath.Join(dir, "audioStem.db.bak"))
+	require.NoError(t, err)
+
+	expected := map[string]ThreadState{
+		"idle":     ThreadIdle,
+		"stemming": ThreadDownloaded,
+		"stemmed":  ThreadStemmed,
+		"revealed": ThreadRevealed,
+		// a verification in progress is verified again
+		"verifying":          ThreadStemmed,
+		"proposed verifying": ThreadProposed,
+	}
+	for id, state := range expected {
+		thread, err := db.ReadThread(id)
+		require.NoError(t, err)
+		require.Equal(t, state, thread.State, id)
+	}
+
+	task, err := db.ReadTask("task1", "task10")
+	require.NoError(t, err)
+	require.True(t, task.WorkerSubscribed)
+
+	// a second thread of the same task used to collide with the first one
+	require.NoError(t, db.AddTask("task1", "task11"))
+}
+
+func TestInit_MigrationsRunOnce(t *testing.T) {
+	dir := t.TempDir()
+	db, err := Init(dir)
+	require.NoError(t, err)
+	require.NoError(t, db.AddTask("task1", "task10"))
+	require.NoError(t, db.Close())
+
+	db, err = Init(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	task, err := db.ReadTask("task1", "task10")
+	require.NoError(t, err)
+	require.Equal(t, "task10", task.ThreadId)
+}
@@ -0,0 +1,474 @@
+//go:build cgo
+
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/janction/audioStem/audioStemLogger"
+	_ "github.com/mattn/go-sqlite3" // SQLite driver
+)
+
+var _ Database = (*DB)(nil)
+
+// DB encapsulates the database connection.
+type DB struct {
+	conn *sql.DB
+}
+
+// Open returns the sqlite database stored at path. If the path doesn't exist yet, the
+// client wasn't initialized, so the node gets a database kept in memory.
+func Open(path string) (Database, error) {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return NewMemory(), nil
+	}
+	return Init(path)
+}
+
+// Init opens the SQLite database and brings its schema up to date.
+func Init(databasePath string) (*DB, error) {
+	// if the path doesn't exists, it might be that client wasn't yet initialized, so we don't create it
+	_, err := os.Stat(databasePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &DB{}, nil
+	}
+
+	db, err := sql.Open("sqlite3", filepath.Join(databasePath, "audioStem.db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := migrate(db, filepath.Join(databasePath, "audioStem.db.bak")); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return &DB{conn: db}, nil
+}
+
+// Close closes the database connection.
+func (db *DB) Close() error {
+	return db.conn.Close()
+}
+
+// Createthread inserts a new thread into the database.
+func (db *DB) AddTask(taskId, threadId string) error {
+	insertQuery := `INSERT INTO tasks (taskId, threadId, worker_subscribed) VALUES (?,?,false)`
+	_, err := db.conn.Exec(insertQuery, taskId, threadId)
+	if err != nil {
+		return fmt.Errorf("failed to insert task: %w", err)
+	}
+
+	return nil
+}
+
+// Readthread retrieves a thread by ID.
+func (db *DB) ReadTask(taskId, threadId string) (*Task, error) {
+	query := `SELECT taskId, threadId, worker_subscribed  FROM tasks WHERE taskId = ? AND threadId = ? `
+	row := db.conn.QueryRow(query, taskId, threadId)
+
+	var task Task
+	if err := row.Scan(&task.TaskId, &task.ThreadId, &task.WorkerSubscribed); err != nil {
+		if err == sql.ErrNoRows {
+			// thead doesn't exists, so we insert it
+			db.AddTask(taskId, threadId)
+			return &Task{TaskId: taskId, ThreadId: threadId, WorkerSubscribed: false}, nil
+		}
+		return nil, fmt.Errorf("failed to read thread: %w", err)
+	}
+
+	return &task, nil
+}
+
+// Updatethread updates a task's information.
+func (db *DB) UpdateTask(taskId, threadId string, workerSubscribed bool) error {
+	updateQuery := `UPDATE tasks SET worker_subscribed = ? WHERE taskId = ? AND threadId = ? `
+	_, err := db.conn.Exec(updateQuery, workerSubscribed, taskId, threadId)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	return nil
+}
+
+// ReadSubscribedTasks returns the subscribed threads that aren't completed locally.
+func (db *DB) ReadSubscribedTasks() ([]Task, error) {
+	query := `SELECT tasks.taskId, tasks.threadId, tasks.worker_subscribed FROM tasks
+	LEFT JOIN threads ON threads.id = tasks.threadId
+	WHERE tasks.worker_subscribed AND (threads.state IS NULL OR threads.state != ?)
+	ORDER BY tasks.taskId, tasks.threadId`
+	rows, err := db.conn.Query(query, ThreadCompleted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read subscribed tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []Task
+	for rows.Next() {
+		var task Task
+		if err := rows.Scan(&task.TaskId, &task.ThreadId, &task.WorkerSubscribed); err != nil {
+			return nil, fmt.Errorf("failed to read subscribed task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// Createthread inserts a new thread into the database.
+func (db *DB) AddThread(id string) error {
+	insertQuery := `INSERT INTO threads (id, state) VALUES (?, ?)`
+	_, err := db.conn.Exec(insertQuery, id, ThreadIdle)
+	if err != nil {
+		return fmt.Errorf("failed to insert thread: %w", err)
+	}
+
+	return nil
+}
+
+// Readthread retrieves a thread by ID.
+func (db *DB) ReadThread(id string) (*Thread, error) {
+	query := `SELECT id, state FROM threads WHERE id = ?`
+	row := db.conn.QueryRow(query, id)
+
+	var thread Thread
+	if err := row.Scan(&thread.ID, &thread.State); err != nil {
+		if err == sql.ErrNoRows {
+			// thead doesn't exists, so we insert it
+			db.AddThread(id)
+			return &Thread{ID: id, State: ThreadIdle}, nil
+		}
+		return nil, fmt.Errorf("failed to read thread: %w", err)
+	}
+
+	return &thread, nil
+}
+
+// Deletethread deletes a thread by ID, together with its salt.
+func (db *DB) DeleteThread(id string) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to delete thread: %w", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`DELETE FROM threads WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete thread: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM salts WHERE threadId = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete salt: %w", err)
+	}
+	return tx.Commit()
+}
+
+// RenameThread moves every row of the thread to the new id, in a single transaction.
+func (db *DB) RenameThread(taskId, from, to string) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to rename thread: %w", err)
+	}
+	defer tx.Rollback()
+
+	updates := []string{
+		`UPDATE tasks SET threadId = ? WHERE threadId = ? AND taskId = ?`,
+		`UPDATE threads SET id = ? WHERE id = ?`,
+		`UPDATE thread_transitions SET thread_id = ? WHERE thread_id = ?`,
+		`UPDATE salts SET threadId = ? WHERE threadId = ?`,
+		`UPDATE pins SET threadId = ? WHERE threadId = ?`,
+		`UPDATE logs SET threadId = ? WHERE threadId = ?`,
+		`UPDATE render_times SET thread_id = ? WHERE thread_id = ?`,
+	}
+	for i, update := range updates {
+		args := []any{to, from}
+		if i == 0 {
+			args = append(args, taskId)
+		}
+		if _, err := tx.Exec(update, args...); err != nil {
+			return fmt.Errorf("failed to rename thread: %w", err)
+		}
+	}
+	if _, err := tx.Exec(`UPDATE jobs SET state = ?, last_error = ?, updated_at = ? WHERE job_key = ? AND state IN (?, ?)`,
+		JobFailed, "thread renamed to "+to, time.Now().Unix(), from, JobPending, JobRunning); err != nil {
+		return fmt.Errorf("failed to rename thread: %w", err)
+	}
+	return tx.Commit()
+}
+
+// Createthread inserts a new thread into the database.
+func (db *DB) Addworker(address string) error {
+	insertQuery := `INSERT INTO workers (address, registered) VALUES (?, true)`
+	_, err := db.conn.Exec(insertQuery, address)
+	if err != nil {
+		return fmt.Errorf("failed to insert worker: %w", err)
+	}
+
+	return nil
+}
+
+// Readthread retrieves a thread by ID.
+func (db *DB) IsWorkerRegistered(address string) (bool, error) {
+	query := `SELECT address, registered  FROM workers WHERE address = ?`
+	row := db.conn.QueryRow(query, address)
+
+	var worker Worker
+	if err := row.Scan(&worker.Address, &worker.Registered); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read thread: %w", err)
+	}
+
+	return true, nil
+}
+
+func (db *DB) DeleteWorker(address string) error {
+	deleteQuery := `DELETE FROM workers WHERE address = ?`
+	_, err := db.conn.Exec(deleteQuery, address)
+	if err != nil {
+		return fmt.Errorf("failed to delete worker: %w", err)
+	}
+	return nil
+}
+
+// inserts a new log entry
+func (db *DB) AddLogEntry(threadId, log string, timestamp, severity int64) error {
+	insertQuery := `INSERT INTO logs (threadId, log, timestamp, severity) VALUES (?,?,?,?)`
+	audioStemLogger.Logger.Info("inserting log %s", log)
+	_, err := db.conn.Exec(insertQuery, threadId, log, timestamp, severity)
+	if err != nil {
+		return fmt.Errorf("failed to insert log entry: %w", err)
+	}
+
+	return nil
+}
+
+// ReadLogs returns the log entries of a thread that match the filter, together with
+// the amount of entries matching it regardless of the page requested.
+func (db *DB) ReadLogs(filter LogFilter) ([]LogEntry, int, error) {
+	where := `threadId = ? AND timestamp >= ? AND ` + severityRankSQL() + ` >= ?`
+	args := []any{filter.ThreadId, filter.Since, SeverityRank(filter.MinSeverity)}
+
+	var total int
+	if err := db.conn.QueryRow(`SELECT COUNT(*) FROM logs WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count logs: %w", err)
+	}
+
+	order := "ASC"
+	if filter.Reverse {
+		order = "DESC"
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	query := `SELECT threadId, log, timestamp, severity FROM logs WHERE ` + where + ` ORDER BY timestamp ` + order + `, rowid ` + order + ` LIMIT ? OFFSET ?`
+	rows, err := db.conn.Query(query, append(args, limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read logs: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []LogEntry
+	for rows.Next() { // Iterate and fetch the records from result cursor
+		log := LogEntry{}
+		if err := rows.Scan(&log.ThreadId, &log.Log, &log.Timestamp, &log.Severity); err != nil {
+			return nil, 0, fmt.Errorf("failed to read log entry: %w", err)
+		}
+		logs = append(logs, log)
+	}
+	return logs, total, rows.Err()
+}
+
+// severityRankSQL returns the SQL expression with the rank of the severity column.
+func severityRankSQL() string {
+	expr := "CASE severity"
+	for _, severity := range []int64{SeverityInfo, SeveritySuccess, SeverityWarning, SeverityError} {
+		expr += fmt.Sprintf(" WHEN %d THEN %d", severity, SeverityRank(severity))
+	}
+	return expr + " ELSE severity END"
+}
+
+// PruneLogs deletes the log entries logged before the given timestamp that belong to
+// threads this node is done with. It returns the amount of entries deleted.
+func (db *DB) PruneLogs(before int64) (int64, error) {
+	deleteQuery := `DELETE FROM logs WHERE timestamp < ? AND threadId IN (SELECT id FROM threads WHERE state = ?)`
+	res, err := db.conn.Exec(deleteQuery, before, ThreadCompleted)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune logs: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// Createthread inserts a new thread into the database.
+func (db *DB) AddIPFSWorker(address string) error {
+	insertQuery := `INSERT INTO ipfs (address, added) VALUES (?, true)`
+	_, err := db.conn.Exec(insertQuery, address)
+	if err != nil {
+		return fmt.Errorf("failed to insert worker: %w", err)
+	}
+	return nil
+}
+
+// Readthread retrieves a thread by ID.
+func (db *DB) IsIPFSWorkerAdded(address string) (bool, error) {
+	query := `SELECT added FROM ipfs WHERE address = ?`
+	row := db.conn.QueryRow(query, address)
+
+	var added sql.NullBool
+	if err := row.Scan(&added); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil // No worker found, returning false
+		}
+		return false, fmt.Errorf("failed to read IPFS worker status: %w", err)
+	}
+
+	// If the value is NULL, treat it as "not added" (false)
+	return added.Valid && added.Bool, nil
+}
+
+// AddPin records that the content cid was pinned for a thread.
+func (db *DB) AddPin(threadId, cid string) error {
+	insertQuery := `INSERT OR IGNORE INTO pins (threadId, cid, createdAt) VALUES (?, ?, ?)`
+	_, err := db.conn.Exec(insertQuery, threadId, cid, time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf("failed to insert pin: %w", err)
+	}
+	return nil
+}
+
+// ReadPins returns the CIDs pinned for a thread, oldest first.
+func (db *DB) ReadPins(threadId string) ([]string, error) {
+	query := `SELECT cid FROM pins WHERE threadId = ? ORDER BY createdAt, rowid`
+	rows, err := db.conn.Query(query, threadId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pins: %w", err)
+	}
+	defer rows.Close()
+
+	var cids []string
+	for rows.Next() {
+		var cid string
+		if err := rows.Scan(&cid); err != nil {
+			return nil, fmt.Errorf("failed to read pins: %w", err)
+		}
+		cids = append(cids, cid)
+	}
+	return cids, rows.Err()
+}
+
+// DeletePin forgets a pin once the content was unpinned.
+func (db *DB) DeletePin(threadId, cid string) error {
+	deleteQuery := `DELETE FROM pins WHERE threadId = ? AND cid = ?`
+	_, err := db.conn.Exec(deleteQuery, threadId, cid)
+	if err != nil {
+		return fmt.Errorf("failed to delete pin: %w", err)
+	}
+	return nil
+}
+
+// SetSalt stores the salt of the commitments sent for a thread. Once stored, it never changes,
+// so the reveal always matches what was committed.
+func (db *DB) SetSalt(threadId, salt string) error {
+	insertQuery := `INSERT OR IGNORE INTO salts (threadId, salt) VALUES (?, ?)`
+	_, err := db.conn.Exec(insertQuery, threadId, salt)
+	if err != nil {
+		return fmt.Errorf("failed to insert salt: %w", err)
+	}
+	return nil
+}
+
+// ReadSalt returns the salt of a thread, or empty if none was stored.
+func (db *DB) ReadSalt(threadId string) (string, error) {
+	var salt string
+	err := db.conn.QueryRow(`SELECT salt FROM salts WHERE threadId = ?`, threadId).Scan(&salt)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read salt: %w", err)
+	}
+	return salt, nil
+}
+
+func (db *DB) AddRenderDuration(threadId string, threadNumber, durationInSeconds int) error {
+	insertQuery := `INSERT INTO render_times (thread_id, frame_number, render_duration) VALUES (?,?,?)`
+	_, err := db.conn.Exec(insertQuery, threadId, threadNumber, durationInSeconds)
+	if err != nil {
+		audioStemLogger.Logger.Error("failed to insert render duration entry: %s", err.Error())
+		return fmt.Errorf("failed to insert render duration entry: %w", err)
+	}
+
+	return nil
+}
+
+// inserts a new log entry
+func (db *DB) GetAverageRenderTime(threadId string) (int, error) {
+	query := `SELECT CAST(AVG(render_duration) AS INT)  FROM render_times WHERE thread_id = ?`
+	row := db.conn.QueryRow(query, threadId)
+
+	var avg int
+	if err := row.Scan(&avg); err != nil {
+		audioStemLogger.Logger.Error("failed to read render_times: %s", err.Error())
+		return 0, fmt.Errorf("failed to read thread: %w", err)
+	}
+
+	return avg, nil
+}
+
+// TransitionThread moves the thread from one state to another. The change is rejected
+// if the transition is not allowed or if the thread is not in the from state anymore.
+func (db *DB) TransitionThread(id string, from, to ThreadState) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, to)
+	}
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to transition thread: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`UPDATE threads SET state = ? WHERE id = ? AND state = ?`, to, id, from)
+	if err != nil {
+		return fmt.Errorf("failed to transition thread: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to transition thread: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: thread %s is not %s", ErrStateMismatch, id, from)
+	}
+
+	insertQuery := `INSERT INTO thread_transitions (thread_id, from_state, to_state, timestamp) VALUES (?,?,?,?)`
+	if _, err := tx.Exec(insertQuery, id, from, to, time.Now().Unix()); err != nil {
+		return fmt.Errorf("failed to record thread transition: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to transition thread: %w", err)
+	}
+	return nil
+}
+
+// ReadThreadTransitions returns the history of state changes of a thread, oldest first.
+func (db *DB) ReadThreadTransitions(id string) ([]ThreadTransition, error) {
+	query := `SELECT thread_id, from_state, to_state, timestamp FROM thread_transitions WHERE thread_id = ? ORDER BY rowid`
+	rows, err := db.conn.Query(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thread transitions: %w", err)
+	}
+	defer rows.Close()
+
+	var transitions []ThreadTransition
+	for rows.Next() {
+		var t ThreadTransition
+		if err := rows.Scan(&t.ThreadId, &t.From, &t.To, &t.Timestamp); err != nil {
+			return nil, fmt.Errorf("failed to read thread transition: %w", err)
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions, rows.Err()
+}
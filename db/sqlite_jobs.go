@@ -0,0 +1,131 @@
+//go:build cgo
+
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const jobColumns = `id, kind, job_key, payload, state, attempts, max_attempts, next_run_at, last_error, created_at, updated_at`
+
+func scanJob(scanner interface{ Scan(...any) error }) (Job, error) {
+	var job Job
+	var lastError sql.NullString
+	err := scanner.Scan(&job.ID, &job.Kind, &job.Key, &job.Payload, &job.State, &job.Attempts, &job.MaxAttempts, &job.NextRunAt, &lastError, &job.CreatedAt, &job.UpdatedAt)
+	job.LastError = lastError.String
+	return job, err
+}
+
+// EnqueueJob adds a pending job unless an active (pending or running) job of the same
+// kind and key already exists. It returns true if a new job was queued.
+func (db *DB) EnqueueJob(kind JobKind, key string, payload []byte, maxAttempts int) (bool, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultJobMaxAttempts
+	}
+	now := time.Now().Unix()
+	insertQuery := `INSERT OR IGNORE INTO jobs (kind, job_key, payload, state, attempts, max_attempts, next_run_at, created_at, updated_at) VALUES (?,?,?,?,0,?,?,?,?)`
+	res, err := db.conn.Exec(insertQuery, kind, key, payload, JobPending, maxAttempts, now, now, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// ClaimJobs marks up to limit due pending jobs as running and returns them.
+func (db *DB) ClaimJobs(now int64, limit int) ([]Job, error) {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE state = ? AND next_run_at <= ? ORDER BY next_run_at, id LIMIT ?`
+	rows, err := tx.Query(query, JobPending, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+
+	var jobs []Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to read job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	rows.Close()
+
+	updateQuery := `UPDATE jobs SET state = ?, attempts = attempts + 1, updated_at = ? WHERE id = ? AND state = ?`
+	for i := range jobs {
+		if _, err := tx.Exec(updateQuery, JobRunning, now, jobs[i].ID, JobPending); err != nil {
+			return nil, fmt.Errorf("failed to claim job %v: %w", jobs[i].ID, err)
+		}
+		jobs[i].State = JobRunning
+		jobs[i].Attempts++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// CompleteJob marks a running job as done.
+func (db *DB) CompleteJob(id int64) error {
+	updateQuery := `UPDATE jobs SET state = ?, last_error = NULL, updated_at = ? WHERE id = ?`
+	_, err := db.conn.Exec(updateQuery, JobDone, time.Now().Unix(), id)
+	if err != nil {
+		return fmt.Errorf("failed to complete job: %w", err)
+	}
+	return nil
+}
+
+// FailJob records the error of a job execution. The job is scheduled again at retryAt
+// unless it has used all of its attempts, in which case it is marked as failed.
+func (db *DB) FailJob(id int64, jobErr error, retryAt int64) error {
+	message := ""
+	if jobErr != nil {
+		message = jobErr.Error()
+	}
+	updateQuery := `UPDATE jobs SET
+		state = CASE WHEN attempts >= max_attempts THEN ? ELSE ? END,
+		next_run_at = ?, last_error = ?, updated_at = ?
+		WHERE id = ?`
+	_, err := db.conn.Exec(updateQuery, JobFailed, JobPending, retryAt, message, time.Now().Unix(), id)
+	if err != nil {
+		return fmt.Errorf("failed to fail job: %w", err)
+	}
+	return nil
+}
+
+// ResetRunningJobs moves jobs left running by a previous process back to pending,
+// so work interrupted by a restart is picked up again.
+func (db *DB) ResetRunningJobs() error {
+	updateQuery := `UPDATE jobs SET state = ?, updated_at = ? WHERE state = ?`
+	_, err := db.conn.Exec(updateQuery, JobPending, time.Now().Unix(), JobRunning)
+	if err != nil {
+		return fmt.Errorf("failed to reset running jobs: %w", err)
+	}
+	return nil
+}
+
+// ReadJob retrieves a job by id.
+func (db *DB) ReadJob(id int64) (*Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = ?`
+	job, err := scanJob(db.conn.QueryRow(query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("job %v not found", id)
+		}
+		return nil, fmt.Errorf("failed to read job: %w", err)
+	}
+	return &job, nil
+}
@@ -0,0 +1,12 @@
+//go:build !cgo
+
+package db
+
+import "github.com/janction/audioStem/audioStemLogger"
+
+// Open returns a database kept in memory, since sqlite requires cgo. Nothing survives a
+// restart, which is fine for nodes that don't run a worker.
+func Open(path string) (Database, error) {
+	audioStemLogger.Logger.Info("built without cgo, the worker database at %s is kept in memory", path)
+	return NewMemory(), nil
+}
@@ -0,0 +1,20 @@
+//go:build cgo
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	databases["sqlite"] = func(t *testing.T) Database { return newTestDB(t) }
+}
+
+func newTestDB(t *testing.T) *DB {
+	db, err := Init(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ThreadState is the progress of this worker on a thread.
+type ThreadState int
+
+const (
+	ThreadIdle ThreadState = iota
+	ThreadDownloading
+	ThreadDownloaded
+	ThreadStemming
+	ThreadStemmed
+	ThreadProposing
+	ThreadProposed
+	ThreadVerifying
+	ThreadVerified
+	ThreadRevealing
+	ThreadRevealed
+	ThreadSubmitting
+	ThreadSubmitted
+	ThreadCompleted
+)
+
+var threadStateNames = map[ThreadState]string{
+	ThreadIdle:        "idle",
+	ThreadDownloading: "downloading",
+	ThreadDownloaded:  "downloaded",
+	ThreadStemming:    "stemming",
+	ThreadStemmed:     "stemmed",
+	ThreadProposing:   "proposing",
+	ThreadProposed:    "proposed",
+	ThreadVerifying:   "verifying",
+	ThreadVerified:    "verified",
+	ThreadRevealing:   "revealing",
+	ThreadRevealed:    "revealed",
+	ThreadSubmitting:  "submitting",
+	ThreadSubmitted:   "submitted",
+	ThreadCompleted:   "completed",
+}
+
+func (s ThreadState) String() string {
+	if name, ok := threadStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// threadTransitions lists, for each state, the states a thread can move to.
+// Every in-progress state can go back to the state it started from when the work fails.
+var threadTransitions = map[ThreadState][]ThreadState{
+	ThreadIdle:        {ThreadDownloading},
+	ThreadDownloading: {ThreadDownloaded, ThreadIdle},
+	ThreadDownloaded:  {ThreadStemming},
+	ThreadStemming:    {ThreadStemmed, ThreadDownloaded},
+	ThreadStemmed:     {ThreadProposing, ThreadVerifying},
+	ThreadProposing:   {ThreadProposed, ThreadStemmed},
+	ThreadProposed:    {ThreadVerifying, ThreadRevealing},
+	ThreadVerifying:   {ThreadVerified, ThreadStemmed, ThreadProposed},
+	ThreadVerified:    {ThreadRevealing},
+	ThreadRevealing:   {ThreadRevealed, ThreadProposed, ThreadVerified},
+	ThreadRevealed:    {ThreadSubmitting},
+	ThreadSubmitting:  {ThreadSubmitted, ThreadRevealed},
+	ThreadSubmitted:   {},
+	ThreadCompleted:   {},
+}
+
+var (
+	ErrIllegalTransition = errors.New("illegal thread state transition")
+	ErrStateMismatch     = errors.New("thread is not in the expected state")
+)
+
+// CanTransition returns true if a thread can move from one state to the other.
+// Any thread can be marked as completed once the chain says so.
+func CanTransition(from, to ThreadState) bool {
+	if to == ThreadCompleted {
+		return from != ThreadCompleted
+	}
+	for _, allowed := range threadTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ThreadTransition is an entry of the history of state changes of a thread.
+type ThreadTransition struct {
+	ThreadId  string
+	From      ThreadState
+	To        ThreadState
+	Timestamp int64
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransition(t *testing.T) {
+	require.True(t, CanTransition(ThreadIdle, ThreadDownloading))
+	require.True(t, CanTransition(ThreadStemming, ThreadDownloaded))
+	require.True(t, CanTransition(ThreadProposed, ThreadVerifying))
+	require.True(t, CanTransition(ThreadRevealed, ThreadCompleted))
+
+	require.False(t, CanTransition(ThreadIdle, ThreadStemmed))
+	require.False(t, CanTransition(ThreadDownloaded, ThreadProposing))
+	require.False(t, CanTransition(ThreadSubmitted, ThreadIdle))
+	require.False(t, CanTransition(ThreadCompleted, ThreadCompleted))
+}
+
+func TestTransitionThread(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		thread, err := db.ReadThread("thread1")
+		require.NoError(t, err)
+		require.Equal(t, ThreadIdle, thread.State)
+
+		require.NoError(t, db.TransitionThread("thread1", ThreadIdle, ThreadDownloading))
+		require.NoError(t, db.TransitionThread("thread1", ThreadDownloading, ThreadDownloaded))
+
+		thread, err = db.ReadThread("thread1")
+		require.NoError(t, err)
+		require.Equal(t, ThreadDownloaded, thread.State)
+	})
+}
+
+func TestTransitionThread_IllegalTransition(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		_, err := db.ReadThread("thread1")
+		require.NoError(t, err)
+
+		err = db.TransitionThread("thread1", ThreadIdle, ThreadProposed)
+		require.ErrorIs(t, err, ErrIllegalTransition)
+
+		thread, err := db.ReadThread("thread1")
+		require.NoError(t, err)
+		require.Equal(t, ThreadIdle, thread.State)
+	})
+}
+
+func TestTransitionThread_StateMismatch(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		_, err := db.ReadThread("thread1")
+		require.NoError(t, err)
+		require.NoError(t, db.TransitionThread("thread1", ThreadIdle, ThreadDownloading))
+
+		// a second worker trying the same transition loses
+		err = db.TransitionThread("thread1", ThreadIdle, ThreadDownloading)
+		require.ErrorIs(t, err, ErrStateMismatch)
+
+		transitions, err := db.ReadThreadTransitions("thread1")
+		require.NoError(t, err)
+		require.Len(t, transitions, 1)
+	})
+}
+
+func TestReadThreadTransitions(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db Database) {
+		_, err := db.ReadThread("thread1")
+		require.NoError(t, err)
+
+		require.NoError(t, db.TransitionThread("thread1", ThreadIdle, ThreadDownloading))
+		require.NoError(t, db.TransitionThread("thread1", ThreadDownloading, ThreadIdle))
+		require.NoError(t, db.TransitionThread("thread1", ThreadIdle, ThreadCompleted))
+
+		transitions, err := db.ReadThreadTransitions("thread1")
+		require.NoError(t, err)
+		require.Len(t, transitions, 3)
+		require.Equal(t, ThreadIdle, transitions[0].From)
+		require.Equal(t, ThreadDownloading, transitions[0].To)
+		require.Equal(t, ThreadDownloading, transitions[1].From)
+		require.Equal(t, ThreadIdle, transitions[1].To)
+		require.Equal(t, ThreadCompleted, transitions[2].To)
+	})
+}
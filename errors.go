@@ -5,15 +5,33 @@ import "cosmossdk.io/errors"
 var (
 	ErrIndexTooLong     = errors.Register(ModuleName, 2, "index too long")
 	ErrDuplicateAddress = errors.Register(ModuleName, 3, "duplicate address")
+	ErrInvalidAddress   = errors.Register(ModuleName, 4, "invalid address")
+	ErrInvalidCoin      = errors.Register(ModuleName, 5, "invalid coin")
+	ErrInvalidThreadId  = errors.Register(ModuleName, 6, "thread doesn't belong to the task")
 
 	ErrWorkerAlreadyRegistered = errors.Register(ModuleName, 10, "worker already registered")
 	ErrWorkerNotAvailable      = errors.Register(ModuleName, 11, "worker cannot subscribe to task")
 	ErrWorkerTaskNotAvailable  = errors.Register(ModuleName, 12, "task is already completed")
 	ErrWorkerIncorrectStake    = errors.Register(ModuleName, 13, "staked coin is incorrect")
+	ErrWorkerInvalidPublicIp   = errors.Register(ModuleName, 14, "public ip of the worker is invalid")
+	ErrWorkerInvalidIpfsId     = errors.Register(ModuleName, 15, "ipfs peer id of the worker is invalid")
+	ErrInvalidSigner           = errors.Register(ModuleName, 16, "signer of the worker is invalid")
 
 	ErrInvalidAudioStemTask = errors.Register(ModuleName, 20, "invalid audio stem task")
+	ErrInvalidAmountFiles   = errors.Register(ModuleName, 21, "amount of files of the task is out of range")
+	ErrInvalidInstrument    = errors.Register(ModuleName, 22, "instrument can't be separated")
+	ErrInvalidCid           = errors.Register(ModuleName, 23, "invalid cid")
 
-	ErrInvalidSolution = errors.Register(ModuleName, 30, "proposed solution is invalid")
+	ErrInvalidSolution   = errors.Register(ModuleName, 30, "proposed solution is invalid")
+	ErrInvalidCommitment = errors.Register(ModuleName, 31, "commitment is invalid")
+	ErrInvalidPublicKey  = errors.Register(ModuleName, 32, "public key is invalid")
+	ErrInvalidSignature  = errors.Register(ModuleName, 33, "signature is invalid")
+	ErrInvalidReveal     = errors.Register(ModuleName, 34, "revealed stems are invalid")
 
 	ErrInvalidVerification = errors.Register(ModuleName, 40, "verification to solution is invalid")
+
+	ErrInvalidInputReport = errors.Register(ModuleName, 50, "invalid input report is invalid")
+
+	ErrInvalidAuthority = errors.Register(ModuleName, 60, "sender is not the authority of the module")
+	ErrInvalidParams    = errors.Register(ModuleName, 61, "params are invalid")
 )
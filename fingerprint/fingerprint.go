@@ -0,0 +1,209 @@
+// Package fingerprint summarizes audio as the energy of a few frequency bands over fixed
+// windows of time. Two stems produced by different builds of the stemming model are not
+// equal byte by byte, but their fingerprints are close, so they can be compared within
+// a tolerance instead of by hash.
+package fingerprint
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"os"
+)
+
+const (
+	// WindowSeconds is the duration summarized by each frame of a fingerprint.
+	WindowSeconds = 0.5
+	// Bands is the amount of frequency bands of each frame.
+	Bands = 8
+	// Tolerance is the difference, in steps of StepDB, for two bands to be considered equal.
+	Tolerance = 6
+	// StepDB is the resolution of the band energies.
+	StepDB = 0.5
+
+	fftSize = 4096
+	// energies below the floor are treated as silence
+	floorDB = -80.0
+	// bands this far below the loudest band of their frame are masked by it
+	dynamicRangeDB = 50.0
+	minFreq        = 60.0
+	maxFreq        = 16000.0
+)
+
+// Fingerprint is the quantized energy of each band, frame after frame.
+type Fingerprint []byte
+
+// Frames returns the amount of windows of time in the fingerprint.
+func (f Fingerprint) Frames() int {
+	return len(f) / Bands
+}
+
+// Encode returns the fingerprint in the form stored on chain.
+func (f Fingerprint) Encode() string {
+	return base64.StdEncoding.EncodeToString(f)
+}
+
+// Decode parses a fingerprint returned by Encode.
+func Decode(s string) (Fingerprint, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fingerprint: %w", err)
+	}
+	if len(data)%Bands != 0 {
+		return nil, fmt.Errorf("invalid fingerprint: length %d is not a multiple of %d bands", len(data), Bands)
+	}
+	return Fingerprint(data), nil
+}
+
+// Similarity returns the fraction, between 0 and 1, of the bands of both fingerprints that
+// are within Tolerance of each other. Frames only present in one of them count as different.
+func Similarity(a, b Fingerprint) float64 {
+	total := max(len(a), len(b))
+	if total == 0 {
+		return 1
+	}
+
+	matched := 0
+	for i := 0; i < min(len(a), len(b)); i++ {
+		diff := int(a[i]) - int(b[i])
+		if diff >= -Tolerance && diff <= Tolerance {
+			matched++
+		}
+	}
+	return float64(matched) / float64(total)
+}
+
+// File computes the fingerprint of a WAV or MP3 file.
+func File(path string) (Fingerprint, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	source, err := newSource(file)
+	if err != nil {
+		return nil, err
+	}
+	return compute(source)
+}
+
+// compute reads the samples window after window and averages their spectrum in each band.
+func compute(source source) (Fingerprint, error) {
+	sampleRate := source.sampleRate()
+	if sampleRate <= 0 {
+		return nil, errors.New("invalid sample rate")
+	}
+
+	hop := int(float64(sampleRate) * WindowSeconds)
+	chunks := max(hop/fftSize, 1)
+	edges := bandEdges(sampleRate)
+	window := hann(fftSize)
+
+	var fingerprint Fingerprint
+	samples := make([]float64, chunks*fftSize)
+	for {
+		n, err := readFull(source, samples)
+		if err != nil {
+			return nil, err
+		}
+		// a trailing partial window is only kept when it is all there is
+		if n == 0 || (n < len(samples) && len(fingerprint) > 0) {
+			break
+		}
+		clear(samples[n:])
+
+		energies := make([]float64, Bands)
+		spectrum := make([]complex128, fftSize)
+		for c := 0; c < chunks; c++ {
+			for i := range spectrum {
+				spectrum[i] = complex(samples[c*fftSize+i]*window[i], 0)
+			}
+			fft(spectrum)
+			for band := 0; band < Bands; band++ {
+				for bin := edges[band]; bin < edges[band+1]; bin++ {
+					energies[band] += math.Pow(cmplx.Abs(spectrum[bin]), 2)
+				}
+			}
+		}
+
+		fingerprint = append(fingerprint, quantize(energies, float64(chunks*fftSize*fftSize))...)
+		if n < len(samples) {
+			break
+		}
+	}
+	return fingerprint, nil
+}
+
+// quantize converts the energies of a frame to steps of StepDB above the floor.
+func quantize(energies []float64, norm float64) []byte {
+	levels := make([]float64, len(energies))
+	peak := floorDB
+	for i, energy := range energies {
+		levels[i] = floorDB
+		if energy > 0 {
+			levels[i] = max(10*math.Log10(energy/norm), floorDB)
+		}
+		peak = max(peak, levels[i])
+	}
+
+	frame := make([]byte, len(levels))
+	for i, level := range levels {
+		level = max(level, peak-dynamicRangeDB)
+		frame[i] = byte(math.Min(math.Round((level-floorDB)/StepDB), math.MaxUint8))
+	}
+	return frame
+}
+
+// bandEdges returns the FFT bins where each band starts, spaced logarithmically.
+func bandEdges(sampleRate int) []int {
+	high := math.Min(maxFreq, float64(sampleRate)/2)
+	edges := make([]int, Bands+1)
+	for i := range edges {
+		freq := minFreq * math.Pow(high/minFreq, float64(i)/Bands)
+		edges[i] = int(math.Round(freq * fftSize / float64(sampleRate)))
+	}
+	// every band covers at least one bin
+	for i := 1; i < len(edges); i++ {
+		edges[i] = max(edges[i], edges[i-1]+1)
+	}
+	return edges
+}
+
+func hann(size int) []float64 {
+	window := make([]float64, size)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size-1))
+	}
+	return window
+}
+
+// fft is an in place radix-2 transform. The length of x must be a power of two.
+func fft(x []complex128) {
+	n := len(x)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j |= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even, odd := x[start+k], w*x[start+k+size/2]
+				x[start+k] = even + odd
+				x[start+k+size/2] = even - odd
+				w *= step
+			}
+		}
+	}
+}
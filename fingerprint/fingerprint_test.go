@@ -0,0 +1,130 @@
+package fingerprint
+
+import (
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
+	"github.com/stretchr/testify/require"
+)
+
+const testSampleRate = 44100
+
+// tone returns seconds of stereo samples of the given frequencies, plus noise of the
+// given amplitude.
+func tone(seconds float64, noise float64, freqs ...float64) []int {
+	rng := rand.New(rand.NewSource(1))
+	samples := make([]int, int(seconds*testSampleRate)*2)
+	for i := 0; i < len(samples)/2; i++ {
+		value := 0.0
+		for _, freq := range freqs {
+			value += 0.3 * math.Sin(2*math.Pi*freq*float64(i)/testSampleRate)
+		}
+		value += noise * (rng.Float64()*2 - 1)
+		samples[2*i] = int(value * 32767)
+		samples[2*i+1] = samples[2*i]
+	}
+	return samples
+}
+
+func writeWav(t *testing.T, samples []int) string {
+	path := filepath.Join(t.TempDir(), "stem.wav")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	encoder := wav.NewEncoder(file, testSampleRate, 16, 2, 1)
+	buf := &audio.IntBuffer{Format: &audio.Format{NumChannels: 2, SampleRate: testSampleRate}, Data: samples, SourceBitDepth: 16}
+	require.NoError(t, encoder.Write(buf))
+	require.NoError(t, encoder.Close())
+	return path
+}
+
+func TestFile(t *testing.T) {
+	fp, err := File(writeWav(t, tone(3, 0, 440)))
+	require.NoError(t, err)
+	require.Equal(t, 6, fp.Frames())
+
+	// the band of 440Hz holds most of the energy
+	band := 0
+	for i := 1; i < Bands; i++ {
+		if fp[i] > fp[band] {
+			band = i
+		}
+	}
+	edges := bandEdges(testSampleRate)
+	bin := int(440 * fftSize / testSampleRate)
+	require.True(t, edges[band] <= bin && bin < edges[band+1])
+}
+
+func TestFile_Short(t *testing.T) {
+	fp, err := File(writeWav(t, tone(0.1, 0, 440)))
+	require.NoError(t, err)
+	require.Equal(t, 1, fp.Frames())
+}
+
+func TestFile_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stem.wav")
+	require.NoError(t, os.WriteFile(path, []byte("not audio"), 0644))
+
+	_, err := File(path)
+	require.Error(t, err)
+}
+
+func TestSimilarity(t *testing.T) {
+	original, err := File(writeWav(t, tone(5, 0, 220, 880)))
+	require.NoError(t, err)
+
+	require.Equal(t, 1.0, Similarity(original, original))
+
+	// small differences, like the ones between builds of the model, are tolerated
+	noisy, err := File(writeWav(t, tone(5, 0.001, 220, 880)))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, Similarity(original, noisy), 0.9)
+
+	other, err := File(writeWav(t, tone(5, 0, 3000)))
+	require.NoError(t, err)
+	require.Less(t, Similarity(original, other), 0.6)
+
+	// missing frames count as different
+	truncated, err := File(writeWav(t, tone(2.5, 0, 220, 880)))
+	require.NoError(t, err)
+	require.InDelta(t, 0.5, Similarity(original, truncated), 0.01)
+
+	require.Equal(t, 1.0, Similarity(nil, nil))
+	require.Equal(t, 0.0, Similarity(original, nil))
+}
+
+func TestEncodeDecode(t *testing.T) {
+	fp, err := File(writeWav(t, tone(1, 0, 440)))
+	require.NoError(t, err)
+
+	decoded, err := Decode(fp.Encode())
+	require.NoError(t, err)
+	require.Equal(t, fp, decoded)
+
+	_, err = Decode("not base64!")
+	require.Error(t, err)
+	_, err = Decode(Fingerprint{1, 2, 3}.Encode())
+	require.ErrorContains(t, err, "multiple")
+}
+
+func TestFFT(t *testing.T) {
+	x := make([]complex128, 8)
+	for i := range x {
+		x[i] = complex(math.Cos(2*math.Pi*float64(i)/8), 0)
+	}
+	fft(x)
+	for i, v := range x {
+		expected := 0.0
+		if i == 1 || i == 7 {
+			expected = 4
+		}
+		require.InDelta(t, expected, real(v), 1e-9)
+		require.InDelta(t, 0, imag(v), 1e-9)
+	}
+}
@@ -0,0 +1,120 @@
+package fingerprint
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// source streams the samples of a file mixed down to mono, between -1 and 1.
+type source interface {
+	sampleRate() int
+	read(samples []float64) (int, error)
+}
+
+func newSource(file io.ReadSeeker) (source, error) {
+	decoder := wav.NewDecoder(file)
+	if decoder.IsValidFile() {
+		if err := decoder.FwdToPCM(); err != nil {
+			return nil, fmt.Errorf("failed to decode WAV: %w", err)
+		}
+		if decoder.NumChans == 0 || decoder.BitDepth == 0 {
+			return nil, errors.New("failed to decode WAV: invalid header")
+		}
+		return &wavSource{decoder: decoder}, nil
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	mp3Decoder, err := mp3.NewDecoder(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode WAV or MP3: %w", err)
+	}
+	return &mp3Source{decoder: mp3Decoder}, nil
+}
+
+// readFull reads samples until the slice is full or the source ends.
+func readFull(s source, samples []float64) (int, error) {
+	total := 0
+	for total < len(samples) {
+		n, err := s.read(samples[total:])
+		total += n
+		if err == io.EOF || (err == nil && n == 0) {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+type wavSource struct {
+	decoder *wav.Decoder
+	buf     *audio.IntBuffer
+}
+
+func (s *wavSource) sampleRate() int {
+	return int(s.decoder.SampleRate)
+}
+
+func (s *wavSource) read(samples []float64) (int, error) {
+	channels := int(s.decoder.NumChans)
+	if s.buf == nil || len(s.buf.Data) < len(samples)*channels {
+		s.buf = &audio.IntBuffer{Data: make([]int, len(samples)*channels)}
+	}
+	s.buf.Data = s.buf.Data[:len(samples)*channels]
+
+	n, err := s.decoder.PCMBuffer(s.buf)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+
+	scale := float64(int(1) << (s.decoder.BitDepth - 1))
+	frames := n / channels
+	for i := 0; i < frames; i++ {
+		sum := 0
+		for c := 0; c < channels; c++ {
+			sum += s.buf.Data[i*channels+c]
+		}
+		samples[i] = float64(sum) / float64(channels) / scale
+	}
+	return frames, nil
+}
+
+// mp3Source reads the output of the decoder, which is always 16 bit little endian stereo.
+type mp3Source struct {
+	decoder *mp3.Decoder
+	buf     []byte
+}
+
+func (s *mp3Source) sampleRate() int {
+	return s.decoder.SampleRate()
+}
+
+func (s *mp3Source) read(samples []float64) (int, error) {
+	if len(s.buf) < len(samples)*4 {
+		s.buf = make([]byte, len(samples)*4)
+	}
+	n, err := io.ReadFull(s.decoder, s.buf[:len(samples)*4])
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+
+	frames := n / 4
+	for i := 0; i < frames; i++ {
+		left := int16(binary.LittleEndian.Uint16(s.buf[i*4:]))
+		right := int16(binary.LittleEndian.Uint16(s.buf[i*4+2:]))
+		samples[i] = (float64(left) + float64(right)) / 2 / 32768
+	}
+	return frames, err
+}
@@ -0,0 +1,155 @@
+package ipfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	shell "github.com/ipfs/go-ipfs-api"
+)
+
+// DefaultAPI is the address of the RPC API of a local Kubo node.
+const DefaultAPI = "127.0.0.1:5001"
+
+// Client is the part of the IPFS RPC API the worker relies on.
+type Client interface {
+	// Stat returns the total size in bytes of the blocks of the DAG of cid.
+	Stat(ctx context.Context, cid string) (uint64, error)
+	// Cat streams the content of the file cid, starting at offset.
+	Cat(ctx context.Context, cid string, offset int64) (io.ReadCloser, error)
+	// AddDir adds and pins a directory, returning the CID of its root.
+	AddDir(ctx context.Context, dir string) (string, error)
+	// Unpin removes the recursive pin of cid, so the node can garbage collect it. Content
+	// that isn't pinned is not an error.
+	Unpin(ctx context.Context, cid string) error
+	// List returns the CIDs of the entries of a directory, by name.
+	List(ctx context.Context, cid string) (map[string]string, error)
+	// Connect connects the node to the peer at the given multiaddress.
+	Connect(ctx context.Context, addr string) error
+	// ID returns the peer id of the node.
+	ID(ctx context.Context) (string, error)
+}
+
+var (
+	clientMu      sync.RWMutex
+	defaultClient Client = NewHTTPClient(DefaultAPI)
+)
+
+// SetClient replaces the client used by the functions of this package.
+func SetClient(c Client) {
+	clientMu.Lock()
+	defer clientMu.Unlock()
+	defaultClient = c
+}
+
+// DefaultClient returns the client used by the functions of this package.
+func DefaultClient() Client {
+	clientMu.RLock()
+	defer clientMu.RUnlock()
+	return defaultClient
+}
+
+// HTTPClient talks to a Kubo node through its HTTP RPC API.
+type HTTPClient struct {
+	api string
+	sh  *shell.Shell
+}
+
+// NewHTTPClient creates a client for the RPC API at api, either as host:port or as a
+// multiaddress. An empty api points to a local node.
+func NewHTTPClient(api string) *HTTPClient {
+	if api == "" {
+		api = DefaultAPI
+	}
+	return &HTTPClient{api: api, sh: shell.NewShell(api)}
+}
+
+// IsLocal returns true if the API is served from this machine.
+func (c *HTTPClient) IsLocal() bool {
+	host := c.api
+	if strings.HasPrefix(host, "/") {
+		// multiaddress such as /ip4/127.0.0.1/tcp/5001
+		parts := strings.Split(host, "/")
+		if len(parts) < 3 {
+			return false
+		}
+		host = parts[2]
+	} else if h, _, err := net.SplitHostPort(strings.TrimPrefix(strings.TrimPrefix(host, "http://"), "https://")); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (c *HTTPClient) Stat(ctx context.Context, cid string) (uint64, error) {
+	// Kubo 0.22 replaced Size with TotalSize in the output of dag stat
+	var out struct {
+		Size      uint64
+		TotalSize uint64
+	}
+	if err := c.sh.Request("dag/stat", cid).Option("progress", false).Exec(ctx, &out); err != nil {
+		return 0, err
+	}
+	if out.TotalSize > 0 {
+		return out.TotalSize, nil
+	}
+	return out.Size, nil
+}
+
+func (c *HTTPClient) Cat(ctx context.Context, cid string, offset int64) (io.ReadCloser, error) {
+	resp, err := c.sh.Request("cat", cid).Option("offset", offset).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		resp.Close()
+		return nil, resp.Error
+	}
+	return resp.Output, nil
+}
+
+func (c *HTTPClient) AddDir(ctx context.Context, dir string) (string, error) {
+	return c.sh.AddDir(dir)
+}
+
+func (c *HTTPClient) Unpin(ctx context.Context, cid string) error {
+	err := c.sh.Request("pin/rm", cid).Option("recursive", true).Exec(ctx, nil)
+	if err != nil && strings.Contains(err.Error(), "not pinned") {
+		return nil
+	}
+	return err
+}
+
+func (c *HTTPClient) List(ctx context.Context, cid string) (map[string]string, error) {
+	var out struct{ Objects []shell.LsObject }
+	if err := c.sh.Request("ls", cid).Exec(ctx, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Objects) != 1 {
+		return nil, errors.New("bad response from server")
+	}
+
+	result := make(map[string]string)
+	for _, link := range out.Objects[0].Links {
+		result[link.Name] = link.Hash
+	}
+	return result, nil
+}
+
+func (c *HTTPClient) Connect(ctx context.Context, addr string) error {
+	return c.sh.SwarmConnect(ctx, addr)
+}
+
+func (c *HTTPClient) ID(ctx context.Context) (string, error) {
+	var out shell.IdOutput
+	if err := c.sh.Request("id").Exec(ctx, &out); err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
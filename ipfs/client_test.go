@@ -0,0 +1,132 @@
+package ipfs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAPI serves canned responses for the RPC endpoints used by HTTPClient.
+func newTestAPI(t *testing.T) (*HTTPClient, *[]string) {
+	var connected []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v0/id", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ID":"12D3KooWTestPeer"}`))
+	})
+	mux.HandleFunc("/api/v0/ls", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "QmDir", r.URL.Query().Get("arg"))
+		w.Write([]byte(`{"Objects":[{"Hash":"QmDir","Links":[{"Name":"vocals.mp3","Hash":"QmVocals"},{"Name":"drums.mp3","Hash":"QmDrums"}]}]}`))
+	})
+	mux.HandleFunc("/api/v0/swarm/connect", func(w http.ResponseWriter, r *http.Request) {
+		connected = append(connected, r.URL.Query().Get("arg"))
+		w.Write([]byte(`{"Strings":["connect success"]}`))
+	})
+	mux.HandleFunc("/api/v0/dag/stat", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"TotalSize":1000256,"DagStats":[{"Cid":"QmFile","Size":1000256,"NumBlocks":5}]}`))
+	})
+	mux.HandleFunc("/api/v0/cat", func(w http.ResponseWriter, r *http.Request) {
+		content := "0123456789"
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		w.Write([]byte(content[offset:]))
+	})
+	mux.HandleFunc("/api/v0/pin/rm", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("arg") != "QmPinned" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"Message":"not pinned or pinned indirectly","Code":0,"Type":"error"}`))
+			return
+		}
+		w.Write([]byte(`{"Pins":["QmPinned"]}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(strings.TrimPrefix(server.URL, "http://")), &connected
+}
+
+func TestHTTPClient_ID(t *testing.T) {
+	client, _ := newTestAPI(t)
+
+	id, err := client.ID(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "12D3KooWTestPeer", id)
+}
+
+func TestHTTPClient_List(t *testing.T) {
+	client, _ := newTestAPI(t)
+
+	listing, err := client.List(context.Background(), "QmDir")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"vocals.mp3": "QmVocals", "drums.mp3": "QmDrums"}, listing)
+}
+
+func TestHTTPClient_Connect(t *testing.T) {
+	client, connected := newTestAPI(t)
+
+	require.NoError(t, client.Connect(context.Background(), "/ip4/10.0.0.2/tcp/4001/p2p/QmPeer"))
+	require.Equal(t, []string{"/ip4/10.0.0.2/tcp/4001/p2p/QmPeer"}, *connected)
+}
+
+func TestHTTPClient_Stat(t *testing.T) {
+	client, _ := newTestAPI(t)
+
+	size, err := client.Stat(context.Background(), "QmFile")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000256), size)
+}
+
+func TestHTTPClient_Cat(t *testing.T) {
+	client, _ := newTestAPI(t)
+
+	reader, err := client.Cat(context.Background(), "QmFile", 4)
+	require.NoError(t, err)
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "456789", string(content))
+}
+
+func TestHTTPClient_Unpin(t *testing.T) {
+	client, _ := newTestAPI(t)
+
+	require.NoError(t, client.Unpin(context.Background(), "QmPinned"))
+	// unpinning twice is fine
+	require.NoError(t, client.Unpin(context.Background(), "QmOther"))
+}
+
+func TestHTTPClient_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	client := NewHTTPClient(strings.TrimPrefix(server.URL, "http://"))
+
+	_, err := client.ID(context.Background())
+	require.Error(t, err)
+}
+
+func TestHTTPClient_IsLocal(t *testing.T) {
+	tests := []struct {
+		api      string
+		expected bool
+	}{
+		{"", true},
+		{"127.0.0.1:5001", true},
+		{"localhost:5001", true},
+		{"http://localhost:5001", true},
+		{"/ip4/127.0.0.1/tcp/5001", true},
+		{"[::1]:5001", true},
+		{"203.0.113.1:5001", false},
+		{"/ip4/10.0.0.2/tcp/5001", false},
+		{"ipfs.example.com:5001", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.api, func(t *testing.T) {
+			require.Equal(t, tt.expected, NewHTTPClient(tt.api).IsLocal())
+		})
+	}
+}
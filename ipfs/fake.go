@@ -0,0 +1,194 @@
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	gocid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+)
+
+var _ Client = (*Fake)(nil)
+
+// Fake is an in-memory stand-in for an IPFS node, for tests and for running a worker
+// without one. Files get the same CIDs a real node would give them, directories don't.
+type Fake struct {
+	mu     sync.Mutex
+	PeerID string
+	files  map[string][]byte
+	dirs   map[string]map[string]string
+	pins   map[string]bool
+	peers  []string
+}
+
+// NewFake creates an empty fake node.
+func NewFake() *Fake {
+	return &Fake{
+		PeerID: "12D3KooWFakePeer",
+		files:  make(map[string][]byte),
+		dirs:   make(map[string]map[string]string),
+		pins:   make(map[string]bool),
+	}
+}
+
+// AddFile stores content in the fake node and returns its CID.
+func (f *Fake) AddFile(content []byte) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addFile(content)
+}
+
+// Peers returns the addresses the node was asked to connect to.
+func (f *Fake) Peers() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.peers...)
+}
+
+func (f *Fake) Stat(ctx context.Context, cid string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.size(cid)
+}
+
+func (f *Fake) Cat(ctx context.Context, cid string, offset int64) (io.ReadCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	content, ok := f.files[cid]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found", cid)
+	}
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	return io.NopCloser(bytes.NewReader(content[offset:])), nil
+}
+
+func (f *Fake) AddDir(ctx context.Context, dir string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cid, err := f.addDir(dir)
+	if err != nil {
+		return "", err
+	}
+	f.pins[cid] = true
+	return cid, nil
+}
+
+func (f *Fake) Unpin(ctx context.Context, cid string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.pins, cid)
+	return nil
+}
+
+// Pinned returns true if cid is pinned.
+func (f *Fake) Pinned(cid string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.pins[cid]
+}
+
+func (f *Fake) List(ctx context.Context, cid string) (map[string]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// like on a real node, a file has no named entries
+	if _, ok := f.files[cid]; ok {
+		return map[string]string{}, nil
+	}
+	entries, ok := f.dirs[cid]
+	if !ok {
+		return nil, fmt.Errorf("directory %s not found", cid)
+	}
+	result := make(map[string]string, len(entries))
+	for name, entry := range entries {
+		result[name] = entry
+	}
+	return result, nil
+}
+
+func (f *Fake) Connect(ctx context.Context, addr string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.peers = append(f.peers, addr)
+	return nil
+}
+
+func (f *Fake) ID(ctx context.Context) (string, error) {
+	return f.PeerID, nil
+}
+
+func (f *Fake) addFile(content []byte) string {
+	cid, _ := ReaderCID(bytes.NewReader(content))
+	f.files[cid] = content
+	return cid
+}
+
+func (f *Fake) addDir(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	links := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			links[entry.Name()], err = f.addDir(path)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		links[entry.Name()] = f.addFile(content)
+	}
+
+	// a directory is identified by the names and CIDs of its entries
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var listing strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&listing, "%s=%s\n", name, links[name])
+	}
+	hash, _ := mh.Sum([]byte(listing.String()), mh.SHA2_256, -1)
+	cid := gocid.NewCidV1(gocid.Raw, hash).String()
+	f.dirs[cid] = links
+	return cid, nil
+}
+
+func (f *Fake) size(cid string) (uint64, error) {
+	if content, ok := f.files[cid]; ok {
+		return uint64(len(content)), nil
+	}
+
+	entries, ok := f.dirs[cid]
+	if !ok {
+		return 0, fmt.Errorf("%s not found", cid)
+	}
+	var total uint64
+	for _, entry := range entries {
+		size, err := f.size(entry)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
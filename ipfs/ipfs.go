@@ -1,23 +1,86 @@
 package ipfs
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strings"
+	"sort"
 	"time"
 
-	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/janction/audioStem/audioStemLogger"
 )
 
-func IPFSGet(cid string, path string) error {
+// ErrInputTooLarge is returned when the content to download is bigger than allowed.
+var ErrInputTooLarge = errors.New("input exceeds the maximum size")
+
+// DownloadOptions bounds a download and reports its progress.
+type DownloadOptions struct {
+	// Timeout bounds the whole download. Zero means no timeout.
+	Timeout time.Duration
+	// MaxSize is the largest DAG, in bytes, that is downloaded. Zero means no limit.
+	MaxSize uint64
+	// Progress is called with the bytes downloaded so far and the size of the DAG, at
+	// most once every tenth of the download and once it completes.
+	Progress func(downloaded, total uint64)
+}
+
+// IPFSGet downloads the file cid into path/cid. Bytes are written to path/cid.part first,
+// so a download that was interrupted, even by a restart, resumes where it stopped.
+func IPFSGet(ctx context.Context, cid string, path string, opts DownloadOptions) error {
+	return get(ctx, cid, path, cid, opts)
+}
+
+// GetInput downloads the input of the thread at index of a task with input cid into
+// path/cid, resuming interrupted downloads like IPFSGet does.
+func GetInput(ctx context.Context, cid string, index uint32, path string, opts DownloadOptions) error {
+	if _, err := os.Stat(filepath.Join(path, cid)); err == nil {
+		audioStemLogger.Logger.Info("IPFS file %s already downloaded", cid)
+		return nil
+	}
+
+	file, err := ResolveInput(ctx, cid, index)
+	if err != nil {
+		audioStemLogger.Logger.Error("Error Downloading IPFS %s: %s", cid, err.Error())
+		return err
+	}
+	return get(ctx, file, path, cid, opts)
+}
+
+// ResolveInput returns the CID of the file the thread at index of a task works on. The
+// inputs of a task are added as a directory, whose files are taken by the threads in the
+// order of their names, while the input of a task with a single file can be the file itself.
+func ResolveInput(ctx context.Context, cid string, index uint32) (string, error) {
+	entries, err := DefaultClient().List(ctx, cid)
+	if err != nil {
+		return "", fmt.Errorf("unable to list %s: %w", cid, err)
+	}
+
+	// the links of a file are its chunks, which have no name
+	delete(entries, "")
+	if len(entries) == 0 {
+		if index > 0 {
+			return "", fmt.Errorf("%s is a single file, it has no input %d", cid, index)
+		}
+		return cid, nil
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	if int(index) >= len(names) {
+		return "", fmt.Errorf("%s has %d files, it has no input %d", cid, len(names), index)
+	}
+	sort.Strings(names)
+	return entries[names[index]], nil
+}
+
+// get downloads the file cid into path/name.
+func get(ctx context.Context, cid string, path string, name string, opts DownloadOptions) error {
 	audioStemLogger.Logger.Info("IPFS Downloading started for %s at %s", cid, path)
 	err := os.MkdirAll(path, os.ModePerm)
 	if err != nil {
@@ -25,12 +88,23 @@ func IPFSGet(cid string, path string) error {
 		return err
 	}
 
-	// Connect to the local IPFS node (ensure IPFS is running on localhost:5001)
-	sh := shell.NewShell("127.0.0.1:5001")
+	target := filepath.Join(path, name)
+	if _, err := os.Stat(target); err == nil {
+		audioStemLogger.Logger.Info("IPFS file %s already downloaded", cid)
+		return nil
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
 
-	// Download the file from IPFS using the CID
-	err = sh.Get(cid, path)
+	err = download(ctx, cid, target, opts)
 	if err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("%w: %v", ctx.Err(), err)
+		}
 		audioStemLogger.Logger.Error("Error Downloading IPFS %s: %s", cid, err.Error())
 		return err
 	}
@@ -39,7 +113,73 @@ func IPFSGet(cid string, path string) error {
 	return nil
 }
 
-// CalculateCIDs recursively computes the CIDs of a directory and its contents using `ipfs add --only-hash --recursive`
+func download(ctx context.Context, cid, target string, opts DownloadOptions) error {
+	client := DefaultClient()
+	total, err := client.Stat(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("unable to stat %s: %w", cid, err)
+	}
+	if opts.MaxSize > 0 && total > opts.MaxSize {
+		return fmt.Errorf("%w: %s is %d bytes, the limit is %d", ErrInputTooLarge, cid, total, opts.MaxSize)
+	}
+
+	part := target + ".part"
+	file, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+	if offset > 0 {
+		audioStemLogger.Logger.Info("Resuming download of %s from byte %d", cid, offset)
+	}
+
+	reader, err := client.Cat(ctx, cid, offset)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	progress := &progressWriter{w: file, downloaded: uint64(offset), total: total, report: opts.Progress}
+	if _, err := io.Copy(progress, reader); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if opts.Progress != nil {
+		opts.Progress(progress.downloaded, total)
+	}
+	return os.Rename(part, target)
+}
+
+// progressWriter counts the bytes written through it and reports them every tenth of
+// the total.
+type progressWriter struct {
+	w          io.Writer
+	downloaded uint64
+	total      uint64
+	reported   uint64
+	report     func(downloaded, total uint64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.downloaded += uint64(n)
+	if p.report != nil && p.downloaded < p.total && p.downloaded-p.reported >= p.total/10 {
+		p.reported = p.downloaded
+		p.report(p.downloaded, p.total)
+	}
+	return n, err
+}
+
+// CalculateCIDs recursively computes the CIDs of the files of a directory. CIDs are computed
+// in-process, the same way `ipfs add` would, so no IPFS node is needed.
 func CalculateCIDs(dirPath string) (map[string]string, error) {
 	audioStemLogger.Logger.Debug("Calculating CID for directory %s", dirPath)
 	cidMap := make(map[string]string)
@@ -58,22 +198,16 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 			return nil
 		}
 
-		// Execute the IPFS add command with -Q and --only-hash to get the CID
-		cmd := exec.Command("ipfs", "add", "-Q", "--only-hash", path)
 		audioStemLogger.Logger.Debug("Calculating CID for file %s", path)
-		var out bytes.Buffer
-		cmd.Stdout = &out
-		cmd.Stderr = &out
-
-		if err := cmd.Run(); err != nil {
-			fail := fmt.Errorf("failed to calculate CID for %s: %s, %w", path, out.String(), err)
+		cid, err := FileCID(path)
+		if err != nil {
+			fail := fmt.Errorf("failed to calculate CID for %s: %w", path, err)
 			audioStemLogger.Logger.Error(fail.Error())
 			return fail
 		}
 
 		// Extract only the file name and add the result to the map
 		fileName := filepath.Base(path)
-		cid := strings.TrimSpace(out.String())
 		audioStemLogger.Logger.Debug("for file %s we got %s", path, cid)
 		cidMap[fileName] = cid
 		return nil
@@ -87,9 +221,6 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 }
 
 func UploadSolution(ctx context.Context, rootPath, threadId, cidFile string) (string, error) {
-	// Connect to the IPFS daemon
-	sh := shell.NewShell("localhost:5001") // Replace with your IPFS API address
-
 	// Construct the path to the thread's output files
 	threadOutputPath := filepath.Join(rootPath, "audioStems", threadId, "htdemucs", cidFile)
 
@@ -106,7 +237,7 @@ func UploadSolution(ctx context.Context, rootPath, threadId, cidFile string) (st
 		return "", fail
 	}
 
-	cid, err := sh.AddDir(threadOutputPath)
+	cid, err := DefaultClient().AddDir(ctx, threadOutputPath)
 	if err != nil {
 		fail := fmt.Errorf("failed to upload files for threadId %s: %w", threadId, err)
 		audioStemLogger.Logger.Error(fail.Error())
@@ -116,32 +247,16 @@ func UploadSolution(ctx context.Context, rootPath, threadId, cidFile string) (st
 	return cid, nil
 }
 
-// CheckIPFSStatus pings the IPFS daemon to check if it's running
+// CheckIPFSStatus pings the IPFS node to check if it's running
 func CheckIPFSStatus() error {
-	client := http.Client{
-		Timeout: 2 * time.Second, // Set timeout to avoid long waits
-	}
-
-	req, err := http.NewRequest("POST", "http://localhost:5001/api/v0/id", nil) // Use POST
-	if err != nil {
-		fail := fmt.Errorf("failed to create request: %v", err)
-		audioStemLogger.Logger.Error(fail.Error())
-		return fail
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second) // Set timeout to avoid long waits
+	defer cancel()
 
-	resp, err := client.Do(req)
-	if err != nil {
+	if _, err := DefaultClient().ID(ctx); err != nil {
 		fail := fmt.Errorf("IPFS node unreachable: %v", err)
 		audioStemLogger.Logger.Error(fail.Error())
 		return fail
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		fail := fmt.Errorf("IPFS node returned non-200 status: %d", resp.StatusCode)
-		audioStemLogger.Logger.Error(fail.Error())
-		return fail
-	}
 
 	audioStemLogger.Logger.Info("✅ IPFS node is running")
 	return nil
@@ -164,10 +279,15 @@ func StartIPFS() error {
 	return nil
 }
 
-// EnsureIPFSRunning checks and starts IPFS if needed
+// EnsureIPFSRunning checks and starts IPFS if needed. The daemon can only be started when
+// the API is served from this machine and the ipfs binary is installed.
 func EnsureIPFSRunning() {
 	err := CheckIPFSStatus()
 	if err != nil {
+		if !canStartIPFS() {
+			audioStemLogger.Logger.Error("⚠️ IPFS not running and it can't be started from this node")
+			return
+		}
 		audioStemLogger.Logger.Info("⚠️ IPFS not running. Attempting to start...")
 		startErr := StartIPFS()
 		if startErr != nil {
@@ -178,100 +298,57 @@ func EnsureIPFSRunning() {
 	}
 }
 
-// ListDirectory runs `ipfs ls {cid}` and returns a map[filename]CID with a 4s timeout.
+func canStartIPFS() bool {
+	client, ok := DefaultClient().(*HTTPClient)
+	if !ok || !client.IsLocal() {
+		return false
+	}
+	_, err := exec.LookPath("ipfs")
+	return err == nil
+}
+
+// ListDirectory returns a map[filename]CID of the directory with a 4s timeout.
 func ListDirectory(cid string) (map[string]string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "ipfs", "ls", cid) // Use context for timeout
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	err := cmd.Run()
+	result, err := DefaultClient().List(ctx, cid)
 	if ctx.Err() == context.DeadlineExceeded {
-		fail := fmt.Errorf("timeout: ipfs ls command took too long")
+		fail := fmt.Errorf("timeout: ipfs ls took too long")
 		audioStemLogger.Logger.Error(fail.Error())
 		return nil, fail
 	}
 	if err != nil {
-		fail := fmt.Errorf("failed to execute ipfs ls: %v", err)
-		audioStemLogger.Logger.Error(fail.Error())
-		return nil, fail
-	}
-
-	result := make(map[string]string)
-	scanner := bufio.NewScanner(&out)
-
-	for scanner.Scan() {
-		fields := strings.Fields(scanner.Text())
-		if len(fields) < 3 {
-			continue // Ensure it has CID, size, and filename
-		}
-		cid := fields[0]
-		filename := fields[2]
-		result[filename] = cid
-	}
-
-	if err := scanner.Err(); err != nil {
-		fail := fmt.Errorf("error reading command output: %v", err)
+		fail := fmt.Errorf("failed to list %s: %v", cid, err)
 		audioStemLogger.Logger.Error(fail.Error())
 		return nil, fail
 	}
-
 	return result, nil
 }
 
 // Function to connect to IPFS nodes
 func ConnectToIPFSNode(ip, peerId string) {
 	seed, _ := GenerateSwarmConnectURL(ip, peerId)
-	cmd := exec.Command("ipfs", "swarm", "connect", seed)
-	output, err := cmd.CombinedOutput()
+	err := DefaultClient().Connect(context.Background(), seed)
 	if err != nil {
-		audioStemLogger.Logger.Error("Failed to connect to %s: %v\nOutput: %s", seed, err, output)
+		audioStemLogger.Logger.Error("Failed to connect to %s: %v", seed, err)
 	} else {
 		audioStemLogger.Logger.Info("Connected to IPFS node: %s\n", seed)
 	}
 }
 
-// IPFSIDResponse represents the structure of the `ipfs id` JSON response.
-type IPFSIDResponse struct {
-	ID string `json:"ID"`
-}
-
-// GetIPFSPeerID runs `ipfs id` and extracts the Peer ID.
+// GetIPFSPeerID returns the Peer ID of the IPFS node.
 func GetIPFSPeerID() (string, error) {
-	cmd := exec.Command("ipfs", "id")
-	output, err := cmd.Output()
+	id, err := DefaultClient().ID(context.Background())
 	if err != nil {
-		fail := fmt.Errorf("failed to run ipfs id: %w", err)
-		audioStemLogger.Logger.Error(fail.Error())
-		return "", fail
-	}
-
-	var response IPFSIDResponse
-	if err := json.Unmarshal(output, &response); err != nil {
-		fail := fmt.Errorf("failed to parse ipfs id output: %w", err)
+		fail := fmt.Errorf("failed to get ipfs id: %w", err)
 		audioStemLogger.Logger.Error(fail.Error())
 		return "", fail
 	}
-
-	return response.ID, nil
+	return id, nil
 }
 
 // GenerateSwarmConnectURL creates the full IPFS swarm connect URL.
 func GenerateSwarmConnectURL(ip, peerID string) (string, error) {
 	return fmt.Sprintf("/ip4/%s/tcp/4001/p2p/%s", ip, peerID), nil
 }
-
-// Checks at the specified path if a file exists. This path will be tipically
-// .janctiond/renders/[threadId]. If IPFS started downloading a file, a temp file will exists
-// if it is empty, it is safe to assume download hasn't started and probably won't
-func IsDownloadStarted(dir string) bool {
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		return false // directory doesn't exist or isn't readable
-	}
-
-	return len(entries) > 0 // true if there's at least one file or subdir
-}
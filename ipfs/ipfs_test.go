@@ -1,68 +1,45 @@
 package ipfs
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"errors"
-	"fmt"
 	"io"
-	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"reflect"
 	"testing"
 	"time"
 
-	"bou.ke/monkey"
-	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestIPFSGet_Success(t *testing.T) {
-	// Patch IPFS shell Get method to simulate success
-	patch := monkey.Patch((*shell.Shell).Get, func(_ *shell.Shell, cid string, outDir string) error {
-		return nil
-	})
-	defer patch.Unpatch()
-
-	// Call IPFSGet with a valid CID and path
-	err := IPFSGet("validCID", "/tmp/testpath_success")
-	if err != nil {
-		t.Errorf("Expected no error, got: %v", err)
-	}
+// useClient makes c the default client for the duration of the test.
+func useClient(t *testing.T, c Client) {
+	previous := DefaultClient()
+	SetClient(c)
+	t.Cleanup(func() { SetClient(previous) })
 }
 
-func TestIPFSGet_IPFSError(t *testing.T) {
-	// Patch IPFS shell Get method to simulate failure
-	patch := monkey.Patch((*shell.Shell).Get, func(_ *shell.Shell, cid string, outDir string) error {
-		return errors.New("mock IPFS error")
-	})
-	defer patch.Unpatch()
-
-	// Call IPFSGet with an invalid CID
-	err := IPFSGet("invalidCID", "/tmp/testpath_error")
-	if err == nil {
-		t.Error("Expected an error but got nil")
-	}
-}
+// failingClient is a client whose node is unreachable.
+type failingClient struct{}
 
-func TestIPFSGet_MkdirError(t *testing.T) {
-	// Patch os.MkdirAll to simulate failure
-	patch := monkey.Patch(os.MkdirAll, func(path string, perm os.FileMode) error {
-		return fmt.Errorf("mock mkdir error")
-	})
-	defer patch.Unpatch()
-
-	err := IPFSGet("anyCID", "/tmp/testpath_mkdirfail")
-	if err == nil || err.Error() != "mock mkdir error" {
-		t.Errorf("Expected mkdir error, got: %v", err)
-	}
+var errUnreachable = errors.New("node unreachable")
+
+func (failingClient) Stat(ctx context.Context, cid string) (uint64, error) {
+	return 0, errUnreachable
+}
+func (failingClient) Cat(ctx context.Context, cid string, offset int64) (io.ReadCloser, error) {
+	return nil, errUnreachable
 }
+func (failingClient) AddDir(ctx context.Context, dir string) (string, error) {
+	return "", errUnreachable
+}
+func (failingClient) Unpin(ctx context.Context, cid string) error { return errUnreachable }
+func (failingClient) List(ctx context.Context, cid string) (map[string]string, error) {
+	return nil, errUnreachable
+}
+func (failingClient) Connect(ctx context.Context, addr string) error { return errUnreachable }
+func (failingClient) ID(ctx context.Context) (string, error)         { return "", errUnreachable }
 
 func createTempFiles(t *testing.T) (string, map[string]string) {
 	dir := t.TempDir()
@@ -77,525 +54,281 @@ func createTempFiles(t *testing.T) (string, map[string]string) {
 	return dir, files
 }
 
-func fakeExecCommand(output string) *exec.Cmd {
-	return &exec.Cmd{
-		Path:   "/bin/echo",
-		Args:   []string{"echo", output},
-		Stdout: &bytes.Buffer{},
-		Stderr: &bytes.Buffer{},
-	}
+func TestIPFSGet_Success(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	cid := node.AddFile([]byte("audio"))
+
+	dir := t.TempDir()
+	require.NoError(t, IPFSGet(context.Background(), cid, dir, DownloadOptions{}))
+
+	content, err := os.ReadFile(filepath.Join(dir, cid))
+	require.NoError(t, err)
+	require.Equal(t, "audio", string(content))
+	require.NoFileExists(t, filepath.Join(dir, cid+".part"))
 }
 
-func fakeExecCommandWithError(stderr string) *exec.Cmd {
-	cmd := exec.Command("false")
-	var out bytes.Buffer
-	out.WriteString(stderr)
-	cmd.Stdout = &out
-	cmd.Stderr = &out
-	return cmd
+func TestIPFSGet_IPFSError(t *testing.T) {
+	useClient(t, NewFake())
+
+	err := IPFSGet(context.Background(), "missingCID", t.TempDir(), DownloadOptions{})
+	require.Error(t, err)
 }
 
-func TestCalculateCIDs_Success(t *testing.T) {
-	// Create temporary files and expected contents for testing
-	dir, fileContents := createTempFiles(t)
+func TestIPFSGet_MkdirError(t *testing.T) {
+	useClient(t, NewFake())
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0644))
 
-	// Patch exec.Command to simulate the behavior of the IPFS command
-	patch := monkey.Patch(exec.Command, func(name string, args ...string) *exec.Cmd {
-		// Get the file path from the last argument
-		path := args[len(args)-1]
+	err := IPFSGet(context.Background(), "anyCID", filepath.Join(file, "dir"), DownloadOptions{})
+	require.Error(t, err)
+}
 
-		// Read the file to simulate CID calculation
-		content, err := os.ReadFile(path)
-		if err != nil {
-			// Simulate command failure if the file doesn't exist
-			return fakeExecCommandWithError("file not found")
-		}
+func TestIPFSGet_TooLarge(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	cid := node.AddFile(make([]byte, 100))
 
-		// Simulate CID by hashing the content and truncating the result
-		hash := sha256.Sum256(content)
-		cid := hex.EncodeToString(hash[:])[:10]
-		return fakeExecCommand(cid)
-	})
-	defer patch.Unpatch()
-
-	// Run the function under test
-	cidMap, err := CalculateCIDs(dir)
-	assert.NoError(t, err)
-
-	// Compare the expected CIDs (from hashes) with the result
-	for name, content := range fileContents {
-		hash := sha256.Sum256([]byte(content))
-		expectedCID := hex.EncodeToString(hash[:])[:10]
-		assert.Equal(t, expectedCID, cidMap[name])
-	}
+	dir := t.TempDir()
+	err := IPFSGet(context.Background(), cid, dir, DownloadOptions{MaxSize: 99})
+	require.ErrorIs(t, err, ErrInputTooLarge)
+	require.NoFileExists(t, filepath.Join(dir, cid))
 }
 
-func TestCalculateCIDs_DirectoryWalkError(t *testing.T) {
-	// This patch forces filepath.Walk to return an error on access
-	patch := monkey.Patch(filepath.Walk, func(root string, walkFn filepath.WalkFunc) error {
-		return walkFn("/badpath", nil, fmt.Errorf("mock walk error"))
-	})
-	defer patch.Unpatch()
+func TestIPFSGet_Resume(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	content := []byte("0123456789")
+	cid := node.AddFile(content)
+
+	// a previous download stopped after 4 bytes
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, cid+".part"), content[:4], 0644))
+
+	var reported []uint64
+	opts := DownloadOptions{Progress: func(downloaded, total uint64) {
+		require.Equal(t, uint64(len(content)), total)
+		reported = append(reported, downloaded)
+	}}
+	require.NoError(t, IPFSGet(context.Background(), cid, dir, opts))
+
+	downloaded, err := os.ReadFile(filepath.Join(dir, cid))
+	require.NoError(t, err)
+	require.Equal(t, content, downloaded)
+	require.Equal(t, []uint64{10}, reported)
+}
 
-	cidMap, err := CalculateCIDs("/somepath")
+func TestIPFSGet_AlreadyDownloaded(t *testing.T) {
+	useClient(t, failingClient{})
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cid"), []byte("audio"), 0644))
+
+	require.NoError(t, IPFSGet(context.Background(), "cid", dir, DownloadOptions{}))
+}
 
-	assert.Error(t, err)
-	assert.Nil(t, cidMap)
+func TestResolveInput(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	dir, files := createTempFiles(t)
+	dirCid, err := node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+
+	// the files of a directory are the inputs of the threads in the order of their names
+	for i, name := range []string{"file1.txt", "file2.txt"} {
+		cid, err := ResolveInput(context.Background(), dirCid, uint32(i))
+		require.NoError(t, err)
+		require.Equal(t, node.AddFile([]byte(files[name])), cid)
+	}
+	_, err = ResolveInput(context.Background(), dirCid, 2)
+	require.Error(t, err)
+
+	// a single file is the input of the only thread
+	fileCid := node.AddFile([]byte("audio"))
+	cid, err := ResolveInput(context.Background(), fileCid, 0)
+	require.NoError(t, err)
+	require.Equal(t, fileCid, cid)
+	_, err = ResolveInput(context.Background(), fileCid, 1)
+	require.Error(t, err)
 }
 
-func TestCalculateCIDs_CommandExecutionFails(t *testing.T) {
+func TestGetInput(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
 	dir, _ := createTempFiles(t)
+	dirCid, err := node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+
+	// the input is saved under the CID of the task, where the work on it expects it
+	path := t.TempDir()
+	require.NoError(t, GetInput(context.Background(), dirCid, 1, path, DownloadOptions{}))
+	content, err := os.ReadFile(filepath.Join(path, dirCid))
+	require.NoError(t, err)
+	require.Equal(t, "file2 content", string(content))
+}
 
-	// Patch exec.Command to simulate a command that always fails
-	patch := monkey.Patch(exec.Command, func(name string, args ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path:   "/bin/false",
-			Args:   []string{"false"},
-			Stdout: &bytes.Buffer{},
-			Stderr: bytes.NewBufferString("simulated command failure"),
-		}
-	})
-	defer patch.Unpatch()
+func TestIPFSGet_Timeout(t *testing.T) {
+	node := NewFake()
+	useClient(t, &slowClient{Fake: node})
+	cid := node.AddFile([]byte("audio"))
 
-	_, err := CalculateCIDs(dir)
-	assert.Error(t, err)
+	err := IPFSGet(context.Background(), cid, t.TempDir(), DownloadOptions{Timeout: 10 * time.Millisecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
-func TestUploadSolution_Success(t *testing.T) {
-	dir := t.TempDir()
-	threadId := "thread123"
-	threadOutputPath := filepath.Join(dir, "audioStems", threadId, "htdemucs")
+// slowClient is a node that never sends any content.
+type slowClient struct {
+	*Fake
+}
 
-	// Setup valid output directory and dummy file
-	require.NoError(t, os.MkdirAll(threadOutputPath, 0755))
-	require.NoError(t, os.WriteFile(filepath.Join(threadOutputPath, "result.png"), []byte("fake image content"), 0644))
+func (c *slowClient) Cat(ctx context.Context, cid string, offset int64) (io.ReadCloser, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
 
-	// Patch AddDir to simulate success
-	patch := monkey.Patch((*shell.Shell).AddDir, func(s *shell.Shell, path string, opts ...func(*shell.RequestBuilder) error) (string, error) {
-		return "QmFakeCID123", nil
-	})
-	defer patch.Unpatch()
+func TestProgressWriter(t *testing.T) {
+	var reported []uint64
+	writer := &progressWriter{w: io.Discard, total: 100, report: func(downloaded, total uint64) {
+		reported = append(reported, downloaded)
+	}}
+	for i := 0; i < 20; i++ {
+		_, err := writer.Write(make([]byte, 5))
+		require.NoError(t, err)
+	}
+	// every tenth, but not the end, which is reported once the file is complete
+	require.Equal(t, []uint64{10, 20, 30, 40, 50, 60, 70, 80, 90}, reported)
+}
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
-	assert.NoError(t, err)
-	assert.Equal(t, "QmFakeCID123", cid)
+func TestCalculateCIDs_Success(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	dir, files := createTempFiles(t)
+
+	cids, err := CalculateCIDs(dir)
+	require.NoError(t, err)
+	require.Len(t, cids, len(files))
+	for name, content := range files {
+		require.Equal(t, node.AddFile([]byte(content)), cids[name])
+	}
 }
 
-func TestUploadSolution_PathDoesNotExist(t *testing.T) {
-	dir := t.TempDir()
-	threadId := "nonexistent"
+func TestCalculateCIDs_DirectoryWalkError(t *testing.T) {
+	useClient(t, NewFake())
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
-	assert.Error(t, err)
-	assert.Empty(t, cid)
-	assert.Contains(t, err.Error(), "failed to access thread output path")
+	_, err := CalculateCIDs(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
 }
 
-func TestUploadSolution_PathIsNotDirectory(t *testing.T) {
+func TestCalculateCIDs_WithoutNode(t *testing.T) {
+	useClient(t, failingClient{})
 	dir := t.TempDir()
-	threadId := "thread123"
-	renderPath := filepath.Join(dir, "audioStems", threadId)
-	require.NoError(t, os.MkdirAll(renderPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hello world\n"), 0644))
 
-	// Create a file instead of a directory at output path
-	outputFilePath := filepath.Join(renderPath, "htdemucs")
-	require.NoError(t, os.WriteFile(outputFilePath, []byte("not a dir"), 0644))
+	cids, err := CalculateCIDs(dir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"hello.txt": "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"}, cids)
+}
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
-	assert.Error(t, err)
-	assert.Empty(t, cid)
-	assert.Contains(t, err.Error(), "thread output path is not a directory")
+func TestUploadSolution_Success(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	rootPath := t.TempDir()
+	output := filepath.Join(rootPath, "audioStems", "thread1", "htdemucs", "song")
+	require.NoError(t, os.MkdirAll(output, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(output, "vocals.mp3"), []byte("vocals"), 0644))
+
+	cid, err := UploadSolution(context.Background(), rootPath, "thread1", "song")
+	require.NoError(t, err)
+
+	listing, err := node.List(context.Background(), cid)
+	require.NoError(t, err)
+	require.Equal(t, node.AddFile([]byte("vocals")), listing["vocals.mp3"])
 }
 
-func TestUploadSolution_AddDirFails(t *testing.T) {
-	dir := t.TempDir()
-	threadId := "thread123"
-	threadOutputPath := filepath.Join(dir, "audioStems", threadId, "htdemucs")
-
-	require.NoError(t, os.MkdirAll(threadOutputPath, 0755))
-	require.NoError(t, os.WriteFile(filepath.Join(threadOutputPath, "result.png"), []byte("dummy"), 0644))
-
-	// Patch AddDir to simulate error
-	patch := monkey.Patch((*shell.Shell).AddDir, func(s *shell.Shell, path string, opts ...func(*shell.RequestBuilder) error) (string, error) {
-		return "", errors.New("upload failed")
-	})
-	defer patch.Unpatch()
-
-	cid, err := UploadSolution(context.Background(), dir, threadId)
-	assert.Error(t, err)
-	assert.Empty(t, cid)
-	assert.Contains(t, err.Error(), "failed to upload files")
-}
-
-func TestCheckIPFSStatus_Success(t *testing.T) {
-	patch := monkey.PatchInstanceMethod(
-		reflect.TypeOf(http.DefaultTransport.(*http.Transport)),
-		"RoundTrip",
-		func(rt *http.Transport, req *http.Request) (*http.Response, error) {
-			if req.Method == "POST" && req.URL.String() == "http://localhost:5001/api/v0/id" {
-				return &http.Response{
-					StatusCode: http.StatusOK,
-					Body:       io.NopCloser(bytes.NewBufferString(`{"ID": "mockID"}`)),
-					Header:     make(http.Header),
-					Request:    req,
-				}, nil
-			}
-			return nil, fmt.Errorf("unexpected request")
-		},
-	)
-	defer patch.Unpatch()
-
-	err := CheckIPFSStatus()
-	assert.NoError(t, err)
-}
-
-func TestCheckIPFSStatus_BadRequest(t *testing.T) {
-	patch := monkey.PatchInstanceMethod(
-		reflect.TypeOf(http.DefaultTransport.(*http.Transport)),
-		"RoundTrip",
-		func(rt *http.Transport, req *http.Request) (*http.Response, error) {
-			return &http.Response{
-				StatusCode: http.StatusBadRequest,
-				Body:       io.NopCloser(bytes.NewBufferString("Bad Request")),
-				Header:     make(http.Header),
-				Request:    req,
-			}, nil
-		},
-	)
-	defer patch.Unpatch()
+func TestUploadSolution_PathDoesNotExist(t *testing.T) {
+	useClient(t, NewFake())
 
-	err := CheckIPFSStatus()
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "non-200")
+	_, err := UploadSolution(context.Background(), t.TempDir(), "thread1", "song")
+	require.Error(t, err)
 }
 
-func TestCheckIPFSStatus_RequestCreationFails(t *testing.T) {
-	patch := monkey.Patch(http.NewRequest, func(method, url string, body io.Reader) (*http.Request, error) {
-		return nil, fmt.Errorf("mock NewRequest error")
-	})
-	defer patch.Unpatch()
-
-	err := CheckIPFSStatus()
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to create request")
+func TestUploadSolution_PathIsNotDirectory(t *testing.T) {
+	useClient(t, NewFake())
+	rootPath := t.TempDir()
+	output := filepath.Join(rootPath, "audioStems", "thread1", "htdemucs")
+	require.NoError(t, os.MkdirAll(output, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(output, "song"), nil, 0644))
+
+	_, err := UploadSolution(context.Background(), rootPath, "thread1", "song")
+	require.ErrorContains(t, err, "not a directory")
 }
 
-func TestCheckIPFSStatus_ClientDoFails(t *testing.T) {
-	patch := monkey.PatchInstanceMethod(
-		reflect.TypeOf(http.DefaultTransport.(*http.Transport)),
-		"RoundTrip",
-		func(rt *http.Transport, req *http.Request) (*http.Response, error) {
-			return nil, fmt.Errorf("mock Do error")
-		},
-	)
-	defer patch.Unpatch()
+func TestUploadSolution_AddDirFails(t *testing.T) {
+	useClient(t, failingClient{})
+	rootPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(rootPath, "audioStems", "thread1", "htdemucs", "song"), 0755))
 
-	err := CheckIPFSStatus()
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "unreachable")
+	_, err := UploadSolution(context.Background(), rootPath, "thread1", "song")
+	require.ErrorIs(t, err, errUnreachable)
 }
 
-func TestStartIPFS(t *testing.T) {
-	// Happy path: simulate successful start (Start returns nil)
-	t.Run("happy path", func(t *testing.T) {
-		// Patch the Start method of *exec.Cmd to always return nil (success)
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Start", func(cmd *exec.Cmd) error {
-			return nil
-		})
-		defer patch.Unpatch()
+func TestCheckIPFSStatus(t *testing.T) {
+	useClient(t, NewFake())
+	require.NoError(t, CheckIPFSStatus())
 
-		err := StartIPFS()
-		assert.NoError(t, err)
-	})
-
-	// Negative path: simulate failure (Start returns an error)
-	t.Run("failure", func(t *testing.T) {
-		// Patch the Start method of *exec.Cmd to simulate an error when starting the daemon
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Start", func(cmd *exec.Cmd) error {
-			return fmt.Errorf("mock start error")
-		})
-		defer patch.Unpatch()
-
-		err := StartIPFS()
-		assert.Error(t, err)
-	})
-}
-
-func TestEnsureIPFSRunning(t *testing.T) {
-	// Test case: IPFS is already running
-	t.Run("IPFS is already running", func(t *testing.T) {
-		// Mock CheckIPFSStatus to simulate a running IPFS daemon by returning nil
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
-			return nil
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to detect if it's called when it shouldn't be
-		patchStart := monkey.Patch(StartIPFS, func() error {
-			t.Error("StartIPFS should not be called when IPFS is already running")
-			return nil
-		})
-		defer patchStart.Unpatch()
-
-		EnsureIPFSRunning()
-	})
-
-	// Test case: IPFS is not running, and StartIPFS succeeds
-	t.Run("IPFS not running, StartIPFS succeeds", func(t *testing.T) {
-		checkCalls := 0
-		startCalls := 0
-
-		// Mock CheckIPFSStatus to simulate a stopped IPFS daemon by returning an error
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
-			checkCalls++
-			return fmt.Errorf("IPFS is not running")
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to simulate successful start by returning nil
-		patchStart := monkey.Patch(StartIPFS, func() error {
-			startCalls++
-			return nil
-		})
-		defer patchStart.Unpatch()
-
-		EnsureIPFSRunning()
-
-		// Verify that CheckIPFSStatus was called once
-		if checkCalls != 1 {
-			t.Errorf("Expected CheckIPFSStatus to be called once, but it was called %d times", checkCalls)
-		}
-		// Verify that StartIPFS was called once
-		if startCalls != 1 {
-			t.Errorf("Expected StartIPFS to be called once, but it was called %d times", startCalls)
-		}
-	})
-
-	// Test case: IPFS is not running, and StartIPFS fails
-	t.Run("IPFS not running, StartIPFS fails", func(t *testing.T) {
-		checkCalls := 0
-		startCalls := 0
-
-		// Mock CheckIPFSStatus to simulate a stopped IPFS daemon by returning an error
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
-			checkCalls++
-			return fmt.Errorf("IPFS is not running")
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to simulate a failure in starting by returning an error
-		patchStart := monkey.Patch(StartIPFS, func() error {
-			startCalls++
-			return fmt.Errorf("Failed to start IPFS")
-		})
-		defer patchStart.Unpatch()
-
-		EnsureIPFSRunning()
-
-		// Verify that CheckIPFSStatus was called once
-		if checkCalls != 1 {
-			t.Errorf("Expected CheckIPFSStatus to be called once, but it was called %d times", checkCalls)
-		}
-		// Verify that StartIPFS was called once
-		if startCalls != 1 {
-			t.Errorf("Expected StartIPFS to be called once, but it was called %d times", startCalls)
-		}
-	})
+	useClient(t, failingClient{})
+	require.Error(t, CheckIPFSStatus())
 }
 
-func TestListDirectory_Success(t *testing.T) {
-	patch := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		output := "QmCid1 12345 file1.txt\nQmCid2 67890 file2.txt"
-		return fakeExecCommand(output)
-	})
-	defer patch.Unpatch()
-
-	result, err := ListDirectory("mockCID")
-	assert.NoError(t, err)
-	assert.Equal(t, map[string]string{
-		"file1.txt": "QmCid1",
-		"file2.txt": "QmCid2",
-	}, result)
-}
-
-func TestListDirectory_RunFails(t *testing.T) {
-	patch := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		return fakeExecCommandWithError("command error")
-	})
-	defer patch.Unpatch()
-
-	result, err := ListDirectory("mockCID")
-	assert.Error(t, err)
-	assert.Nil(t, result)
-}
-
-func TestListDirectory_SkipMalformedLines(t *testing.T) {
-	patch := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		output := "QmCidOnly\nQmCid2 67890 file2.txt"
-		return fakeExecCommand(output)
-	})
-	defer patch.Unpatch()
-
-	result, err := ListDirectory("mockCID")
-	assert.NoError(t, err)
-	assert.Equal(t, map[string]string{
-		"file2.txt": "QmCid2",
-	}, result)
-}
-
-func TestListDirectory_ScannerError(t *testing.T) {
-	// Use a buffer with malformed data (not enough fields)
-	buf := bytes.NewBufferString("QmFakeCID fileNameOnly")
-
-	// Patch exec.CommandContext to return a command with our malformed buffer as stdout
-	patch := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		cmd := exec.Command("echo") // dummy command
-		cmd.Stdout = buf
-		return cmd
-	})
-	defer patch.Unpatch()
-
-	// Run the function under test
-	result, err := ListDirectory("mockCID")
-	assert.NoError(t, err)                       // Check that no error is returned
-	assert.Equal(t, map[string]string{}, result) // Check that the result is an empty map
-}
-
-func TestListDirectory_Timeout(t *testing.T) {
-	// Patch context.WithTimeout to return an expired context
-	patch := monkey.Patch(context.WithTimeout, func(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
-		ctx, cancel := context.WithDeadline(parent, time.Now().Add(-1*time.Second)) // Already expired
-		return ctx, cancel
-	})
-	defer patch.Unpatch()
-
-	// Patch exec.CommandContext to prevent real execution
-	patchCmd := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return fakeExecCommand("") // Won't be used because timeout triggers first
-	})
-	defer patchCmd.Unpatch()
-
-	result, err := ListDirectory("fakeCID")
-
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "timeout")
-	assert.Nil(t, result)
-}
-
-func TestListDirectory_ScannerErrMethod(t *testing.T) {
-	// Patch exec.CommandContext to return a command with empty output
-	patchCmd := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		// out buffer will remain empty
-		return fakeExecCommand("")
-	})
-	defer patchCmd.Unpatch()
-
-	// Patch bufio.Scanner.Err to simulate a scan error after iteration
-	patchErr := monkey.PatchInstanceMethod(
-		reflect.TypeOf(&bufio.Scanner{}),
-		"Err",
-		func(s *bufio.Scanner) error {
-			return fmt.Errorf("simulated scanner failure")
-		},
-	)
-	defer patchErr.Unpatch()
+func TestEnsureIPFSRunning_RemoteNode(t *testing.T) {
+	// a remote node that is down is never started from here
+	useClient(t, NewHTTPClient("203.0.113.1:5001"))
+	require.False(t, canStartIPFS())
 
-	// Call under test
-	result, err := ListDirectory("anyCID")
-
-	// Validate: we should get that scanner‑error path
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "error reading command output")
-	assert.Nil(t, result)
+	useClient(t, failingClient{})
+	require.False(t, canStartIPFS())
+	EnsureIPFSRunning()
 }
 
-func TestListDirectory_ExecCommandWithInvalidCID(t *testing.T) {
-	// Patch exec.CommandContext to simulate an error when using an invalid CID
-	patch := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return fakeExecCommandWithError("invalid CID")
-	})
-	defer patch.Unpatch()
+func TestListDirectory_Success(t *testing.T) {
+	node := NewFake()
+	useClient(t, node)
+	dir, files := createTempFiles(t)
+	cid, err := node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+
+	listing, err := ListDirectory(cid)
+	require.NoError(t, err)
+	require.Len(t, listing, len(files))
+	require.Equal(t, node.AddFile([]byte(files["file1.txt"])), listing["file1.txt"])
+}
 
-	result, err := ListDirectory("invalidCID")
+func TestListDirectory_Fails(t *testing.T) {
+	useClient(t, NewFake())
 
-	// Assert that an error is returned for invalid CID
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to execute ipfs ls")
-	assert.Nil(t, result)
+	_, err := ListDirectory("missingCID")
+	require.Error(t, err)
 }
 
 func TestConnectToIPFSNode(t *testing.T) {
-	// Happy path: simulate successful connection (CombinedOutput returns nil and no error)
-	t.Run("happy path", func(t *testing.T) {
-		// Patch the CombinedOutput method of *exec.Cmd to simulate a successful connection
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "CombinedOutput", func(cmd *exec.Cmd) ([]byte, error) {
-			return []byte("connected successfully"), nil
-		})
-		defer patch.Unpatch()
-
-		ConnectToIPFSNode("127.0.0.1", "peerId123")
-	})
-
-	// Negative path: simulate connection failure (CombinedOutput returns an error)
-	t.Run("failure", func(t *testing.T) {
-		// Patch the CombinedOutput method of *exec.Cmd to simulate an error when connecting
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "CombinedOutput", func(cmd *exec.Cmd) ([]byte, error) {
-			return nil, fmt.Errorf("mock connection error")
-		})
-		defer patch.Unpatch()
+	node := NewFake()
+	useClient(t, node)
 
-		ConnectToIPFSNode("127.0.0.1", "peerId123")
-	})
+	ConnectToIPFSNode("192.168.1.1", "QmPeer")
+	require.Equal(t, []string{"/ip4/192.168.1.1/tcp/4001/p2p/QmPeer"}, node.Peers())
 }
 
 func TestGetIPFSPeerID(t *testing.T) {
-	// Happy path: simulate successful execution of `ipfs id` command
-	t.Run("happy path", func(t *testing.T) {
-		// Patch the Output method of *exec.Cmd to simulate a successful output
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-			// Simulate a successful response from `ipfs id` (json format)
-			return []byte(`{"ID": "QmExamplePeerID"}`), nil
-		})
-		defer patch.Unpatch()
-
-		peerID, err := GetIPFSPeerID()
-
-		// Assert no error occurred and peerID is the expected one
-		assert.NoError(t, err)
-		assert.Equal(t, "QmExamplePeerID", peerID)
-	})
-
-	// Negative path: simulate failure of `ipfs id` command
-	t.Run("failure", func(t *testing.T) {
-		// Patch the Output method of *exec.Cmd to simulate a failure (error from the command)
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-			// Simulate an error (command fails)
-			return nil, fmt.Errorf("mock ipfs id error")
-		})
-		defer patch.Unpatch()
-
-		peerID, err := GetIPFSPeerID()
-
-		// Assert an error occurred and peerID should be empty
-		assert.Error(t, err)
-		assert.Empty(t, peerID)
-	})
-
-	// Negative path: simulate failure in parsing the JSON output
-	t.Run("json parsing failure", func(t *testing.T) {
-		// Patch the Output method of *exec.Cmd to simulate a successful output, but malformed JSON
-		patch := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-			// Return malformed JSON (missing closing brace)
-			return []byte(`{"ID": "QmExamplePeerID"`), nil
-		})
-		defer patch.Unpatch()
-
-		peerID, err := GetIPFSPeerID()
-
-		// Assert that an error occurred and peerID should be empty
-		assert.Error(t, err)
-		assert.Empty(t, peerID)
-	})
+	node := NewFake()
+	useClient(t, node)
+
+	peerID, err := GetIPFSPeerID()
+	require.NoError(t, err)
+	require.Equal(t, node.PeerID, peerID)
+
+	useClient(t, failingClient{})
+	peerID, err = GetIPFSPeerID()
+	require.Error(t, err)
+	require.Empty(t, peerID)
 }
 
 func TestGenerateSwarmConnectURL(t *testing.T) {
@@ -626,48 +359,3 @@ func TestGenerateSwarmConnectURL(t *testing.T) {
 		}
 	}
 }
-
-func TestIsDownloadStarted(t *testing.T) {
-	tests := []struct {
-		dir      string
-		setup    func() // Function to create the directory and its content
-		expected bool
-	}{
-		{
-			dir:      "testDirEmpty",
-			setup:    func() { os.Mkdir("testDirEmpty", 0755) },
-			expected: false, // Empty directory
-		},
-		{
-			dir:      "testDirWithFiles",
-			setup:    func() { os.Mkdir("testDirWithFiles", 0755); os.Create("testDirWithFiles/file1.txt") },
-			expected: true, // Directory with at least one file
-		},
-		{
-			dir:      "testDirWithSubdir",
-			setup:    func() { os.Mkdir("testDirWithSubdir", 0755); os.Mkdir("testDirWithSubdir/subdir", 0755) },
-			expected: true, // Directory with a subdirectory
-		},
-		{
-			dir:      "testDirError",
-			setup:    func() { /* simulate directory not existing */ },
-			expected: false, // Directory doesn't exist
-		},
-	}
-
-	for _, tt := range tests {
-		// Setup the directory and its contents
-		tt.setup()
-
-		// Ensure the function works as expected
-		t.Run(tt.dir, func(t *testing.T) {
-			result := IsDownloadStarted(tt.dir)
-			if result != tt.expected {
-				t.Errorf("For dir %s, expected %v but got %v", tt.dir, tt.expected, result)
-			}
-		})
-
-		// Cleanup
-		os.RemoveAll(tt.dir)
-	}
-}
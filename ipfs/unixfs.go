@@ -0,0 +1,177 @@
+package ipfs
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	gocid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The defaults of `ipfs add`: CIDv0, fixed size chunks and a balanced DAG of dag-pb nodes
+// wrapping UnixFS file nodes.
+const (
+	DefaultChunkSize = 256 * 1024
+	defaultMaxLinks  = 174
+)
+
+// UnixFS file node type
+const unixfsFile = 2
+
+// FileCID returns the CID `ipfs add` gives to the file at path, without a node.
+func FileCID(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	return ReaderCID(file)
+}
+
+// ReaderCID returns the CID `ipfs add` gives to the content of r, without a node.
+func ReaderCID(r io.Reader) (string, error) {
+	root, err := buildBalancedDag(r, DefaultChunkSize, defaultMaxLinks)
+	if err != nil {
+		return "", err
+	}
+	return root.cid.String(), nil
+}
+
+// dagNode is an encoded dag-pb node of the DAG of a file.
+type dagNode struct {
+	cid gocid.Cid
+	// fileSize is the amount of file data under the node
+	fileSize uint64
+	// dagSize is the size of the node and all its descendants, the Tsize of its links
+	dagSize uint64
+}
+
+// dagBuilder mirrors the balanced layout of the Kubo importer.
+type dagBuilder struct {
+	r         *bufio.Reader
+	chunkSize int
+	maxLinks  int
+	chunk     []byte
+	done      bool
+}
+
+func buildBalancedDag(r io.Reader, chunkSize, maxLinks int) (dagNode, error) {
+	b := &dagBuilder{r: bufio.NewReaderSize(r, chunkSize), chunkSize: chunkSize, maxLinks: maxLinks}
+	if err := b.next(); err != nil {
+		return dagNode{}, err
+	}
+	if b.done {
+		return encodeNode(nil, nil)
+	}
+
+	root, err := b.leaf()
+	if err != nil {
+		return dagNode{}, err
+	}
+	// every time the tree is full, it becomes the first child of a deeper one
+	for depth := 1; !b.done; depth++ {
+		root, err = b.fill([]dagNode{root}, depth)
+		if err != nil {
+			return dagNode{}, err
+		}
+	}
+	return root, nil
+}
+
+// next reads the following chunk of the file.
+func (b *dagBuilder) next() error {
+	buf := make([]byte, b.chunkSize)
+	n, err := io.ReadFull(b.r, buf)
+	switch {
+	case errors.Is(err, io.EOF):
+		b.chunk, b.done = nil, true
+		return nil
+	case errors.Is(err, io.ErrUnexpectedEOF), err == nil:
+		b.chunk = buf[:n]
+		return nil
+	default:
+		return err
+	}
+}
+
+func (b *dagBuilder) leaf() (dagNode, error) {
+	node, err := encodeNode(nil, b.chunk)
+	if err != nil {
+		return dagNode{}, err
+	}
+	return node, b.next()
+}
+
+// fill adds children to an internal node at the given depth until it is full or the file
+// ends.
+func (b *dagBuilder) fill(children []dagNode, depth int) (dagNode, error) {
+	for len(children) < b.maxLinks && !b.done {
+		var child dagNode
+		var err error
+		if depth == 1 {
+			child, err = b.leaf()
+		} else {
+			child, err = b.fill(nil, depth-1)
+		}
+		if err != nil {
+			return dagNode{}, err
+		}
+		children = append(children, child)
+	}
+	return encodeNode(children, nil)
+}
+
+// encodeNode serializes a dag-pb node holding a UnixFS file node, either a leaf with data
+// or an internal node with links to its children.
+func encodeNode(children []dagNode, data []byte) (dagNode, error) {
+	fileSize := uint64(len(data))
+	for _, child := range children {
+		fileSize += child.fileSize
+	}
+
+	var unixfs []byte
+	unixfs = protowire.AppendTag(unixfs, 1, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, unixfsFile)
+	if data != nil {
+		unixfs = protowire.AppendTag(unixfs, 2, protowire.BytesType)
+		unixfs = protowire.AppendBytes(unixfs, data)
+	}
+	unixfs = protowire.AppendTag(unixfs, 3, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, fileSize)
+	for _, child := range children {
+		unixfs = protowire.AppendTag(unixfs, 4, protowire.VarintType)
+		unixfs = protowire.AppendVarint(unixfs, child.fileSize)
+	}
+
+	// dag-pb writes the links before the data
+	var node []byte
+	dagSize := uint64(0)
+	for _, child := range children {
+		var link []byte
+		link = protowire.AppendTag(link, 1, protowire.BytesType)
+		link = protowire.AppendBytes(link, child.cid.Hash())
+		link = protowire.AppendTag(link, 2, protowire.BytesType)
+		link = protowire.AppendString(link, "")
+		link = protowire.AppendTag(link, 3, protowire.VarintType)
+		link = protowire.AppendVarint(link, child.dagSize)
+
+		node = protowire.AppendTag(node, 2, protowire.BytesType)
+		node = protowire.AppendBytes(node, link)
+		dagSize += child.dagSize
+	}
+	node = protowire.AppendTag(node, 1, protowire.BytesType)
+	node = protowire.AppendBytes(node, unixfs)
+
+	hash, err := mh.Sum(node, mh.SHA2_256, -1)
+	if err != nil {
+		return dagNode{}, err
+	}
+	return dagNode{
+		cid:      gocid.NewCidV0(hash),
+		fileSize: fileSize,
+		dagSize:  dagSize + uint64(len(node)),
+	}, nil
+}
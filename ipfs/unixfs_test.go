@@ -0,0 +1,50 @@
+package ipfs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// CIDs given by `ipfs add` with the default settings, unless a chunk size is set
+func TestReaderCID_KnownVectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   []byte
+		chunkSize int
+		expected  string
+	}{
+		{"empty", nil, DefaultChunkSize, "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"},
+		{"hello world", []byte("hello world\n"), DefaultChunkSize, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"},
+		{"hello, world!", []byte("hello, world!"), DefaultChunkSize, "QmQy2Dw4Wk7rdJKjThjYXzfFJNaRKRHhHP5gHHXroJMYxk"},
+		// four chunks under a single root
+		{"1M zeros", bytes.Repeat([]byte{0}, 1000000), DefaultChunkSize, "QmXXNNbwe4zzpdMg62ZXvnX1oU7MwSrQ3vAEtuwFKCm1oD"},
+		// 500 chunks, more than fit under one node, so the tree has two levels
+		{"size-4 chunks", []byte(strings.Repeat("aoeuidhtns", 200)), 4, "QmRo11d4QJrST47aaiGVJYwPhoNA4ihRpJ5WaxBWjWDwbX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := buildBalancedDag(bytes.NewReader(tt.content), tt.chunkSize, defaultMaxLinks)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, root.cid.String())
+			require.Equal(t, uint64(len(tt.content)), root.fileSize)
+		})
+	}
+}
+
+func TestFileCID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hello.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello world\n"), 0644))
+
+	cid, err := FileCID(path)
+	require.NoError(t, err)
+	require.Equal(t, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", cid)
+
+	_, err = FileCID(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
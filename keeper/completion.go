@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/audioStemLogger"
+)
+
+// CompleteThread completes a thread whose accepted solution was uploaded to dir and pays its
+// share of the reward left in the task. The proposer gets half of it and the validators the
+// other half, in proportion to the stems each of them revealed. Stakes slashed into the reward
+// are shared by the threads that complete later, and what the last thread doesn't pay is
+// refunded to the requester. The caller stores the task and the thread.
+func (k Keeper) CompleteThread(ctx context.Context, task *audioStem.AudioStemTask, thread *audioStem.AudioStemThread, dir string, renderSeconds int64) error {
+	threads, err := k.TaskThreads(ctx, task.TaskId)
+	if err != nil {
+		return err
+	}
+	pending := 0
+	for _, other := range threads {
+		if !other.Completed {
+			pending++
+		}
+	}
+
+	thread.Solution.Dir = dir
+	thread.AverageStemSeconds = renderSeconds
+	thread.Completed = true
+	if err := k.releaseWorkers(ctx, thread); err != nil {
+		return err
+	}
+
+	if task.Reward == nil {
+		return nil
+	}
+	audioStemLogger.Logger.Info("Thread %s completed, paying %d of the pending threads of task %s", thread.ThreadId, pending, task.TaskId)
+
+	payment, validatorsReward := task.GetWinnerReward(pending), task.GetValidatorsReward(pending)
+	if err := k.payWorker(ctx, task, thread.Solution.ProposedBy, payment, func(worker *audioStem.Worker) {
+		worker.DeclareWinner(payment, renderSeconds)
+	}); err != nil {
+		return err
+	}
+
+	for _, validation := range thread.Validations {
+		payment := thread.GetValidatorReward(validation.Validator, validatorsReward)
+		if err := k.payWorker(ctx, task, validation.Validator, payment, func(worker *audioStem.Worker) {
+			worker.DeclareValidator(payment)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if pending > 1 || !task.Reward.IsPositive() {
+		return nil
+	}
+	addr, err := types.AccAddressFromBech32(task.Requester)
+	if err != nil {
+		return err
+	}
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(*task.Reward)); err != nil {
+		return err
+	}
+	refunded := types.NewCoin(task.Reward.Denom, math.ZeroInt())
+	task.Reward = &refunded
+	return nil
+}
+
+// payWorker sends the payment from the reward of the task to the worker and declares it in
+// its reputation.
+func (k Keeper) payWorker(ctx context.Context, task *audioStem.AudioStemTask, address string, payment types.Coin, declare func(*audioStem.Worker)) error {
+	worker, err := k.Workers.Get(ctx, address)
+	if err != nil {
+		return err
+	}
+	if payment.IsPositive() {
+		addr, err := types.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(payment)); err != nil {
+			return err
+		}
+		reward := task.Reward.Sub(payment)
+		task.Reward = &reward
+	}
+	declare(&worker)
+	return k.Workers.Set(ctx, address, worker)
+}
@@ -9,13 +9,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/janction/audioStem"
-	"github.com/janction/audioStem/db"
 )
 
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	addressCodec address.Codec
 	BankKeeper   bankkeeper.BaseKeeper
+	storeService storetypes.KVStoreService
 
 	// authority is the address capable of executing a MsgUpdateParams and other authority-gated message.
 	// typically, this should be the x/gov module account.
@@ -26,37 +26,36 @@ type Keeper struct {
 	Params            collections.Item[audioStem.Params]
 	AudioStemTaskInfo collections.Item[audioStem.AudioStemTaskInfo]
 	AudioStemTasks    collections.Map[string, audioStem.AudioStemTask]
+	Threads           collections.Map[collections.Pair[string, uint32], audioStem.AudioStemThread] // by task id and index
 	Workers           collections.Map[string, audioStem.Worker]
-	Configuration     VideoConfiguration
-	DB                db.DB
+	WorkerSigners     collections.Map[string, string] // workers by the address of their signer
+
+	// indexes of the work still pending, maintained by SetTask and SetThread
+	PendingAudioStemTasks collections.KeySet[string]
+	RevealingThreads      collections.KeySet[collections.Pair[string, uint32]] // by task id and index
 }
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, path string, bankKeeper bankkeeper.BaseKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, bankKeeper bankkeeper.BaseKeeper) Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
 
-	// we initialize the database
-	db, err := db.Init(path)
-	if err != nil {
-		panic(err)
-	}
-
-	config, _ := GetAudioStemConfiguration(path)
-
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		cdc:               cdc,
-		addressCodec:      addressCodec,
-		authority:         authority,
-		Params:            collections.NewItem(sb, audioStem.ParamsKey, "params", codec.CollValue[audioStem.Params](cdc)),
-		AudioStemTaskInfo: collections.NewItem(sb, audioStem.TaskInfoKey, "audioStemtaskInfo", codec.CollValue[audioStem.AudioStemTaskInfo](cdc)),
-		AudioStemTasks:    collections.NewMap(sb, audioStem.AudioStemTaskKey, "audioStemTasks", collections.StringKey, codec.CollValue[audioStem.AudioStemTask](cdc)),
-		Workers:           collections.NewMap(sb, audioStem.WorkerKey, "audioStemWorkers", collections.StringKey, codec.CollValue[audioStem.Worker](cdc)),
-		Configuration:     *config,
-		DB:                *db,
-		BankKeeper:        bankKeeper,
+		cdc:                   cdc,
+		addressCodec:          addressCodec,
+		storeService:          storeService,
+		authority:             authority,
+		Params:                collections.NewItem(sb, audioStem.ParamsKey, "params", codec.CollValue[audioStem.Params](cdc)),
+		AudioStemTaskInfo:     collections.NewItem(sb, audioStem.TaskInfoKey, "audioStemtaskInfo", codec.CollValue[audioStem.AudioStemTaskInfo](cdc)),
+		AudioStemTasks:        collections.NewMap(sb, audioStem.AudioStemTaskKey, "audioStemTasks", collections.StringKey, codec.CollValue[audioStem.AudioStemTask](cdc)),
+		Threads:               collections.NewMap(sb, audioStem.AudioStemThreadKey, "audioStemThreads", collections.PairKeyCodec(collections.StringKey, collections.Uint32Key), codec.CollValue[audioStem.AudioStemThread](cdc)),
+		Workers:               collections.NewMap(sb, audioStem.WorkerKey, "audioStemWorkers", collections.StringKey, codec.CollValue[audioStem.Worker](cdc)),
+		WorkerSigners:         collections.NewMap(sb, audioStem.WorkerSignerKey, "audioStemWorkerSigners", collections.StringKey, collections.StringValue),
+		PendingAudioStemTasks: collections.NewKeySet(sb, audioStem.PendingAudioStemTasksKey, "pendingAudioStemTasks", collections.StringKey),
+		RevealingThreads:      collections.NewKeySet(sb, audioStem.RevealingThreadsKey, "revealingThreads", collections.PairKeyCodec(collections.StringKey, collections.Uint32Key)),
+		BankKeeper:            bankKeeper,
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+// newTestKeeper returns a keeper backed by an in-memory store, without a bank.
+func newTestKeeper(t *testing.T) (Keeper, sdk.Context) {
+	key := storetypes.NewKVStoreKey(audioStem.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authority := authtypes.NewModuleAddress("gov")
+
+	k := NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), runtime.NewKVStoreService(key), authority.String(), bankkeeper.BaseKeeper{})
+
+	ctx := testCtx.Ctx.WithBlockHeight(1)
+	require.NoError(t, k.Params.Set(ctx, audioStem.DefaultParams()))
+	return k, ctx
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/audioStemLogger"
+)
+
+// Migrator handles in place migrations of the state of the module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 reopens the threads of open tasks that were committed stem by stem. Their stems
+// can't be revealed against a merkle root, so they would be rejected and their workers slashed.
+// Instead the workers are released without a penalty and the thread takes new solutions.
+func (m Migrator) Migrate1to2(ctx types.Context) error {
+	var tasks []audioStem.AudioStemTask
+	err := m.keeper.AudioStemTasks.Walk(ctx, nil, func(key string, task audioStem.AudioStemTask) (bool, error) {
+		if !task.Completed {
+			tasks = append(tasks, task)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		reopened := false
+		for i, thread := range task.Threads {
+			if thread.Completed || !hasLegacyCommitments(thread) {
+				continue
+			}
+			audioStemLogger.Logger.Info("Reopening thread %s of task %s committed stem by stem", thread.ThreadId, task.TaskId)
+			// threads stored in the task are known by their position
+			thread.TaskId, thread.Index = task.TaskId, uint32(i)
+			if err := m.keeper.releaseWorkers(ctx, thread); err != nil {
+				return err
+			}
+			thread.Solution = nil
+			thread.Validations = nil
+			thread.RevealDeadline = 0
+			thread.Workers = nil
+			reopened = true
+		}
+		if reopened {
+			if err := m.keeper.AudioStemTasks.Set(ctx, task.TaskId, task); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 builds the index of open tasks from the stored tasks. The index of revealing
+// threads is built by Migrate3to4, once threads are keyed by their index.
+func (m Migrator) Migrate2to3(ctx types.Context) error {
+	return m.keeper.AudioStemTasks.Walk(ctx, nil, func(key string, task audioStem.AudioStemTask) (bool, error) {
+		return false, m.keeper.indexTask(ctx, task)
+	})
+}
+
+// Migrate3to4 moves the threads out of their tasks into their own collection, keyed by the
+// task id and their position in the task, and rebuilds the index of revealing threads with
+// the new keys.
+func (m Migrator) Migrate3to4(ctx types.Context) error {
+	// the index was keyed by task and thread id
+	legacy := collections.NewKeySet(collections.NewSchemaBuilder(m.keeper.storeService), audioStem.RevealingThreadsKey, "revealingThreads", collections.PairKeyCodec(collections.StringKey, collections.StringKey))
+	if err := legacy.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	var tasks []audioStem.AudioStemTask
+	err := m.keeper.AudioStemTasks.Walk(ctx, nil, func(key string, task audioStem.AudioStemTask) (bool, error) {
+		if len(task.Threads) > 0 {
+			tasks = append(tasks, task)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		for i, thread := range task.Threads {
+			thread.TaskId = task.TaskId
+			thread.Index = uint32(i)
+			if err := m.keeper.SetThread(ctx, *thread); err != nil {
+				return err
+			}
+		}
+		if err := m.keeper.SetTask(ctx, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate4to5 sets the acceptance params to their defaults. The minimum of validators was
+// computed every block from the registered workers, so it is reset to be set by governance.
+// Params added before that were read with their default while unset, so they get it stored.
+func (m Migrator) Migrate4to5(ctx types.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaults := audioStem.DefaultParams()
+	params.MaxWorkersPerThread = max(params.MaxWorkersPerThread, defaults.MaxWorkersPerThread)
+	params.MinValidators = min(defaults.MinValidators, params.MaxWorkersPerThread)
+	params.MinValidValidations = min(defaults.MinValidValidations, params.MaxWorkersPerThread)
+	params.MinValidStemsPercent = defaults.MinValidStemsPercent
+	if params.MinInvalidInputReports <= 0 {
+		params.MinInvalidInputReports = defaults.MinInvalidInputReports
+	}
+	if params.MinStemSimilarityPercent <= 0 {
+		params.MinStemSimilarityPercent = defaults.MinStemSimilarityPercent
+	}
+	if params.RevealPeriodBlocks <= 0 {
+		params.RevealPeriodBlocks = defaults.RevealPeriodBlocks
+	}
+	if params.MaxSolutionAttempts <= 0 {
+		params.MaxSolutionAttempts = defaults.MaxSolutionAttempts
+	}
+	if params.MinWorkerStaking == nil {
+		params.MinWorkerStaking = defaults.MinWorkerStaking
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 separates the index of the threads from the id of their task in their ids,
+// since the thread at index 10 of task 1 and the one at index 0 of task 11 had the same id.
+// The reports of invalid inputs name their thread by its id, so they are migrated as well.
+// Threads at an index a task can't have anymore can't be given an id, so the migration fails.
+func (m Migrator) Migrate5to6(ctx types.Context) error {
+	iter, err := m.keeper.Threads.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	threads, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range threads {
+		if kv.Key.K2() >= audioStem.MaxAmountFiles {
+			return fmt.Errorf("thread %s of task %s is at index %d, tasks have at most %d threads", kv.Value.ThreadId, kv.Key.K1(), kv.Key.K2(), audioStem.MaxAmountFiles)
+		}
+	}
+	for _, kv := range threads {
+		thread := kv.Value
+		thread.ThreadId = audioStem.FormatThreadId(kv.Key.K1(), kv.Key.K2())
+		if err := m.keeper.Threads.Set(ctx, kv.Key, thread); err != nil {
+			return err
+		}
+	}
+
+	var tasks []audioStem.AudioStemTask
+	err = m.keeper.AudioStemTasks.Walk(ctx, nil, func(key string, task audioStem.AudioStemTask) (bool, error) {
+		if len(task.InvalidInputReports) > 0 {
+			tasks = append(tasks, task)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		for _, report := range task.InvalidInputReports {
+			index, err := legacyThreadIndex(task.TaskId, report.ThreadId)
+			if err != nil {
+				return err
+			}
+			report.ThreadId = audioStem.FormatThreadId(task.TaskId, index)
+		}
+		if err := m.keeper.AudioStemTasks.Set(ctx, task.TaskId, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyThreadIndex returns the index of the thread whose legacy id is the id of its task
+// followed by its index.
+func legacyThreadIndex(taskId, threadId string) (uint32, error) {
+	index, found := strings.CutPrefix(threadId, taskId)
+	i, err := strconv.ParseUint(index, 10, 32)
+	if !found || err != nil || i >= audioStem.MaxAmountFiles {
+		return 0, fmt.Errorf("thread %q of task %s has no legacy id", threadId, taskId)
+	}
+	return uint32(i), nil
+}
+
+// hasLegacyCommitments tells if the solution or a validation of the thread was committed
+// before commitments were made to the merkle root of the stems.
+func hasLegacyCommitments(thread *audioStem.AudioStemThread) bool {
+	if thread.Solution != nil && thread.Solution.Commitment == "" {
+		return true
+	}
+	for _, validation := range thread.Validations {
+		if validation.Commitment == "" {
+			return true
+		}
+	}
+	return false
+}
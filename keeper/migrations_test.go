@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	for _, address := range []string{"legacy", "current"} {
+		require.NoError(t, k.Workers.Set(ctx, address, audioStem.Worker{Address: address, Enabled: true, CurrentTaskId: "1"}))
+	}
+	task := audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{
+			ThreadId:       "10",
+			Workers:        []string{"legacy"},
+			Solution:       &audioStem.AudioStemThread_Solution{ProposedBy: "legacy", Stems: []*audioStem.AudioStemThread_Stem{{Filename: "vocals.wav"}}},
+			RevealDeadline: 5,
+		},
+		{
+			ThreadId: "11",
+			Workers:  []string{"current"},
+			Solution: &audioStem.AudioStemThread_Solution{ProposedBy: "current", Commitment: "commitment"},
+		},
+	}}
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "1", task))
+	completed := audioStem.AudioStemTask{TaskId: "2", Completed: true, Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "20", Solution: &audioStem.AudioStemThread_Solution{ProposedBy: "legacy"}},
+	}}
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "2", completed))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	task, err := k.AudioStemTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	legacy := task.Threads[0]
+	require.Nil(t, legacy.Solution)
+	require.Empty(t, legacy.Workers)
+	require.Zero(t, legacy.RevealDeadline)
+	// reopening isn't a rejection
+	require.Zero(t, legacy.Attempts)
+	require.Empty(t, legacy.RejectedWorkers)
+	require.Equal(t, "current", task.Threads[1].Solution.ProposedBy)
+
+	worker, err := k.Workers.Get(ctx, "legacy")
+	require.NoError(t, err)
+	require.Empty(t, worker.CurrentTaskId)
+	worker, err = k.Workers.Get(ctx, "current")
+	require.NoError(t, err)
+	require.Equal(t, "1", worker.CurrentTaskId)
+
+	completed, err = k.AudioStemTasks.Get(ctx, "2")
+	require.NoError(t, err)
+	require.NotNil(t, completed.Threads[0].Solution)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	revealing := audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "10", Solution: &audioStem.AudioStemThread_Solution{ProposedBy: "worker"}, RevealDeadline: 5},
+		{ThreadId: "11"},
+	}}
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "1", revealing))
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "2", audioStem.AudioStemTask{TaskId: "2", Completed: true}))
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "3", audioStem.AudioStemTask{TaskId: "3"}))
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	taskIds, err := k.PendingTaskIds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "3"}, taskIds)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	revealing := audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "10", TaskId: "1", Solution: &audioStem.AudioStemThread_Solution{ProposedBy: "worker"}, RevealDeadline: 5},
+		{ThreadId: "11", TaskId: "1"},
+	}}
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "1", revealing))
+	require.NoError(t, k.AudioStemTasks.Set(ctx, "2", audioStem.AudioStemTask{TaskId: "2", Completed: true, Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "20", TaskId: "2", Completed: true},
+	}}))
+	// the index of revealing threads was keyed by thread id
+	legacy := collections.NewKeySet(collections.NewSchemaBuilder(k.storeService), audioStem.RevealingThreadsKey, "revealingThreads", collections.PairKeyCodec(collections.StringKey, collections.StringKey))
+	require.NoError(t, legacy.Set(ctx, collections.Join("1", "10")))
+
+	require.NoError(t, NewMigrator(k).Migrate3to4(ctx))
+
+	task, err := k.AudioStemTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, task.Threads)
+	threads, err := k.TaskThreads(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, threads, 2)
+	require.Equal(t, "11", threads[1].ThreadId)
+	require.Equal(t, uint32(1), threads[1].Index)
+	require.Equal(t, "worker", threads[0].Solution.ProposedBy)
+	threads, err = k.TaskThreads(ctx, "2")
+	require.NoError(t, err)
+	require.Len(t, threads, 1)
+
+	keys, err := k.RevealingThreadKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint32]{collections.Join("1", uint32(0))}, keys)
+	taskIds, err := k.PendingTaskIds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, taskIds)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	// the minimum of validators was computed from the registered workers
+	params := audioStem.DefaultParams()
+	params.MinValidators = 6
+	params.MinValidValidations = 0
+	params.MinValidStemsPercent = 0
+	// params added earlier were read with their default while unset
+	params.MinInvalidInputReports = 0
+	params.MinStemSimilarityPercent = 0
+	params.RevealPeriodBlocks = 0
+	params.MaxSolutionAttempts = 0
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	require.NoError(t, NewMigrator(k).Migrate4to5(ctx))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, audioStem.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrate5to6(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	// the thread at index 10 of task 1 and the one at index 0 of task 11 had the same id
+	for _, thread := range []audioStem.AudioStemThread{
+		{TaskId: "1", Index: 10, ThreadId: "110", Workers: []string{"worker"}},
+		{TaskId: "11", Index: 0, ThreadId: "110"},
+	} {
+		require.NoError(t, k.SetThread(ctx, thread))
+	}
+
+	// reports name their thread by its legacy id
+	for _, task := range []audioStem.AudioStemTask{
+		{TaskId: "1", InvalidInputReports: []*audioStem.AudioStemTask_InvalidInputReport{{Reporter: "worker", ThreadId: "110"}}},
+		{TaskId: "11", InvalidInputReports: []*audioStem.AudioStemTask_InvalidInputReport{{Reporter: "worker", ThreadId: "110"}}},
+	} {
+		require.NoError(t, k.SetTask(ctx, task))
+	}
+
+	require.NoError(t, NewMigrator(k).Migrate5to6(ctx))
+
+	thread, err := k.GetThread(ctx, "1", "1-10")
+	require.NoError(t, err)
+	require.Equal(t, "1-10", thread.ThreadId)
+	require.Equal(t, []string{"worker"}, thread.Workers)
+	thread, err = k.GetThread(ctx, "11", "11-0")
+	require.NoError(t, err)
+	require.Equal(t, "11-0", thread.ThreadId)
+	for id, threadId := range map[string]string{"1": "1-10", "11": "11-0"} {
+		task, err := k.AudioStemTasks.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, threadId, task.InvalidInputReports[0].ThreadId)
+	}
+}
+
+func TestMigrate5to6_IndexOutOfRange(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	require.NoError(t, k.SetThread(ctx, audioStem.AudioStemThread{TaskId: "1", Index: 0, ThreadId: "10"}))
+	require.NoError(t, k.SetThread(ctx, audioStem.AudioStemThread{TaskId: "1", Index: audioStem.MaxAmountFiles, ThreadId: "1100"}))
+
+	// a thread at an index tasks can't have can't be given an id
+	require.ErrorContains(t, NewMigrator(k).Migrate5to6(ctx), "at index 100")
+
+	// no thread is migrated
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Equal(t, "10", thread.ThreadId)
+}
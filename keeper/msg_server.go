@@ -2,19 +2,20 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"slices"
 	"strconv"
-	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ipfs/go-cid"
 	audioStemCrypto "github.com/janction/audioStem/crypto"
 
 	"github.com/janction/audioStem"
 	"github.com/janction/audioStem/audioStemLogger"
-	"github.com/janction/audioStem/ipfs"
 )
 
 type msgServer struct {
@@ -32,22 +33,13 @@ func NewMsgServerImpl(keeper Keeper) audioStem.MsgServer {
 func (ms msgServer) CreateAudioStemTask(ctx context.Context, msg *audioStem.MsgCreateAudioStemTask) (*audioStem.MsgCreateAudioStemTaskResponse, error) {
 	audioStemLogger.Logger.Info("CreateAudioStemTask -  creator: %s, cid: %s, amountFiles: %v, instrument: %s, mp3: %v, reward: %s", msg.Creator, msg.Cid, msg.AmountFiles, msg.Instrument, msg.Mp3, msg.Reward)
 
-	// TODO had validations about the parameters
+	// fields are checked by ValidateBasic before the message gets here
 	taskInfo, err := ms.k.AudioStemTaskInfo.Get(ctx)
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting task: %s", err.Error())
 		return nil, err
 	}
 
-	// TODO reward must be valid
-
-	// we make sure the provided CID is valid
-	_, err = cid.Decode(msg.Cid)
-	if err != nil {
-		audioStemLogger.Logger.Error("provided cid is invalid: (%s)", msg.Cid)
-		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidAudioStemTask.Error(), "cid %s is invalid", msg.Cid)
-	}
-
 	var nextId = taskInfo.NextId
 	// we get the taskId in string
 	taskId := strconv.FormatInt(nextId, 10)
@@ -58,17 +50,26 @@ func (ms msgServer) CreateAudioStemTask(ctx context.Context, msg *audioStem.MsgC
 
 	videoTask := audioStem.AudioStemTask{TaskId: taskId, Requester: msg.Creator, Cid: msg.Cid, AmountFiles: msg.AmountFiles, Instrument: msg.Instrument, Completed: false, Mp3: msg.Mp3, Reward: msg.Reward}
 	threads := videoTask.GenerateThreads(taskId, msg.Cid)
-	videoTask.Threads = threads
 
 	// the module will keep the reward to be distributed later
-	// TODO Add validations for msg.Creator
-	addr, _ := types.AccAddressFromBech32(msg.Creator)
-	ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, audioStem.ModuleName, types.NewCoins(*msg.Reward))
+	addr, err := types.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, audioStem.ErrInvalidAddress.Wrapf("%s: %s", msg.Creator, err)
+	}
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, audioStem.ModuleName, types.NewCoins(*msg.Reward)); err != nil {
+		audioStemLogger.Logger.Error("Holding the reward of the task: %s", err.Error())
+		return nil, err
+	}
 
-	// we create the task
-	if err := ms.k.AudioStemTasks.Set(ctx, taskId, videoTask); err != nil {
+	// we create the task and its threads
+	if err := ms.k.SetTask(ctx, videoTask); err != nil {
 		return nil, err
 	}
+	for _, thread := range threads {
+		if err := ms.k.SetThread(ctx, *thread); err != nil {
+			return nil, err
+		}
+	}
 	return &audioStem.MsgCreateAudioStemTaskResponse{TaskId: taskId}, nil
 }
 
@@ -81,40 +82,79 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *audioStem.MsgAddWorker)
 		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
 	}
 
+	// a worker disabled by a slash registers again to top its stake up
+	var worker audioStem.Worker
 	if found {
+		worker, err = ms.k.Workers.Get(ctx, msg.Creator)
+		if err != nil {
+			return &audioStem.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
+		}
+	}
+	if found && worker.Enabled {
 		audioStemLogger.Logger.Error("Worker %v already exists.", msg.Creator)
 		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerAlreadyRegistered.Error(), "worker (%s) is already registered", msg.Creator)
 		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
+	// the signer of a worker can't be a worker itself
+	operator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
+	}
+	if operator != msg.Creator {
+		error := audioStem.ErrInvalidSigner.Wrapf("%s signs for worker %s", msg.Creator, operator)
+		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+	}
+
 	// we verify the staking amount if valid and at least equeal the min value
-	params, _ := ms.k.Params.Get(ctx)
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting params: %s", err.Error())
+		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
+	}
 	if msg.Stake.Denom != params.MinWorkerStaking.Denom {
 		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerIncorrectStake.Error(), "staked coin denom %s is not accepted", msg.Stake.Denom)
 		audioStemLogger.Logger.Error(error.Error())
 		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
-	if msg.Stake.Amount.LT(params.MinWorkerStaking.Amount) {
+	staked := msg.Stake
+	if found && worker.Reputation != nil && worker.Reputation.Staked != nil && worker.Reputation.Staked.Denom == staked.Denom {
+		staked = staked.Add(*worker.Reputation.Staked)
+	}
+	if staked.Amount.LT(params.MinWorkerStaking.Amount) {
 		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerIncorrectStake.Error(), "staked coin is not enought. Min value is %v", params.MinWorkerStaking.Amount)
 		audioStemLogger.Logger.Error(error.Error())
 		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
 	// we verify the account has enought balance to stack
-	addr, _ := types.AccAddressFromBech32(msg.Creator)
+	addr, err := types.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		error := audioStem.ErrInvalidAddress.Wrapf("%s: %s", msg.Creator, err)
+		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+	}
 	balance := ms.k.BankKeeper.GetBalance(ctx, addr, params.MinWorkerStaking.Denom)
 	audioStemLogger.Logger.Debug("balance of %s [%s]: %s", msg.Creator, addr, balance)
 
-	if balance.Amount.LT(params.MinWorkerStaking.Amount) {
+	if balance.Amount.LT(msg.Stake.Amount) {
 		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerIncorrectStake.Error(), "not enought balance to stack. Min value is %v", params.MinWorkerStaking.Amount)
 		audioStemLogger.Logger.Error(error.Error())
 		return &audioStem.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
-	// worker is not previously registered, so we move on
-	reputation := audioStem.Worker_Reputation{Points: 0, Staked: &msg.Stake, Validations: 0, Solutions: 0, Winnings: types.NewCoin(params.MinWorkerStaking.Denom, math.NewInt(0))}
-	worker := audioStem.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId}
+	if found {
+		// the worker keeps its reputation with the stake topped up
+		if worker.Reputation == nil {
+			worker.Reputation = &audioStem.Worker_Reputation{Winnings: types.NewCoin(params.MinWorkerStaking.Denom, math.NewInt(0))}
+		}
+		worker.Reputation.Staked = &staked
+		worker.Enabled, worker.PublicIp, worker.IpfsId = true, msg.PublicIp, msg.IpfsId
+	} else {
+		// worker is not previously registered, so we move on
+		reputation := audioStem.Worker_Reputation{Points: 0, Staked: &msg.Stake, Validations: 0, Solutions: 0, Winnings: types.NewCoin(params.MinWorkerStaking.Denom, math.NewInt(0))}
+		worker = audioStem.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId}
+	}
 
 	err = ms.k.Workers.Set(ctx, msg.Creator, worker)
 	if err != nil {
@@ -135,7 +175,13 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *audioStem.MsgAddWorker)
 func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *audioStem.MsgSubscribeWorkerToTask) (*audioStem.MsgSubscribeWorkerToTaskResponse, error) {
 	audioStemLogger.Logger.Info("SubscribeWorkerToTask - address: %s, taskId: %s, threadId: %s", msg.Address, msg.TaskId, msg.ThreadId)
 
-	worker, err := ms.k.Workers.Get(ctx, msg.Address)
+	// messages of a worker can be sent by its signer
+	address, err := ms.k.WorkerOf(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	worker, err := ms.k.Workers.Get(ctx, address)
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
 		return nil, err
@@ -143,7 +189,7 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *audioStem.Ms
 
 	if !worker.Enabled {
 		audioStemLogger.Logger.Debug("Worker not enabled: %s", worker.String())
-		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerNotAvailable.Error(), "worker (%s) it nos enabled or doesn't exists", msg.Address)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerNotAvailable.Error(), "worker (%s) it nos enabled or doesn't exists", address)
 	}
 	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
 	if err != nil {
@@ -155,48 +201,67 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *audioStem.Ms
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerTaskNotAvailable.Error(), "task (%s) is already completed. Can't subscribe worker", msg.TaskId)
 	}
 
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting thread: %s", err.Error())
+		return nil, err
+	}
+
 	// we get the params to get the MaxWorkersPerThread value
-	params, _ := ms.k.Params.Get(ctx)
-	for i, v := range task.Threads {
-		if v.ThreadId == msg.ThreadId {
-			if len(v.Workers) < int(params.MaxWorkersPerThread) && !v.Completed {
-
-				if slices.Contains(v.Workers, worker.Address) {
-					audioStemLogger.Logger.Info("worker %s is already working at thread %s, skipping...", worker.Address, v.ThreadId)
-					return nil, nil
-				}
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting params: %s", err.Error())
+		return nil, err
+	}
+	if len(thread.Workers) >= int(params.MaxWorkersPerThread) || thread.Completed {
+		return nil, nil
+	}
 
-				v.Workers = append(v.Workers, msg.Address)
+	if slices.Contains(thread.Workers, worker.Address) {
+		audioStemLogger.Logger.Info("worker %s is already working at thread %s, skipping...", worker.Address, thread.ThreadId)
+		return nil, nil
+	}
 
-				worker.CurrentTaskId = task.TaskId
-				worker.CurrentThreadIndex = int32(i)
-				ms.k.Workers.Set(ctx, msg.Address, worker)
+	if slices.Contains(thread.RejectedWorkers, worker.Address) {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerTaskNotAvailable.Error(), "solution of worker %s was rejected at thread %s", worker.Address, thread.ThreadId)
+	}
 
-				err := ms.k.AudioStemTasks.Set(ctx, task.TaskId, task)
-				if err != nil {
-					audioStemLogger.Logger.Error("error trying to update thread %s to in progress", v.ThreadId)
-				}
+	thread.Workers = append(thread.Workers, address)
 
-				return &audioStem.MsgSubscribeWorkerToTaskResponse{ThreadId: v.ThreadId}, nil
-			}
-		}
+	worker.CurrentTaskId = task.TaskId
+	worker.CurrentThreadIndex = int32(thread.Index)
+	if err := ms.k.Workers.Set(ctx, address, worker); err != nil {
+		audioStemLogger.Logger.Error("error trying to assign worker %s to thread %s", address, thread.ThreadId)
+		return nil, err
+	}
+
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		audioStemLogger.Logger.Error("error trying to update thread %s to in progress", thread.ThreadId)
+		return nil, err
 	}
-	return nil, nil
+
+	return &audioStem.MsgSubscribeWorkerToTaskResponse{ThreadId: thread.ThreadId}, nil
 }
 
 func (ms msgServer) ProposeSolution(ctx context.Context, msg *audioStem.MsgProposeSolution) (*audioStem.MsgProposeSolutionResponse, error) {
-	audioStemLogger.Logger.Info("ProposeSolution - creator: %s, taskId: %s, threadId: %s, publicKey: %s, signatures: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Signatures)
+	audioStemLogger.Logger.Info("ProposeSolution - creator: %s, taskId: %s, threadId: %s, publicKey: %s, commitment: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Commitment)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
 
 	// creator of the solution must be a valid worker
-	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	worker, err := ms.k.Workers.Get(ctx, creator)
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
 		return nil, err
 	}
 
 	if !worker.Enabled {
-		audioStemLogger.Logger.Error("workers %s is not enabled to propose a solution", msg.Creator)
-		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "workers %s is not enabled to propose a solution", msg.Creator)
+		audioStemLogger.Logger.Error("workers %s is not enabled to propose a solution", creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "workers %s is not enabled to propose a solution", creator)
 	}
 
 	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
@@ -211,58 +276,45 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *audioStem.MsgPropo
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "Task %s is not valid to accept solutions", msg.TaskId)
 	}
 
-	for i, v := range task.Threads {
-		// TODO threads might be better as map instead of slice
-		if v.ThreadId == msg.ThreadId {
-			if v.Solution != nil {
-				audioStemLogger.Logger.Error("thread %s already has a solution", msg.ThreadId)
-				return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "thread %s already has a solution", msg.ThreadId)
-			}
-			// worker must be a valid registered worker in the thread with a solution
-			if !slices.Contains(v.Workers, msg.Creator) {
-				audioStemLogger.Logger.Error("Worker %s is not valid at thread %s", msg.Creator, msg.ThreadId)
-				return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "Worker %s is not valid at thread %s", msg.Creator, msg.ThreadId)
-			}
-
-			// solution len must be equal to the frames generated
-			if len(msg.Signatures) != 4 {
-				audioStemLogger.Logger.Error("amount of files in solution is incorrect, %v ", len(msg.Signatures))
-				return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "amount of files in solution is incorrect, %v ", len(msg.Signatures))
-			}
-
-			// we have passed all validations, lets add the solution to the thread
-			var frames []*audioStem.AudioStemThread_Stem
-
-			for _, val := range msg.Signatures {
-				parts := strings.SplitN(val, "=", 2)
-
-				frame := audioStem.AudioStemThread_Stem{Filename: parts[0], Signature: parts[1]}
-				frames = append(frames, &frame)
-			}
-
-			_, err := audioStemCrypto.DecodePublicKeyFromCLI(msg.PublicKey)
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting thread: %s", err.Error())
+		return nil, err
+	}
+	if thread.Solution != nil {
+		audioStemLogger.Logger.Error("thread %s already has a solution", msg.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "thread %s already has a solution", msg.ThreadId)
+	}
+	// worker must be a valid registered worker in the thread with a solution
+	if !slices.Contains(thread.Workers, creator) {
+		audioStemLogger.Logger.Error("Worker %s is not valid at thread %s", creator, msg.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "Worker %s is not valid at thread %s", creator, msg.ThreadId)
+	}
 
-			if err != nil {
-				audioStemLogger.Logger.Error("unable to decode publicKey from msg %s: %s", msg.PublicKey, err.Error())
-				return nil, err
-			}
-			task.Threads[i].Solution = &audioStem.AudioStemThread_Solution{ProposedBy: msg.Creator, Stems: frames, PublicKey: msg.PublicKey}
-			err = ms.k.AudioStemTasks.Set(ctx, msg.TaskId, task)
+	if err := ms.k.verifyCommitment(ctx, creator, msg.PublicKey, msg.Commitment, msg.Signature); err != nil {
+		audioStemLogger.Logger.Error("invalid commitment %s from %s: %s", msg.Commitment, creator, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "%s", err.Error())
+	}
 
-			if err != nil {
-				audioStemLogger.Logger.Error("unable to propose solution %s", err.Error())
-				return nil, err
-			}
-		}
+	// we have passed all validations, lets add the solution to the thread
+	thread.Solution = &audioStem.AudioStemThread_Solution{ProposedBy: creator, PublicKey: msg.PublicKey, Commitment: msg.Commitment, Signature: msg.Signature}
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		audioStemLogger.Logger.Error("unable to propose solution %s", err.Error())
+		return nil, err
 	}
 
 	return &audioStem.MsgProposeSolutionResponse{}, nil
 }
 
-// TODO Implement
 func (ms msgServer) RevealSolution(ctx context.Context, msg *audioStem.MsgRevealSolution) (*audioStem.MsgRevealSolutionResponse, error) {
 	audioStemLogger.Logger.Info("RevealSolution - creator: %s, taskId: %s, threadId: %s, stems: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Stems)
 
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
 	// Solution must be from a worker on the thread
 	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
 
@@ -271,7 +323,7 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *audioStem.MsgReveal
 		return nil, err
 	}
 
-	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	worker, err := ms.k.Workers.Get(ctx, creator)
 
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
@@ -288,14 +340,22 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *audioStem.MsgReveal
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
 	}
 
-	thread := task.Threads[worker.CurrentThreadIndex]
+	thread, err := ms.k.Threads.Get(ctx, collections.Join(task.TaskId, uint32(worker.CurrentThreadIndex)))
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting thread: %s", err.Error())
+		return nil, err
+	}
+
+	if thread.Solution == nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "thread %s has no solution to reveal", thread.ThreadId)
+	}
 
 	if thread.Solution.Accepted {
 		audioStemLogger.Logger.Error("solution has already been accepted")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "solution has already been accepted.")
 	}
 
-	if thread.Solution.ProposedBy != msg.Creator {
+	if thread.Solution.ProposedBy != creator {
 		audioStemLogger.Logger.Error("creator is not the winner.")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "creator is not the winner.")
 	}
@@ -306,28 +366,35 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *audioStem.MsgReveal
 	}
 
 	// this shouldn't happen.
-	if !slices.Contains(thread.Workers, msg.Creator) {
+	if !slices.Contains(thread.Workers, creator) {
 		audioStemLogger.Logger.Error("worker is not working on thread")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker is not working on thread")
 	}
 
+	if err := checkRevealPeriod(ctx, &thread); err != nil {
+		return nil, err
+	}
+
 	// cids amount must be equal to the amount of frames
-	if len(msg.Stems) != 4 {
+	if len(msg.Stems) != audioStem.StemsPerSolution {
 		audioStemLogger.Logger.Error("invalid amount of stems for the solution")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "invalid amount of cids for the solution")
 	}
 
-	solution := audioStem.FromCliToFrames(msg.Stems)
-	for _, frame := range solution {
-		idx := slices.IndexFunc(thread.Solution.Stems, func(f *audioStem.AudioStemThread_Stem) bool { return f.Filename == frame.Filename })
-		if idx < 0 {
-			audioStemLogger.Logger.Error("Unable to find frame with filename %s in solution", frame.String())
-			return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "frame %s not found in solution", frame)
-		}
-		// we reveal the solution
-		thread.Solution.Stems[idx].Cid = frame.Cid
-		thread.Solution.Stems[idx].Hash = frame.Hash
+	solution, err := revealedStems(msg.Stems)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "%s", err.Error())
+	}
+	total, err := audioStem.VerifyStems(solution, thread.Solution.Commitment, msg.Salt, creator)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "solution doesn't match its commitment: %s", err.Error())
+	}
+	// the whole solution must be revealed, not only part of it
+	if total != len(solution) {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "solution commits to %d stems, %d revealed", total, len(solution))
 	}
+	thread.Solution.Stems = solution
+	thread.Solution.Salt = msg.Salt
 
 	// We verify all frames in the solution have a CID revealed
 	for _, frame := range thread.Solution.Stems {
@@ -337,13 +404,139 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *audioStem.MsgReveal
 		}
 	}
 
-	task.Threads[worker.CurrentThreadIndex] = thread
-	ms.k.AudioStemTasks.Set(ctx, msg.TaskId, task)
-	return nil, nil
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgRevealSolutionResponse{}, nil
+}
+
+func (ms msgServer) RevealValidation(ctx context.Context, msg *audioStem.MsgRevealValidation) (*audioStem.MsgRevealValidationResponse, error) {
+	audioStemLogger.Logger.Info("RevealValidation - creator: %s, taskId: %s, threadId: %s, stems: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Stems)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting task: %s", err.Error())
+		return nil, err
+	}
+	if task.Completed {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "task is already completed")
+	}
+
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "thread %s doesn't exists in task %s", msg.ThreadId, msg.TaskId)
+	}
+	if err := checkRevealPeriod(ctx, &thread); err != nil {
+		return nil, err
+	}
+
+	vIdx := slices.IndexFunc(thread.Validations, func(v *audioStem.AudioStemThread_Validation) bool { return v.Validator == creator })
+	if vIdx < 0 {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker %s didn't validate thread %s", creator, msg.ThreadId)
+	}
+	validation := thread.Validations[vIdx]
+	if validation.Salt != "" {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "validation of worker %s is already revealed", creator)
+	}
+
+	// validators may reveal only the stems they could produce, each with its proof
+	stems, err := revealedStems(msg.Stems)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "%s", err.Error())
+	}
+	if _, err := audioStem.VerifyStems(stems, validation.Commitment, msg.Salt, creator); err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "validation doesn't match its commitment: %s", err.Error())
+	}
+	validation.Stems = stems
+	validation.Salt = msg.Salt
+
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgRevealValidationResponse{}, nil
+}
+
+// checkRevealPeriod makes sure the thread is between the end of its validations and the reveal deadline.
+func checkRevealPeriod(ctx context.Context, thread *audioStem.AudioStemThread) error {
+	if thread.RevealDeadline == 0 {
+		return sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "thread %s is still accepting validations", thread.ThreadId)
+	}
+	if height := types.UnwrapSDKContext(ctx).BlockHeight(); height > thread.RevealDeadline {
+		return sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "reveal deadline of thread %s was block %d", thread.ThreadId, thread.RevealDeadline)
+	}
+	return nil
+}
+
+// verifySignerKey checks that the public key sent along with signatures belongs to the worker
+// or to its signer, so nobody can sign on behalf of another worker.
+func (k Keeper) verifySignerKey(ctx context.Context, creator, encodedKey string) error {
+	pubKey, err := audioStemCrypto.DecodePublicKeyFromCLI(encodedKey)
+	if err != nil {
+		return err
+	}
+	address, err := k.addressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return err
+	}
+	if address == creator {
+		return nil
+	}
+	if worker, err := k.Workers.Get(ctx, creator); err == nil && worker.Signer != "" && address == worker.Signer {
+		return nil
+	}
+	return fmt.Errorf("public key belongs to %s, not to signer %s", address, creator)
+}
+
+// verifyCommitment checks a commitment sent by a worker, and that the worker signed it.
+func (k Keeper) verifyCommitment(ctx context.Context, creator, publicKey, commitment, signature string) error {
+	if decoded, err := hex.DecodeString(commitment); err != nil || len(decoded) != sha256.Size {
+		return fmt.Errorf("invalid commitment %s", commitment)
+	}
+	if err := k.verifySignerKey(ctx, creator, publicKey); err != nil {
+		return err
+	}
+	return audioStem.VerifyCommitmentSignature(publicKey, signature, commitment, creator)
+}
+
+// revealedStems reads the stems revealed by workers.
+func revealedStems(reveals []*audioStem.StemReveal) ([]*audioStem.AudioStemThread_Stem, error) {
+	frames := audioStem.FromRevealsToFrames(reveals)
+	if len(frames) != len(reveals) {
+		return nil, fmt.Errorf("%d stems revealed without filename or repeated", len(reveals)-len(frames))
+	}
+
+	// maps are iterated in random order, and the stored stems must be the same on every node
+	var filenames []string
+	for filename := range frames {
+		filenames = append(filenames, filename)
+	}
+	slices.Sort(filenames)
+
+	var stems []*audioStem.AudioStemThread_Stem
+	for _, filename := range filenames {
+		stem := frames[filename]
+		if stem.Cid == "" || stem.Hash == "" {
+			return nil, fmt.Errorf("stem %s doesn't have a CID or hash revealed", filename)
+		}
+		stems = append(stems, &stem)
+	}
+	return stems, nil
 }
 
 func (ms msgServer) SubmitValidation(ctx context.Context, msg *audioStem.MsgSubmitValidation) (*audioStem.MsgSubmitValidationResponse, error) {
-	audioStemLogger.Logger.Info("SubmitValidation - creator: %s, taskId: %s, threadId: %s, publicKey: %s, Signatures: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Signatures)
+	audioStemLogger.Logger.Info("SubmitValidation - creator: %s, taskId: %s, threadId: %s, publicKey: %s, commitment: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Commitment)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
 
 	// validation must be from a worker on the thread
 	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
@@ -353,7 +546,7 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *audioStem.MsgSubm
 		return nil, err
 	}
 
-	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	worker, err := ms.k.Workers.Get(ctx, creator)
 
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
@@ -375,97 +568,298 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *audioStem.MsgSubm
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
 	}
 
-	thread := task.Threads[worker.CurrentThreadIndex]
+	thread, err := ms.k.Threads.Get(ctx, collections.Join(task.TaskId, uint32(worker.CurrentThreadIndex)))
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting thread: %s", err.Error())
+		return nil, err
+	}
 	if thread.ThreadId != msg.ThreadId {
 		audioStemLogger.Logger.Error("worker is not working on thread")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker is not working on thread")
 	}
 
 	// this shouldn't happen.
-	if !slices.Contains(thread.Workers, msg.Creator) {
+	if !slices.Contains(thread.Workers, creator) {
 		audioStemLogger.Logger.Error("worker is not working on thread")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker is not working on thread")
 	}
 
-	var frames []*audioStem.AudioStemThread_Stem
-	for _, signatures := range msg.Signatures {
-		parts := strings.SplitN(signatures, "=", 2)
+	if thread.RevealDeadline != 0 {
+		audioStemLogger.Logger.Error("thread %s is already revealing its commitments", thread.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "thread %s no longer accepts validations", thread.ThreadId)
+	}
+
+	// threads reopened after a rejection have no solution until a new one is proposed
+	if thread.Solution == nil {
+		audioStemLogger.Logger.Error("thread %s has no solution to validate", thread.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "thread %s has no solution to validate", thread.ThreadId)
+	}
+
+	if thread.Solution.ProposedBy == creator {
+		audioStemLogger.Logger.Error("worker %s can't validate its own solution", creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker %s can't validate its own solution", creator)
+	}
+
+	for _, validation := range thread.Validations {
+		if validation.Validator == creator {
+			audioStemLogger.Logger.Error("worker %s already validated thread %s", creator, thread.ThreadId)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "worker %s already validated thread %s", creator, thread.ThreadId)
+		}
+	}
 
-		frame := audioStem.AudioStemThread_Stem{Filename: parts[0], Signature: parts[1]}
-		frames = append(frames, &frame)
+	if err := ms.k.verifyCommitment(ctx, creator, msg.PublicKey, msg.Commitment, msg.Signature); err != nil {
+		audioStemLogger.Logger.Error("invalid commitment %s from %s: %s", msg.Commitment, creator, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidVerification.Error(), "%s", err.Error())
 	}
 
-	validation := audioStem.AudioStemThread_Validation{Validator: msg.Creator, IsReverse: thread.IsReverse(worker.Address), Stems: frames, PublicKey: msg.PublicKey}
-	task.Threads[worker.CurrentThreadIndex].Validations = append(thread.Validations, &validation)
-	ms.k.AudioStemTasks.Set(ctx, msg.TaskId, task)
+	validation := audioStem.AudioStemThread_Validation{Validator: creator, IsReverse: thread.IsReverse(worker.Address), PublicKey: msg.PublicKey, Commitment: msg.Commitment, Signature: msg.Signature}
+	thread.Validations = append(thread.Validations, &validation)
+
+	// with enough validations, workers have until the deadline to reveal what they committed to
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.AcceptancePolicy().HasEnoughValidations(&thread) {
+		thread.RevealDeadline = types.UnwrapSDKContext(ctx).BlockHeight() + params.RevealPeriodBlocks
+	}
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		return nil, err
+	}
 
 	// we release the worker since there is nothing else for him to do on this thread
-	if worker.Address != thread.Solution.ProposedBy {
-		worker.ReleaseValidator()
-		ms.k.Workers.Set(ctx, msg.Creator, worker)
+	worker.ReleaseValidator()
+	if err := ms.k.Workers.Set(ctx, creator, worker); err != nil {
+		return nil, err
 	}
 
 	return &audioStem.MsgSubmitValidationResponse{}, nil
 }
 
 func (ms msgServer) SubmitSolution(ctx context.Context, msg *audioStem.MsgSubmitSolution) (*audioStem.MsgSubmitSolutionResponse, error) {
-	audioStemLogger.Logger.Info("SubmitSolution - creator: %s, taskId: %s, threadId: %s, Dir: %s, AverageRenderSeconds: %v", msg.Creator, msg.TaskId, msg.ThreadId, msg.Dir, msg.TaskId)
+	audioStemLogger.Logger.Info("SubmitSolution - creator: %s, taskId: %s, threadId: %s, Dir: %s, AverageStemSeconds: %v", msg.Creator, msg.TaskId, msg.ThreadId, msg.Dir, msg.AverageStemSeconds)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting Thread: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "provided thread doesn't exists")
+	}
+
+	if thread.Solution == nil || thread.Solution.ProposedBy != creator {
+		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "only the provider of the solution can upload it")
+		audioStemLogger.Logger.Error(error.Error())
+		return nil, error
+	}
+
+	if !thread.Solution.Accepted || thread.Completed {
+		error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "solution of thread %s isn't accepted or was already submitted", msg.ThreadId)
+		audioStemLogger.Logger.Error(error.Error())
+		return nil, error
+	}
 
 	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
 	if err != nil {
 		audioStemLogger.Logger.Error("Getting Task: %s", err.Error())
-		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "provided task doesn't exists")
+		return nil, err
 	}
-	for _, thread := range task.Threads {
-		if thread.ThreadId == msg.ThreadId {
 
-			if thread.Solution.ProposedBy != msg.Creator {
-				error := sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "only the provider of the solution can upload it")
-				audioStemLogger.Logger.Error(error.Error())
-				return nil, error
-			}
+	// IPFS can't be reached from consensus, the stems in the dir are verified against the
+	// revealed solution by whoever fetches the results
+	if err := ms.k.CompleteThread(ctx, &task, &thread, msg.Dir, msg.AverageStemSeconds); err != nil {
+		audioStemLogger.Logger.Error("unable to complete thread %s: %s", thread.ThreadId, err.Error())
+		return nil, err
+	}
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		return nil, err
+	}
+	if err := ms.k.SetTask(ctx, task); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgSubmitSolutionResponse{}, nil
+}
+
+func (ms msgServer) ReportInvalidInput(ctx context.Context, msg *audioStem.MsgReportInvalidInput) (*audioStem.MsgReportInvalidInputResponse, error) {
+	audioStemLogger.Logger.Info("ReportInvalidInput - creator: %s, taskId: %s, threadId: %s, reason: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Reason)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := ms.k.AudioStemTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidInputReport.Error(), "provided task doesn't exists")
+	}
+	if task.Completed {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidInputReport.Error(), "task %s is already completed", msg.TaskId)
+	}
+
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidInputReport.Error(), "thread %s doesn't exists in task %s", msg.ThreadId, msg.TaskId)
+	}
+	if !slices.Contains(thread.Workers, creator) {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidInputReport.Error(), "worker %s is not working on thread %s", creator, msg.ThreadId)
+	}
+	for _, report := range task.InvalidInputReports {
+		if report.Reporter == creator {
+			return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidInputReport.Error(), "worker %s already reported the input of task %s", creator, msg.TaskId)
+		}
+	}
+	task.InvalidInputReports = append(task.InvalidInputReports, &audioStem.AudioStemTask_InvalidInputReport{Reporter: creator, ThreadId: msg.ThreadId, Reason: msg.Reason})
 
-			// we make sure ipfs is running
-			ipfs.EnsureIPFSRunning()
-
-			// we verify the solution
-			// err := thread.VerifySubmittedSolution(msg.Dir)
-			// if err != nil {
-			// 	return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrInvalidSolution.Error(), "submited solution is incorrect")
-			// }
-
-			// solution is verified so we pay the winner
-			// addr, _ := types.AccAddressFromBech32(msg.Creator)
-			// payment := task.GetWinnerReward()
-			// ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(payment))
-			// task.Threads[i].Solution.Dir = msg.Dir
-			// task.Threads[i].AverageRenderSeconds = msg.AverageRenderSeconds
-			// task.Threads[i].Completed = true
-			// ms.k.AudioStemTasks.Set(ctx, msg.TaskId, task)
-
-			// should we pay here the validators?
-			// TODO Implement
-
-			// a worker which didn't submit a validation might still be working on this task
-			// we release them
-			for _, val := range thread.Workers {
-				worker, _ := ms.k.Workers.Get(ctx, val)
-				if task.TaskId == worker.CurrentTaskId && thread.ThreadId == task.Threads[worker.CurrentThreadIndex].ThreadId {
-					// this worker is still active but work is completed. we release him
-					worker.CurrentTaskId = ""
-					worker.CurrentThreadIndex = 0
-					ms.k.Workers.Set(ctx, worker.Address, worker)
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(task.InvalidInputReports)) < params.MinInvalidInputReports {
+		if err := ms.k.SetTask(ctx, task); err != nil {
+			return nil, err
+		}
+		return &audioStem.MsgReportInvalidInputResponse{Rejected: false}, nil
+	}
+
+	// enough workers agree the input can't be processed, so the task is rejected
+	task.Invalid = true
+	task.Completed = true
+	threads, err := ms.k.TaskThreads(ctx, task.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	for _, thread := range threads {
+		thread.Completed = true
+		if err := ms.k.releaseWorkers(ctx, &thread); err != nil {
+			return nil, err
+		}
+		if err := ms.k.SetThread(ctx, thread); err != nil {
+			return nil, err
+		}
+	}
+
+	if task.Reward != nil {
+		refund, fee := splitRejectedReward(*task.Reward, len(task.InvalidInputReports), params.ProbingFeePercent)
+		if fee.IsPositive() {
+			for _, report := range task.InvalidInputReports {
+				addr, _ := types.AccAddressFromBech32(report.Reporter)
+				if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(fee)); err != nil {
+					return nil, err
 				}
 			}
-
-			// we increase the reputation of the winner
-			worker, _ := ms.k.Workers.Get(ctx, msg.Creator)
-			// worker.DeclareWinner(payment)
-			// we added this duration to the slice of average durations.
-			// worker.Reputation.RenderDurations = append(worker.Reputation.RenderDurations, msg.AverageRenderSeconds)
-			ms.k.Workers.Set(ctx, msg.Creator, worker)
-			break
+		}
+		if refund.IsPositive() {
+			addr, _ := types.AccAddressFromBech32(task.Requester)
+			if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(refund)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return &audioStem.MsgSubmitSolutionResponse{}, nil
+
+	if err := ms.k.SetTask(ctx, task); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgReportInvalidInputResponse{Rejected: true}, nil
+}
+
+func (ms msgServer) LeaveThread(ctx context.Context, msg *audioStem.MsgLeaveThread) (*audioStem.MsgLeaveThreadResponse, error) {
+	audioStemLogger.Logger.Info("LeaveThread - creator: %s, taskId: %s, threadId: %s", msg.Creator, msg.TaskId, msg.ThreadId)
+
+	// messages of a worker can be sent by its signer
+	creator, err := ms.k.WorkerOf(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	worker, err := ms.k.Workers.Get(ctx, creator)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+	thread, err := ms.k.GetThread(ctx, msg.TaskId, msg.ThreadId)
+	if err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerTaskNotAvailable.Error(), "thread %s doesn't exists in task %s", msg.ThreadId, msg.TaskId)
+	}
+	if thread.Completed || !slices.Contains(thread.Workers, creator) || worker.CurrentTaskId != thread.TaskId || uint32(worker.CurrentThreadIndex) != thread.Index {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerTaskNotAvailable.Error(), "worker %s is not working on thread %s", creator, msg.ThreadId)
+	}
+
+	// a worker that committed to the thread has to reveal what it committed to
+	if thread.Solution != nil && thread.Solution.ProposedBy == creator {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(audioStem.ErrWorkerTaskNotAvailable.Error(), "worker %s proposed the solution of thread %s", creator, msg.ThreadId)
+	}
+
+	thread.Workers = slices.DeleteFunc(thread.Workers, func(address string) bool { return address == creator })
+	worker.ReleaseValidator()
+	if err := ms.k.Workers.Set(ctx, creator, worker); err != nil {
+		return nil, err
+	}
+	if err := ms.k.SetThread(ctx, thread); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgLeaveThreadResponse{}, nil
+}
+
+// splitRejectedReward returns the part of the reward refunded to the requester and the fee
+// paid to each of the reporters. Any remainder of the split fee goes back to the requester.
+func splitRejectedReward(reward types.Coin, reporters int, feePercent int64) (types.Coin, types.Coin) {
+	fee := types.NewCoin(reward.Denom, math.ZeroInt())
+	if reporters > 0 && feePercent > 0 {
+		total := reward.Amount.MulRaw(min(feePercent, 100)).QuoRaw(100)
+		fee.Amount = total.QuoRaw(int64(reporters))
+	}
+	refund := types.NewCoin(reward.Denom, reward.Amount.Sub(fee.Amount.MulRaw(int64(reporters))))
+	return refund, fee
+}
+
+func (ms msgServer) SetWorkerSigner(ctx context.Context, msg *audioStem.MsgSetWorkerSigner) (*audioStem.MsgSetWorkerSignerResponse, error) {
+	audioStemLogger.Logger.Info("SetWorkerSigner - creator: %s, signer: %s", msg.Creator, msg.Signer)
+
+	// only the operator can change its signer, not the signer itself
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+	if err := ms.k.SetWorkerSigner(ctx, &worker, msg.Signer); err != nil {
+		audioStemLogger.Logger.Error("unable to set signer of worker %s: %s", msg.Creator, err.Error())
+		return nil, err
+	}
+	return &audioStem.MsgSetWorkerSignerResponse{}, nil
+}
+
+func (ms msgServer) AcceptWorkerSigner(ctx context.Context, msg *audioStem.MsgAcceptWorkerSigner) (*audioStem.MsgAcceptWorkerSignerResponse, error) {
+	audioStemLogger.Logger.Info("AcceptWorkerSigner - creator: %s, worker: %s", msg.Creator, msg.Worker)
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Worker)
+	if err != nil {
+		audioStemLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+	if err := ms.k.AcceptWorkerSigner(ctx, &worker, msg.Creator); err != nil {
+		audioStemLogger.Logger.Error("unable to accept signer %s of worker %s: %s", msg.Creator, msg.Worker, err.Error())
+		return nil, err
+	}
+	return &audioStem.MsgAcceptWorkerSignerResponse{}, nil
+}
+
+func (ms msgServer) UpdateParams(ctx context.Context, msg *audioStem.MsgUpdateParams) (*audioStem.MsgUpdateParamsResponse, error) {
+	audioStemLogger.Logger.Info("UpdateParams - authority: %s", msg.Authority)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, audioStem.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, audioStem.ErrInvalidParams.Wrap(err.Error())
+	}
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &audioStem.MsgUpdateParamsResponse{}, nil
 }
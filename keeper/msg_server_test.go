@@ -0,0 +1,474 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+	"github.com/janction/audioStem/fingerprint"
+)
+
+func TestSplitRejectedReward(t *testing.T) {
+	tests := []struct {
+		name       string
+		reward     int64
+		reporters  int
+		feePercent int64
+		refund     int64
+		fee        int64
+	}{
+		{"no fee", 1000, 2, 0, 1000, 0},
+		{"even split", 1000, 2, 10, 900, 50},
+		{"remainder refunded", 1000, 3, 10, 901, 33},
+		{"whole reward", 1000, 4, 100, 0, 250},
+		{"fee above reward is capped", 1000, 2, 150, 0, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refund, fee := splitRejectedReward(types.NewCoin("jct", math.NewInt(tt.reward)), tt.reporters, tt.feePercent)
+			require.Equal(t, types.NewCoin("jct", math.NewInt(tt.refund)).String(), refund.String())
+			require.Equal(t, types.NewCoin("jct", math.NewInt(tt.fee)).String(), fee.String())
+		})
+	}
+}
+
+// committer is a worker that commits to stems and reveals them later.
+type committer struct {
+	address string
+	key     *secp256k1.PrivKey
+	salt    string
+}
+
+// newCommitter returns a committer whose address is derived from its key.
+func newCommitter(t *testing.T) committer {
+	salt, err := audioStemCrypto.GenerateSalt()
+	require.NoError(t, err)
+	key := secp256k1.GenPrivKey()
+	return committer{address: types.AccAddress(key.PubKey().Address()).String(), key: key, salt: salt}
+}
+
+func (c committer) publicKey() string {
+	encoded, _ := audioStemCrypto.EncodePublicKeyForCLI(c.key.PubKey())
+	return encoded
+}
+
+// commit returns the commitment to the merkle root of the stems and its signature.
+func (c committer) commit(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem) (string, string) {
+	root, err := audioStem.StemsRoot(stems)
+	require.NoError(t, err)
+	commitment := audioStemCrypto.GenerateCommitment(root, c.salt, c.address)
+	message, err := audioStemCrypto.GenerateSignableMessage(commitment, c.address)
+	require.NoError(t, err)
+	signature, err := c.key.Sign(message)
+	require.NoError(t, err)
+	return commitment, audioStemCrypto.EncodeSignatureForCLI(signature)
+}
+
+func (c committer) propose(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem) *audioStem.MsgProposeSolution {
+	commitment, signature := c.commit(t, stems)
+	return &audioStem.MsgProposeSolution{Creator: c.address, TaskId: "1", ThreadId: "1-0", PublicKey: c.publicKey(), Commitment: commitment, Signature: signature}
+}
+
+func (c committer) validate(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem) *audioStem.MsgSubmitValidation {
+	commitment, signature := c.commit(t, stems)
+	return &audioStem.MsgSubmitValidation{Creator: c.address, TaskId: "1", ThreadId: "1-0", PublicKey: c.publicKey(), Commitment: commitment, Signature: signature}
+}
+
+func testStems(fp byte) map[string]audioStem.AudioStemThread_Stem {
+	stems := make(map[string]audioStem.AudioStemThread_Stem)
+	for _, name := range []string{"vocals.wav", "drums.wav", "bass.wav", "other.wav"} {
+		stems[name] = audioStem.AudioStemThread_Stem{
+			Filename:    name,
+			Cid:         "cid-" + name,
+			Hash:        "hash-" + name,
+			Fingerprint: fingerprint.Fingerprint{fp, fp, fp, fp, fp, fp, fp, fp}.Encode(),
+		}
+	}
+	return stems
+}
+
+// reveal returns the reveals of the stems with the names, or of every stem without names.
+func reveal(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem, names ...string) []*audioStem.StemReveal {
+	proved, err := audioStem.ProveStems(stems)
+	require.NoError(t, err)
+	if len(names) > 0 {
+		subset := make(map[string]audioStem.AudioStemThread_Stem)
+		for _, name := range names {
+			subset[name] = proved[name]
+		}
+		proved = subset
+	}
+	return audioStem.FromFramesToReveals(proved)
+}
+
+func TestCommitReveal(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	proposer, validator, lazy := newCommitter(t), newCommitter(t), newCommitter(t)
+	for _, c := range []committer{proposer, validator, lazy} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true, CurrentTaskId: "1"}))
+	}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "1-0", TaskId: "1", Workers: []string{proposer.address, validator.address, lazy.address}},
+	}})
+
+	solution := testStems(100)
+	proposal := proposer.propose(t, solution)
+	_, err := ms.ProposeSolution(ctx, proposal)
+	require.NoError(t, err)
+
+	// nothing can be revealed while validations are open
+	_, err = ms.RevealSolution(ctx, &audioStem.MsgRevealSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Salt: proposer.salt, Stems: reveal(t, solution)})
+	require.ErrorContains(t, err, "still accepting validations")
+
+	// the honest validator produced slightly different stems, the lazy one copies the proposal
+	validated := testStems(102)
+	_, err = ms.SubmitValidation(ctx, validator.validate(t, validated))
+	require.NoError(t, err)
+	copied := lazy.validate(t, solution)
+	copied.Commitment = proposal.Commitment
+	_, err = ms.SubmitValidation(ctx, copied)
+	require.ErrorContains(t, err, "signature is not valid")
+	copied.Signature = proposal.Signature
+	_, err = ms.SubmitValidation(ctx, copied)
+	require.ErrorContains(t, err, "signature is not valid")
+	// the lazy validator can still sign the copied commitment itself
+	message, err := audioStemCrypto.GenerateSignableMessage(proposal.Commitment, lazy.address)
+	require.NoError(t, err)
+	signature, err := lazy.key.Sign(message)
+	require.NoError(t, err)
+	copied.Signature = audioStemCrypto.EncodeSignatureForCLI(signature)
+	_, err = ms.SubmitValidation(ctx, copied)
+	require.NoError(t, err)
+
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	deadline := thread.RevealDeadline
+	require.Equal(t, ctx.BlockHeight()+audioStem.DefaultParams().RevealPeriodBlocks, deadline)
+
+	_, err = ms.SubmitValidation(ctx, proposer.validate(t, solution))
+	require.ErrorContains(t, err, "no longer accepts validations")
+
+	// reveals must match the commitments, and the whole solution must be revealed
+	_, err = ms.RevealSolution(ctx, &audioStem.MsgRevealSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Salt: validator.salt, Stems: reveal(t, solution)})
+	require.ErrorContains(t, err, "doesn't match its commitment")
+	_, err = ms.RevealSolution(ctx, &audioStem.MsgRevealSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Salt: proposer.salt, Stems: reveal(t, solution, "bass.wav", "drums.wav")})
+	require.ErrorContains(t, err, "invalid amount")
+	_, err = ms.RevealSolution(ctx, &audioStem.MsgRevealSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Salt: proposer.salt, Stems: reveal(t, solution)})
+	require.NoError(t, err)
+
+	_, err = ms.RevealValidation(ctx, &audioStem.MsgRevealValidation{Creator: validator.address, TaskId: "1", ThreadId: "1-0", Salt: validator.salt, Stems: reveal(t, testStems(150))})
+	require.ErrorContains(t, err, "doesn't match its commitment")
+	// a validator can reveal part of its stems
+	_, err = ms.RevealValidation(ctx, &audioStem.MsgRevealValidation{Creator: validator.address, TaskId: "1", ThreadId: "1-0", Salt: validator.salt, Stems: reveal(t, validated, "vocals.wav", "bass.wav")})
+	require.NoError(t, err)
+	_, err = ms.RevealValidation(ctx, &audioStem.MsgRevealValidation{Creator: validator.address, TaskId: "1", ThreadId: "1-0", Salt: validator.salt, Stems: reveal(t, validated)})
+	require.ErrorContains(t, err, "already revealed")
+
+	// the copied commitment is bound to the proposer, so the lazy validator can't reveal it
+	_, err = ms.RevealValidation(ctx, &audioStem.MsgRevealValidation{Creator: lazy.address, TaskId: "1", ThreadId: "1-0", Salt: proposer.salt, Stems: reveal(t, solution)})
+	require.ErrorContains(t, err, "doesn't match its commitment")
+
+	_, err = ms.RevealValidation(ctx.WithBlockHeight(deadline+1), &audioStem.MsgRevealValidation{Creator: lazy.address, TaskId: "1", ThreadId: "1-0", Salt: lazy.salt, Stems: reveal(t, solution)})
+	require.ErrorContains(t, err, "reveal deadline")
+
+	thread, err = k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.False(t, thread.IsRevealed())
+	require.NoError(t, thread.EvaluateVerifications(audioStem.DefaultParams().StemSimilarityThreshold()))
+	for _, stem := range thread.Solution.Stems {
+		validCount := int64(0)
+		if stem.Filename == "vocals.wav" || stem.Filename == "bass.wav" {
+			validCount = 1
+		}
+		require.Equal(t, validCount, stem.ValidCount, stem.Filename)
+		require.Equal(t, int64(0), stem.InvalidCount, stem.Filename)
+	}
+
+	// evaluating again gives the same result
+	require.NoError(t, thread.EvaluateVerifications(audioStem.DefaultParams().StemSimilarityThreshold()))
+	require.Equal(t, int64(1), thread.Solution.Stems[0].ValidCount)
+}
+
+func TestSubmitValidation_Rejected(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	proposer, validator, other := newCommitter(t), newCommitter(t), newCommitter(t)
+	for _, c := range []committer{proposer, validator, other} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true, CurrentTaskId: "1"}))
+	}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "1-0", TaskId: "1", Workers: []string{proposer.address, validator.address, other.address}},
+	}})
+
+	// a thread without solution, like one reopened after a rejection, has nothing to validate
+	_, err := ms.SubmitValidation(ctx, validator.validate(t, testStems(100)))
+	require.ErrorContains(t, err, "no solution to validate")
+
+	solution := testStems(100)
+	_, err = ms.ProposeSolution(ctx, proposer.propose(t, solution))
+	require.NoError(t, err)
+
+	_, err = ms.SubmitValidation(ctx, proposer.validate(t, solution))
+	require.ErrorContains(t, err, "its own solution")
+
+	_, err = ms.SubmitValidation(ctx, validator.validate(t, solution))
+	require.NoError(t, err)
+	// the validator is released, even if it is assigned again it can't validate twice
+	worker, err := k.Workers.Get(ctx, validator.address)
+	require.NoError(t, err)
+	require.Empty(t, worker.CurrentTaskId)
+	worker.CurrentTaskId = "1"
+	require.NoError(t, k.Workers.Set(ctx, validator.address, worker))
+	_, err = ms.SubmitValidation(ctx, validator.validate(t, solution))
+	require.ErrorContains(t, err, "already validated")
+
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Len(t, thread.Validations, 1)
+	require.Zero(t, thread.RevealDeadline)
+}
+
+// revealedThread returns a thread whose solution and validation are committed and revealed.
+func revealedThread(t *testing.T, proposer, validator committer, solution, validated map[string]audioStem.AudioStemThread_Stem) *audioStem.AudioStemThread {
+	proposal, validation := proposer.propose(t, solution), validator.validate(t, validated)
+	solutionStems, err := revealedStems(reveal(t, solution))
+	require.NoError(t, err)
+	validationStems, err := revealedStems(reveal(t, validated))
+	require.NoError(t, err)
+
+	return &audioStem.AudioStemThread{
+		ThreadId: "1-0",
+		Solution: &audioStem.AudioStemThread_Solution{
+			ProposedBy: proposer.address, PublicKey: proposal.PublicKey, Commitment: proposal.Commitment, Signature: proposal.Signature,
+			Salt: proposer.salt, Stems: solutionStems,
+		},
+		Validations: []*audioStem.AudioStemThread_Validation{{
+			Validator: validator.address, PublicKey: validation.PublicKey, Commitment: validation.Commitment, Signature: validation.Signature,
+			Salt: validator.salt, Stems: validationStems,
+		}},
+	}
+}
+
+func TestEvaluateVerifications_NotSimilar(t *testing.T) {
+	thread := revealedThread(t, newCommitter(t), newCommitter(t), testStems(100), testStems(140))
+
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	for _, stem := range thread.Solution.Stems {
+		require.Equal(t, int64(0), stem.ValidCount)
+		require.Equal(t, int64(1), stem.InvalidCount)
+	}
+}
+
+func TestEvaluateVerifications_TamperedStems(t *testing.T) {
+	thread := revealedThread(t, newCommitter(t), newCommitter(t), testStems(100), testStems(100))
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	for _, stem := range thread.Solution.Stems {
+		require.Equal(t, int64(1), stem.ValidCount)
+	}
+
+	// a stem changed after the reveal no longer belongs to the committed root
+	thread.Validations[0].Stems[0].Hash = "other"
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	for _, stem := range thread.Solution.Stems {
+		require.Equal(t, int64(0), stem.ValidCount)
+		require.Equal(t, int64(1), stem.InvalidCount)
+	}
+
+	// and so does a solution stem, which invalidates the whole solution
+	thread.Validations[0].Stems[0].Hash = "hash-" + thread.Validations[0].Stems[0].Filename
+	thread.Solution.Stems[1].Hash = "other"
+	require.NoError(t, thread.EvaluateVerifications(0.9))
+	for _, stem := range thread.Solution.Stems {
+		require.Equal(t, int64(0), stem.ValidCount)
+		require.Equal(t, int64(1), stem.InvalidCount)
+	}
+}
+
+func TestRevealedStems(t *testing.T) {
+	stems, err := revealedStems([]*audioStem.StemReveal{
+		{Filename: "vocals.wav", Cid: "cid", Hash: "hash", Fingerprint: "ZmluZ2VycHJpbnQ=", Proof: "0,1"},
+		{Filename: "bass.wav", Cid: "cid2", Hash: "hash2"},
+	})
+	require.NoError(t, err)
+	require.Len(t, stems, 2)
+	// stems are sorted by filename
+	require.Equal(t, "bass.wav", stems[0].Filename)
+	require.Equal(t, "vocals.wav", stems[1].Filename)
+	require.Equal(t, "ZmluZ2VycHJpbnQ=", stems[1].Fingerprint)
+	require.Equal(t, "0,1", stems[1].Proof)
+
+	tests := []struct {
+		name    string
+		reveals []*audioStem.StemReveal
+	}{
+		{"nil", []*audioStem.StemReveal{nil}},
+		{"no filename", []*audioStem.StemReveal{{Cid: "cid", Hash: "hash"}}},
+		{"no hash", []*audioStem.StemReveal{{Filename: "vocals.wav", Cid: "cid"}}},
+		{"no cid", []*audioStem.StemReveal{{Filename: "vocals.wav", Hash: "hash"}}},
+		{"repeated", []*audioStem.StemReveal{{Filename: "vocals.wav", Cid: "cid", Hash: "hash"}, {Filename: "vocals.wav", Cid: "cid", Hash: "hash"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := revealedStems(tt.reveals)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSignerKey(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	owner, impostor := newCommitter(t), newCommitter(t)
+	for _, c := range []committer{owner, impostor} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true, CurrentTaskId: "1"}))
+	}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{
+		{ThreadId: "1-0", TaskId: "1", Workers: []string{owner.address, impostor.address}},
+	}})
+
+	// the impostor signs with the key of the owner
+	impostor.key = owner.key
+	commitment, signature := impostor.commit(t, testStems(100))
+
+	tests := []struct {
+		name      string
+		publicKey string
+		err       string
+	}{
+		{"key of another worker", owner.publicKey(), "not to signer"},
+		{"not base64", "not a key!", "failed to decode"},
+		{"not a key", "c2hvcnQ=", "invalid public key"},
+		{"empty", "", "invalid public key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ms.ProposeSolution(ctx, &audioStem.MsgProposeSolution{Creator: impostor.address, TaskId: "1", ThreadId: "1-0", PublicKey: tt.publicKey, Commitment: commitment, Signature: signature})
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	// once there is a solution, the same substitution is rejected on validations
+	_, err := ms.ProposeSolution(ctx, owner.propose(t, testStems(100)))
+	require.NoError(t, err)
+	_, err = ms.SubmitValidation(ctx, &audioStem.MsgSubmitValidation{Creator: impostor.address, TaskId: "1", ThreadId: "1-0", PublicKey: owner.publicKey(), Commitment: commitment, Signature: signature})
+	require.ErrorContains(t, err, "not to signer")
+
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Equal(t, owner.address, thread.Solution.ProposedBy)
+	require.Empty(t, thread.Validations)
+}
+
+func TestVerifySignerKey_KeyTypes(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	edKey := ed25519.GenPrivKey().PubKey()
+	multi := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{edKey, secp256k1.GenPrivKey().PubKey()})
+	for _, pk := range []cryptotypes.PubKey{edKey, multi} {
+		encoded, err := audioStemCrypto.EncodePublicKeyForCLI(pk)
+		require.NoError(t, err)
+		require.NoError(t, k.verifySignerKey(ctx, types.AccAddress(pk.Address()).String(), encoded))
+		require.ErrorContains(t, k.verifySignerKey(ctx, newCommitter(t).address, encoded), "not to signer")
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	params := audioStem.DefaultParams()
+	params.MinValidStemsPercent = 50
+	_, err := ms.UpdateParams(ctx, &audioStem.MsgUpdateParams{Authority: newCommitter(t).address, Params: params})
+	require.ErrorIs(t, err, audioStem.ErrInvalidAuthority)
+
+	invalid := params
+	invalid.RevealPeriodBlocks = 0
+	_, err = ms.UpdateParams(ctx, &audioStem.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, audioStem.ErrInvalidParams)
+
+	stored, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, audioStem.DefaultParams(), stored)
+
+	_, err = ms.UpdateParams(ctx, &audioStem.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	stored, err = k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, stored)
+}
+
+func TestWorkerHandlers_MissingParams(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	worker := newCommitter(t)
+	require.NoError(t, k.Workers.Set(ctx, worker.address, audioStem.Worker{Address: worker.address, Enabled: true}))
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{{ThreadId: "1-0"}}})
+	require.NoError(t, k.Params.Remove(ctx))
+
+	// the handlers fail instead of going on with empty params
+	_, err := ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: newCommitter(t).address, Stake: types.NewCoin("jct", math.NewInt(100))})
+	require.Error(t, err)
+	_, err = ms.SubscribeWorkerToTask(ctx, &audioStem.MsgSubscribeWorkerToTask{Address: worker.address, TaskId: "1", ThreadId: "1-0"})
+	require.Error(t, err)
+
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Empty(t, thread.Workers)
+	stored, err := k.Workers.Get(ctx, worker.address)
+	require.NoError(t, err)
+	require.Empty(t, stored.CurrentTaskId)
+}
+
+func TestLeaveThread(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	proposer, leaving, other := newCommitter(t), newCommitter(t), newCommitter(t)
+	for _, c := range []committer{proposer, leaving, other} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true}))
+	}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{{ThreadId: "1-0"}}})
+	for _, c := range []committer{proposer, leaving} {
+		_, err := ms.SubscribeWorkerToTask(ctx, &audioStem.MsgSubscribeWorkerToTask{Address: c.address, TaskId: "1", ThreadId: "1-0"})
+		require.NoError(t, err)
+	}
+	_, err := ms.ProposeSolution(ctx, proposer.propose(t, testStems(100)))
+	require.NoError(t, err)
+
+	// the proposer has to reveal its solution, and others aren't on the thread
+	_, err = ms.LeaveThread(ctx, &audioStem.MsgLeaveThread{Creator: proposer.address, TaskId: "1", ThreadId: "1-0"})
+	require.ErrorContains(t, err, "proposed the solution")
+	_, err = ms.LeaveThread(ctx, &audioStem.MsgLeaveThread{Creator: other.address, TaskId: "1", ThreadId: "1-0"})
+	require.ErrorContains(t, err, "not working on thread")
+
+	_, err = ms.LeaveThread(ctx, &audioStem.MsgLeaveThread{Creator: leaving.address, TaskId: "1", ThreadId: "1-0"})
+	require.NoError(t, err)
+	worker, err := k.Workers.Get(ctx, leaving.address)
+	require.NoError(t, err)
+	require.Empty(t, worker.CurrentTaskId)
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Equal(t, []string{proposer.address}, thread.Workers)
+	require.Empty(t, thread.RejectedWorkers)
+
+	// another worker takes its place
+	_, err = ms.SubscribeWorkerToTask(ctx, &audioStem.MsgSubscribeWorkerToTask{Address: other.address, TaskId: "1", ThreadId: "1-0"})
+	require.NoError(t, err)
+	thread, err = k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Equal(t, []string{proposer.address, other.address}, thread.Workers)
+}
@@ -3,9 +3,9 @@ package keeper
 import (
 	"context"
 	"errors"
-	"log"
 
 	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,7 +25,7 @@ type queryServer struct {
 
 // GetGame defines the handler for the Query/GetGame RPC method.
 func (qs queryServer) GetAudioStemTask(ctx context.Context, req *audioStem.QueryGetAudioStemTaskRequest) (*audioStem.QueryGetAudioStemTaskResponse, error) {
-	audioStemTask, err := qs.k.AudioStemTasks.Get(ctx, req.Index)
+	audioStemTask, err := qs.k.GetTask(ctx, req.Index)
 	if err == nil {
 		return &audioStem.QueryGetAudioStemTaskResponse{AudioStemTask: &audioStemTask}, nil
 	}
@@ -36,49 +36,71 @@ func (qs queryServer) GetAudioStemTask(ctx context.Context, req *audioStem.Query
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
-func (qs queryServer) GetAudioStemLogs(ctx context.Context, req *audioStem.QueryGetAudioStemLogsRequest) (*audioStem.QueryGetAudioStemLogsResponse, error) {
-	// access database
-	var logs []*audioStem.AudioStemLogs_AudioStemLog
-	result := qs.k.DB.ReadLogs(req.ThreadId)
-	if len(result) == 0 {
-		return nil, nil
+// GetAudioStemThread returns a single thread, without reading the rest of its task.
+func (qs queryServer) GetAudioStemThread(ctx context.Context, req *audioStem.QueryGetAudioStemThreadRequest) (*audioStem.QueryGetAudioStemThreadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	for _, val := range result {
-		logEntry := audioStem.AudioStemLogs_AudioStemLog{Log: val.Log, Timestamp: val.Timestamp, Severity: audioStem.AudioStemLogs_AudioStemLog_SEVERITY(val.Severity)}
-		logs = append(logs, &logEntry)
+	thread, err := qs.k.Threads.Get(ctx, collections.Join(req.TaskId, req.Index))
+	if err == nil {
+		return &audioStem.QueryGetAudioStemThreadResponse{Thread: &thread}, nil
+	}
+	if errors.Is(err, collections.ErrNotFound) {
+		return &audioStem.QueryGetAudioStemThreadResponse{Thread: nil}, nil
 	}
 
-	return &audioStem.QueryGetAudioStemLogsResponse{AudioStemLogs: &audioStem.AudioStemLogs{ThreadId: req.ThreadId, Logs: logs}}, nil
+	return nil, status.Error(codes.Internal, err.Error())
 }
 
 func (qs queryServer) GetPendingAudioStemTasks(ctx context.Context, req *audioStem.QueryGetPendingAudioStemTaskRequest) (*audioStem.QueryGetPendingAudioStemTaskResponse, error) {
-	ti, err := qs.k.AudioStemTaskInfo.Get(ctx)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
 
+	// pages go over the index of open tasks, so completed tasks are never read
+	tasks, pageRes, err := query.CollectionPaginate(ctx, qs.k.PendingAudioStemTasks, req.Pagination, func(taskId string, _ collections.NoValue) (*audioStem.AudioStemTask, error) {
+		task, err := qs.k.GetTask(ctx, taskId)
+		return &task, err
+	})
 	if err != nil {
-		return nil, err
-	}
-	nextId := ti.NextId
-
-	var result []*audioStem.AudioStemTask
-	for i := 0; i < int(nextId); i++ {
-		task, err := qs.k.AudioStemTasks.Get(ctx, string(i))
-		if err != nil {
-			log.Fatalf("unable to retrieve task with id %v. Error: %v", string(i), err.Error())
-			continue
-		}
-
-		if !task.Completed {
-			result = append(result, &task)
-		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &audioStem.QueryGetPendingAudioStemTaskResponse{AudioStemTasks: result}, nil
+
+	return &audioStem.QueryGetPendingAudioStemTaskResponse{AudioStemTasks: tasks, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) GetWorker(ctx context.Context, req *audioStem.QueryGetWorkerRequest) (*audioStem.QueryGetWorkerResponse, error) {
 	worker, err := qs.k.Workers.Get(ctx, req.Worker)
+	if err == nil {
+		return &audioStem.QueryGetWorkerResponse{Worker: &worker}, nil
+	}
+	if errors.Is(err, collections.ErrNotFound) {
+		return &audioStem.QueryGetWorkerResponse{Worker: nil}, nil
+	}
+
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+func (qs queryServer) GetWorkers(ctx context.Context, req *audioStem.QueryGetWorkersRequest) (*audioStem.QueryGetWorkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	workers, pageRes, err := query.CollectionPaginate(ctx, qs.k.Workers, req.Pagination, func(_ string, worker audioStem.Worker) (audioStem.Worker, error) {
+		return worker, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &audioStem.QueryGetWorkersResponse{Workers: workers, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetParams(ctx context.Context, req *audioStem.QueryGetParamsRequest) (*audioStem.QueryGetParamsResponse, error) {
+	params, err := qs.k.Params.Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &audioStem.QueryGetWorkerResponse{Worker: &worker}, nil
+	return &audioStem.QueryGetParamsResponse{Params: params}, nil
 }
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+func TestGetWorkers(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := queryServer{k: k}
+	for _, address := range []string{"worker1", "worker2", "worker3"} {
+		require.NoError(t, k.Workers.Set(ctx, address, audioStem.Worker{Address: address}))
+	}
+
+	res, err := qs.GetWorker(ctx, &audioStem.QueryGetWorkerRequest{Worker: "unknown"})
+	require.NoError(t, err)
+	require.Nil(t, res.Worker)
+
+	var workers []string
+	req := &audioStem.QueryGetWorkersRequest{Pagination: &query.PageRequest{Limit: 2}}
+	for {
+		res, err := qs.GetWorkers(ctx, req)
+		require.NoError(t, err)
+		for _, worker := range res.Workers {
+			workers = append(workers, worker.Address)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	require.Equal(t, []string{"worker1", "worker2", "worker3"}, workers)
+
+	params, err := qs.GetParams(ctx, &audioStem.QueryGetParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, audioStem.DefaultParams(), params.Params)
+}
+
+func TestGetAudioStemThread(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := queryServer{k: k}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{{ThreadId: "1-0"}, {ThreadId: "1-1"}}})
+
+	res, err := qs.GetAudioStemThread(ctx, &audioStem.QueryGetAudioStemThreadRequest{TaskId: "1", Index: 1})
+	require.NoError(t, err)
+	require.Equal(t, "1-1", res.Thread.ThreadId)
+	res, err = qs.GetAudioStemThread(ctx, &audioStem.QueryGetAudioStemThreadRequest{TaskId: "1", Index: 2})
+	require.NoError(t, err)
+	require.Nil(t, res.Thread)
+
+	// tasks are served with their threads
+	task, err := qs.GetAudioStemTask(ctx, &audioStem.QueryGetAudioStemTaskRequest{Index: "1"})
+	require.NoError(t, err)
+	require.Len(t, task.AudioStemTask.Threads, 2)
+	pending, err := qs.GetPendingAudioStemTasks(ctx, &audioStem.QueryGetPendingAudioStemTaskRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.AudioStemTasks[0].Threads, 2)
+}
+
+func TestGetPendingAudioStemTasks_Pagination(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := queryServer{k: k}
+	for _, taskId := range []string{"1", "2", "3"} {
+		storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: taskId, Threads: []*audioStem.AudioStemThread{{ThreadId: taskId + "-0"}}})
+	}
+	require.NoError(t, k.SetTask(ctx, audioStem.AudioStemTask{TaskId: "4", Completed: true}))
+
+	var taskIds []string
+	req := &audioStem.QueryGetPendingAudioStemTaskRequest{Pagination: &query.PageRequest{Limit: 2}}
+	for {
+		res, err := qs.GetPendingAudioStemTasks(ctx, req)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.AudioStemTasks), 2)
+		for _, task := range res.AudioStemTasks {
+			require.Len(t, task.Threads, 1)
+			taskIds = append(taskIds, task.TaskId)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	require.Equal(t, []string{"1", "2", "3"}, taskIds)
+}
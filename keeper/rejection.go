@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/audioStemLogger"
+)
+
+// RejectSolution handles a solution of the thread that wasn't accepted. The proposer loses part
+// of its stake, which is added to the reward of the task, and can't work on the thread again.
+// The thread is reopened for new workers unless it already rejected the maximum of solutions,
+// in which case the task fails and the requester gets the reward back. The caller stores
+// the task and the thread.
+func (k Keeper) RejectSolution(ctx context.Context, task *audioStem.AudioStemTask, thread *audioStem.AudioStemThread) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	proposer := thread.Solution.ProposedBy
+	audioStemLogger.Logger.Info("Rejecting solution of %s for thread %s", proposer, thread.ThreadId)
+	if err := k.releaseWorkers(ctx, thread); err != nil {
+		return err
+	}
+	if err := k.slash(ctx, params, task, thread, proposer); err != nil {
+		return err
+	}
+
+	thread.Attempts++
+	thread.RejectedWorkers = append(thread.RejectedWorkers, proposer)
+	thread.Solution = nil
+	thread.Validations = nil
+	thread.RevealDeadline = 0
+	thread.Workers = nil
+
+	if thread.Attempts < params.MaxSolutionAttempts {
+		return nil
+	}
+	audioStemLogger.Logger.Info("Thread %s rejected %d solutions, task %s failed", thread.ThreadId, thread.Attempts, task.TaskId)
+	return k.failTask(ctx, task, thread)
+}
+
+// ResolveReveals decides on a thread whose commitments were revealed or whose reveal deadline
+// passed. Validators that didn't reveal are penalized like a rejected proposer and leave the
+// thread. A solution that wasn't revealed is rejected. Otherwise, with enough revealed
+// validations the acceptance policy accepts or rejects the solution, and with too few the
+// thread accepts validations again, so withholding a reveal can't get the proposer slashed.
+// The caller stores the task and the thread.
+func (k Keeper) ResolveReveals(ctx context.Context, task *audioStem.AudioStemTask, thread *audioStem.AudioStemThread) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	policy := params.AcceptancePolicy()
+
+	var revealed []*audioStem.AudioStemThread_Validation
+	var withheld []string
+	for _, validation := range thread.Validations {
+		if validation.Salt == "" {
+			withheld = append(withheld, validation.Validator)
+			continue
+		}
+		revealed = append(revealed, validation)
+	}
+	thread.Validations = revealed
+	// validators that withheld their reveal are still counted, so they can't make the thread
+	// decide on fewer validations than it waited for
+	enough := policy.HasEnoughValidations(thread)
+	for _, address := range withheld {
+		audioStemLogger.Logger.Info("Validator %s didn't reveal its validation of thread %s", address, thread.ThreadId)
+		if err := k.slash(ctx, params, task, thread, address); err != nil {
+			return err
+		}
+		thread.Workers = slices.DeleteFunc(thread.Workers, func(worker string) bool { return worker == address })
+		thread.RejectedWorkers = append(thread.RejectedWorkers, address)
+	}
+
+	// a solution that wasn't revealed in time can't be accepted
+	if thread.Solution.Salt == "" {
+		return k.RejectSolution(ctx, task, thread)
+	}
+	if !enough {
+		audioStemLogger.Logger.Info("Too few validations of thread %s were revealed, it accepts validations again", thread.ThreadId)
+		thread.RevealDeadline = 0
+		return nil
+	}
+	if err := thread.EvaluateVerifications(params.StemSimilarityThreshold()); err != nil {
+		return err
+	}
+	if policy.IsSolutionAccepted(thread) {
+		thread.Solution.Accepted = true
+		return nil
+	}
+	return k.RejectSolution(ctx, task, thread)
+}
+
+// slash takes the percentage of the stake of the worker set by the params and releases it if
+// it is still working on the thread. The stake is already held by the module, so it only needs
+// to be added to the reward of the task.
+func (k Keeper) slash(ctx context.Context, params audioStem.Params, task *audioStem.AudioStemTask, thread *audioStem.AudioStemThread, address string) error {
+	worker, err := k.Workers.Get(ctx, address)
+	if err != nil {
+		return nil
+	}
+	slash := worker.Penalize(params.RejectionSlashPercent, params.MinWorkerStaking)
+	if !worker.Enabled {
+		audioStemLogger.Logger.Info("Stake of worker %s is below the minimum, it is disabled until it tops it up", address)
+	}
+	if worker.IsWorkingOn(thread) {
+		worker.ReleaseValidator()
+	}
+	if task.Reward != nil && slash.Denom == task.Reward.Denom {
+		reward := task.Reward.Add(slash)
+		task.Reward = &reward
+	}
+	return k.Workers.Set(ctx, address, worker)
+}
+
+// failTask completes the task without a result and refunds the reward to the requester.
+// Threads completed before were paid from the reward, so only what is left goes back. The
+// other threads of the task are completed in the store, while the failing thread is left to
+// the caller.
+func (k Keeper) failTask(ctx context.Context, task *audioStem.AudioStemTask, failing *audioStem.AudioStemThread) error {
+	task.Failed = true
+	task.Completed = true
+	failing.Completed = true
+	threads, err := k.TaskThreads(ctx, task.TaskId)
+	if err != nil {
+		return err
+	}
+	for _, thread := range threads {
+		if thread.Index == failing.Index {
+			continue
+		}
+		thread.Completed = true
+		if err := k.releaseWorkers(ctx, &thread); err != nil {
+			return err
+		}
+		if err := k.SetThread(ctx, thread); err != nil {
+			return err
+		}
+	}
+
+	if task.Reward == nil || !task.Reward.IsPositive() {
+		return nil
+	}
+	addr, err := types.AccAddressFromBech32(task.Requester)
+	if err != nil {
+		return err
+	}
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, audioStem.ModuleName, addr, types.NewCoins(*task.Reward))
+}
+
+// releaseWorkers frees the workers still assigned to the thread.
+func (k Keeper) releaseWorkers(ctx context.Context, thread *audioStem.AudioStemThread) error {
+	for _, address := range thread.Workers {
+		worker, err := k.Workers.Get(ctx, address)
+		if err != nil || !worker.IsWorkingOn(thread) {
+			continue
+		}
+		worker.ReleaseValidator()
+		if err := k.Workers.Set(ctx, address, worker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
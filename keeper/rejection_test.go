@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+// rejectionTask stores a task with a single thread proposed by the first worker and
+// validated by the second one, both working on it.
+func rejectionTask(t *testing.T, k Keeper, ctx types.Context, reward *types.Coin) audioStem.AudioStemTask {
+	workers := []string{"proposer", "validator"}
+	for _, address := range workers {
+		staked := types.NewCoin("jct", math.NewInt(1000))
+		require.NoError(t, k.Workers.Set(ctx, address, audioStem.Worker{
+			Address:       address,
+			Enabled:       true,
+			CurrentTaskId: "1",
+			Reputation:    &audioStem.Worker_Reputation{Staked: &staked, Points: 5},
+		}))
+	}
+	task := audioStem.AudioStemTask{
+		TaskId:    "1",
+		Requester: "requester",
+		Reward:    reward,
+		Threads: []*audioStem.AudioStemThread{{
+			ThreadId:       "1-0",
+			Workers:        workers,
+			Solution:       &audioStem.AudioStemThread_Solution{ProposedBy: "proposer"},
+			Validations:    []*audioStem.AudioStemThread_Validation{{Validator: "validator"}},
+			RevealDeadline: 10,
+		}, {
+			ThreadId: "1-1",
+		}},
+	}
+	storeTask(t, k, ctx, task)
+	return task
+}
+
+func TestRejectSolution_Reopens(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	reward := types.NewCoin("jct", math.NewInt(500))
+	task := rejectionTask(t, k, ctx, &reward)
+	thread := task.Threads[0]
+
+	require.NoError(t, k.RejectSolution(ctx, &task, thread))
+
+	require.False(t, task.Failed)
+	require.False(t, thread.Completed)
+	require.Nil(t, thread.Solution)
+	require.Empty(t, thread.Validations)
+	require.Empty(t, thread.Workers)
+	require.Zero(t, thread.RevealDeadline)
+	require.Equal(t, int64(1), thread.Attempts)
+	require.Equal(t, []string{"proposer"}, thread.RejectedWorkers)
+	// the slash of the proposer goes to the reward
+	require.Equal(t, "600jct", task.Reward.String())
+
+	proposer, err := k.Workers.Get(ctx, "proposer")
+	require.NoError(t, err)
+	require.Empty(t, proposer.CurrentTaskId)
+	require.Equal(t, "900jct", proposer.Reputation.Staked.String())
+	require.Equal(t, int64(4), proposer.Reputation.Points)
+
+	validator, err := k.Workers.Get(ctx, "validator")
+	require.NoError(t, err)
+	require.Empty(t, validator.CurrentTaskId)
+	require.Equal(t, "1000jct", validator.Reputation.Staked.String())
+}
+
+func TestRejectSolution_FailsAfterMaxAttempts(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	// without a reward there is nothing to refund
+	task := rejectionTask(t, k, ctx, nil)
+	thread := task.Threads[0]
+	thread.Attempts = audioStem.DefaultParams().MaxSolutionAttempts - 1
+
+	require.NoError(t, k.RejectSolution(ctx, &task, thread))
+
+	require.True(t, task.Failed)
+	require.True(t, task.Completed)
+	require.True(t, thread.Completed)
+	require.Equal(t, audioStem.DefaultParams().MaxSolutionAttempts, thread.Attempts)
+	// the other threads of the task are completed as well
+	other, err := k.GetThread(ctx, "1", "1-1")
+	require.NoError(t, err)
+	require.True(t, other.Completed)
+}
+
+func TestResolveReveals_WithheldValidation(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	reward := types.NewCoin("jct", math.NewInt(500))
+	task := rejectionTask(t, k, ctx, &reward)
+	thread := task.Threads[0]
+	// the proposer revealed its solution, but the validator withholds its reveal
+	thread.Solution.Salt = "salt"
+
+	require.NoError(t, k.ResolveReveals(ctx, &task, thread))
+
+	// the thread accepts validations again for the same solution
+	require.False(t, task.Failed)
+	require.NotNil(t, thread.Solution)
+	require.False(t, thread.Solution.Accepted)
+	require.Empty(t, thread.Validations)
+	require.Zero(t, thread.RevealDeadline)
+	require.Zero(t, thread.Attempts)
+	require.Equal(t, []string{"proposer"}, thread.Workers)
+	require.Equal(t, []string{"validator"}, thread.RejectedWorkers)
+	// only the validator is slashed
+	require.Equal(t, "600jct", task.Reward.String())
+
+	proposer, err := k.Workers.Get(ctx, "proposer")
+	require.NoError(t, err)
+	require.Equal(t, "1000jct", proposer.Reputation.Staked.String())
+	require.Equal(t, "1", proposer.CurrentTaskId)
+	validator, err := k.Workers.Get(ctx, "validator")
+	require.NoError(t, err)
+	require.Equal(t, "900jct", validator.Reputation.Staked.String())
+}
+
+func TestResolveReveals_RevealedValidations(t *testing.T) {
+	tests := []struct {
+		name      string
+		validated map[string]audioStem.AudioStemThread_Stem
+		accepted  bool
+	}{
+		{"agreeing validation", testStems(101), true},
+		{"disagreeing validation", testStems(140), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ctx := newTestKeeper(t)
+			proposer, validator := newCommitter(t), newCommitter(t)
+			for _, c := range []committer{proposer, validator} {
+				staked := types.NewCoin("jct", math.NewInt(1000))
+				require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true, CurrentTaskId: "1", Reputation: &audioStem.Worker_Reputation{Staked: &staked}}))
+			}
+			reward := types.NewCoin("jct", math.NewInt(500))
+			task := audioStem.AudioStemTask{TaskId: "1", Requester: "requester", Reward: &reward}
+			thread := revealedThread(t, proposer, validator, testStems(100), tt.validated)
+			thread.TaskId = "1"
+			thread.Workers = []string{proposer.address, validator.address}
+			thread.RevealDeadline = 10
+
+			require.NoError(t, k.ResolveReveals(ctx, &task, thread))
+
+			worker, err := k.Workers.Get(ctx, proposer.address)
+			require.NoError(t, err)
+			if tt.accepted {
+				require.True(t, thread.Solution.Accepted)
+				require.Equal(t, "1000jct", worker.Reputation.Staked.String())
+				return
+			}
+			// the validator revealed stems that don't match, so the proposer is slashed
+			require.Nil(t, thread.Solution)
+			require.Equal(t, []string{proposer.address}, thread.RejectedWorkers)
+			require.Equal(t, "900jct", worker.Reputation.Staked.String())
+			require.Equal(t, "600jct", task.Reward.String())
+		})
+	}
+}
+
+func TestSlash_KeepsWorkerOnOtherThread(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	reward := types.NewCoin("jct", math.NewInt(500))
+	task := rejectionTask(t, k, ctx, &reward)
+	thread := task.Threads[0]
+	thread.Solution.Salt = "salt"
+	// the validator was already released and works on the other thread of the task
+	validator, err := k.Workers.Get(ctx, "validator")
+	require.NoError(t, err)
+	validator.CurrentThreadIndex = 1
+	require.NoError(t, k.Workers.Set(ctx, "validator", validator))
+
+	require.NoError(t, k.ResolveReveals(ctx, &task, thread))
+
+	validator, err = k.Workers.Get(ctx, "validator")
+	require.NoError(t, err)
+	require.Equal(t, "900jct", validator.Reputation.Staked.String())
+	require.Equal(t, "1", validator.CurrentTaskId)
+	require.Equal(t, int32(1), validator.CurrentThreadIndex)
+}
+
+func TestSlash_DisablesWorkerBelowMinStake(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	params := audioStem.DefaultParams()
+	minStake := types.NewCoin("jct", math.NewInt(950))
+	params.MinWorkerStaking = &minStake
+	require.NoError(t, k.Params.Set(ctx, params))
+	reward := types.NewCoin("jct", math.NewInt(500))
+	task := rejectionTask(t, k, ctx, &reward)
+
+	require.NoError(t, k.RejectSolution(ctx, &task, task.Threads[0]))
+
+	// the proposer has 900jct left, below the minimum, while the validator wasn't slashed
+	proposer, err := k.Workers.Get(ctx, "proposer")
+	require.NoError(t, err)
+	require.False(t, proposer.Enabled)
+	validator, err := k.Workers.Get(ctx, "validator")
+	require.NoError(t, err)
+	require.True(t, validator.Enabled)
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/cli"
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+	"github.com/janction/audioStem/fingerprint"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/keeper"
+	audioStemModule "github.com/janction/audioStem/module"
+)
+
+// newBankKeeper returns a keeper that holds and pays rewards through a bank backed by an
+// in-memory store.
+func newBankKeeper(t *testing.T) (keeper.Keeper, bankkeeper.BaseKeeper, sdk.Context) {
+	keys := storetypes.NewKVStoreKeys(audioStem.ModuleName, authtypes.StoreKey, banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithBlockHeight(1)
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	authority := authtypes.NewModuleAddress("gov").String()
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+
+	permissions := map[string][]string{minttypes.ModuleName: {authtypes.Minter}, audioStem.ModuleName: nil}
+	accountKeeper := authkeeper.NewAccountKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]), authtypes.ProtoBaseAccount, permissions, addressCodec, "cosmos", authority)
+	bankKeeper := bankkeeper.NewBaseKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[banktypes.StoreKey]), accountKeeper, nil, authority, log.NewNopLogger())
+	k := keeper.NewKeeper(encCfg.Codec, addressCodec, runtime.NewKVStoreService(keys[audioStem.ModuleName]), authority, bankKeeper)
+
+	require.NoError(t, k.Params.Set(ctx, audioStem.DefaultParams()))
+	require.NoError(t, k.AudioStemTaskInfo.Set(ctx, audioStem.AudioStemTaskInfo{NextId: 1}))
+	return k, bankKeeper, ctx
+}
+
+// account is a funded account that signs its commitments.
+type account struct {
+	address string
+	key     *secp256k1.PrivKey
+	salt    string
+}
+
+func newAccount(t *testing.T, ctx sdk.Context, bankKeeper bankkeeper.BaseKeeper, funds sdk.Coin) account {
+	key := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	require.NoError(t, banktestutil.FundAccount(ctx, bankKeeper, addr, sdk.NewCoins(funds)))
+	salt, err := audioStemCrypto.GenerateSalt()
+	require.NoError(t, err)
+	return account{address: addr.String(), key: key, salt: salt}
+}
+
+// commit returns the public key, commitment and signature of the account for the stems.
+func (a account) commit(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem) (string, string, string) {
+	root, err := audioStem.StemsRoot(stems)
+	require.NoError(t, err)
+	commitment := audioStemCrypto.GenerateCommitment(root, a.salt, a.address)
+	message, err := audioStemCrypto.GenerateSignableMessage(commitment, a.address)
+	require.NoError(t, err)
+	signature, err := a.key.Sign(message)
+	require.NoError(t, err)
+	publicKey, err := audioStemCrypto.EncodePublicKeyForCLI(a.key.PubKey())
+	require.NoError(t, err)
+	return publicKey, commitment, audioStemCrypto.EncodeSignatureForCLI(signature)
+}
+
+func writeWav(t *testing.T, path string, samples []int) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	encoder := wav.NewEncoder(file, 8000, 16, 1, 1)
+	buf := &audio.IntBuffer{Format: &audio.Format{NumChannels: 1, SampleRate: 8000}, Data: samples, SourceBitDepth: 16}
+	require.NoError(t, encoder.Write(buf))
+	require.NoError(t, encoder.Close())
+}
+
+// uploadStems writes the stems of a solution and adds their directory to the node.
+func uploadStems(t *testing.T, node *ipfs.Fake) (map[string]audioStem.AudioStemThread_Stem, string) {
+	dir := t.TempDir()
+	stems := make(map[string]audioStem.AudioStemThread_Stem)
+	for i, name := range []string{"vocals.wav", "drums.wav", "bass.wav", "other.wav"} {
+		path := filepath.Join(dir, name)
+		writeWav(t, path, []int{i, i + 1, i + 2, i + 3})
+		cid, err := ipfs.FileCID(path)
+		require.NoError(t, err)
+		hash, err := audioStem.CalculateFileHash(path)
+		require.NoError(t, err)
+		stems[name] = audioStem.AudioStemThread_Stem{Filename: name, Cid: cid, Hash: hash, Fingerprint: fingerprint.Fingerprint{100, 100, 100, 100, 100, 100, 100, 100}.Encode()}
+	}
+	cid, err := node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+	return stems, cid
+}
+
+func revealAll(t *testing.T, stems map[string]audioStem.AudioStemThread_Stem) []*audioStem.StemReveal {
+	proved, err := audioStem.ProveStems(stems)
+	require.NoError(t, err)
+	return audioStem.FromFramesToReveals(proved)
+}
+
+func TestSubmitSolution_FetchResults(t *testing.T) {
+	k, bankKeeper, ctx := newBankKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	am := audioStemModule.NewAppModule(moduletestutil.MakeTestEncodingConfig().Codec, k)
+	node := ipfs.NewFake()
+	previous := ipfs.DefaultClient()
+	ipfs.SetClient(node)
+	t.Cleanup(func() { ipfs.SetClient(previous) })
+
+	stake := *audioStem.DefaultParams().MinWorkerStaking
+	reward := sdk.NewCoin(stake.Denom, math.NewInt(1000))
+	requester := newAccount(t, ctx, bankKeeper, reward)
+	proposer, validator := newAccount(t, ctx, bankKeeper, stake), newAccount(t, ctx, bankKeeper, stake)
+	for _, worker := range []account{proposer, validator} {
+		_, err := ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: worker.address, PublicIp: "127.0.0.1", IpfsId: "peer", Stake: stake})
+		require.NoError(t, err)
+	}
+
+	_, err := ms.CreateAudioStemTask(ctx, &audioStem.MsgCreateAudioStemTask{Creator: requester.address, Cid: "input", AmountFiles: 1, Instrument: "vocals", Reward: &reward})
+	require.NoError(t, err)
+	for _, worker := range []account{proposer, validator} {
+		_, err := ms.SubscribeWorkerToTask(ctx, &audioStem.MsgSubscribeWorkerToTask{Address: worker.address, TaskId: "1", ThreadId: "1-0"})
+		require.NoError(t, err)
+	}
+
+	stems, dir := uploadStems(t, node)
+	publicKey, commitment, signature := proposer.commit(t, stems)
+	_, err = ms.ProposeSolution(ctx, &audioStem.MsgProposeSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", PublicKey: publicKey, Commitment: commitment, Signature: signature})
+	require.NoError(t, err)
+	publicKey, commitment, signature = validator.commit(t, stems)
+	_, err = ms.SubmitValidation(ctx, &audioStem.MsgSubmitValidation{Creator: validator.address, TaskId: "1", ThreadId: "1-0", PublicKey: publicKey, Commitment: commitment, Signature: signature})
+	require.NoError(t, err)
+
+	// nothing can be submitted before the solution is accepted
+	_, err = ms.SubmitSolution(ctx, &audioStem.MsgSubmitSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Dir: dir})
+	require.ErrorContains(t, err, "isn't accepted")
+
+	_, err = ms.RevealSolution(ctx, &audioStem.MsgRevealSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Salt: proposer.salt, Stems: revealAll(t, stems)})
+	require.NoError(t, err)
+	_, err = ms.RevealValidation(ctx, &audioStem.MsgRevealValidation{Creator: validator.address, TaskId: "1", ThreadId: "1-0", Salt: validator.salt, Stems: revealAll(t, stems)})
+	require.NoError(t, err)
+	require.NoError(t, am.BeginBlock(ctx))
+
+	_, err = ms.SubmitSolution(ctx, &audioStem.MsgSubmitSolution{Creator: validator.address, TaskId: "1", ThreadId: "1-0", Dir: dir})
+	require.ErrorContains(t, err, "only the provider")
+	_, err = ms.SubmitSolution(ctx, &audioStem.MsgSubmitSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Dir: dir, AverageStemSeconds: 12})
+	require.NoError(t, err)
+	_, err = ms.SubmitSolution(ctx, &audioStem.MsgSubmitSolution{Creator: proposer.address, TaskId: "1", ThreadId: "1-0", Dir: dir})
+	require.ErrorContains(t, err, "already submitted")
+	require.NoError(t, am.EndBlock(ctx))
+
+	// the reward is split between the proposer and the validator of the only thread
+	require.Equal(t, math.NewInt(500), bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(proposer.address), stake.Denom).Amount)
+	require.Equal(t, math.NewInt(500), bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(validator.address), stake.Denom).Amount)
+	winner, err := k.Workers.Get(ctx, proposer.address)
+	require.NoError(t, err)
+	require.Empty(t, winner.CurrentTaskId)
+	require.Equal(t, int32(1), winner.Reputation.Solutions)
+	require.Equal(t, []int64{12}, winner.Reputation.RenderDurations)
+	require.Equal(t, math.NewInt(500), winner.Reputation.Winnings.Amount)
+	checker, err := k.Workers.Get(ctx, validator.address)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), checker.Reputation.Validations)
+
+	res, err := keeper.NewQueryServerImpl(k).GetAudioStemTask(ctx, &audioStem.QueryGetAudioStemTaskRequest{Index: "1"})
+	require.NoError(t, err)
+	require.True(t, res.AudioStemTask.Completed)
+	require.True(t, res.AudioStemTask.Reward.IsZero())
+
+	outDir := t.TempDir()
+	manifest, err := cli.FetchResults(context.Background(), res.AudioStemTask, outDir)
+	require.NoError(t, err)
+	require.Empty(t, manifest.Missing)
+	require.Len(t, manifest.Threads, 1)
+	require.Len(t, manifest.Threads[0].Stems, len(stems))
+	require.FileExists(t, filepath.Join(outDir, "1-0", "vocals.wav"))
+}
+
+func TestAddWorker_TopsUpStake(t *testing.T) {
+	k, bankKeeper, ctx := newBankKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	stake := *audioStem.DefaultParams().MinWorkerStaking
+	worker := newAccount(t, ctx, bankKeeper, stake.Add(stake))
+	_, err := ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: worker.address, PublicIp: "127.0.0.1", IpfsId: "peer", Stake: stake})
+	require.NoError(t, err)
+	_, err = ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: worker.address, PublicIp: "127.0.0.1", IpfsId: "peer", Stake: stake})
+	require.ErrorContains(t, err, "already registered")
+
+	// a slash left the worker disabled with half of the minimum stake
+	registered, err := k.Workers.Get(ctx, worker.address)
+	require.NoError(t, err)
+	half := sdk.NewCoin(stake.Denom, stake.Amount.QuoRaw(2))
+	registered.Reputation.Staked = &half
+	registered.Reputation.Solutions = 3
+	registered.Enabled = false
+	require.NoError(t, k.Workers.Set(ctx, worker.address, registered))
+
+	quarter := sdk.NewCoin(stake.Denom, stake.Amount.QuoRaw(4))
+	_, err = ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: worker.address, PublicIp: "127.0.0.1", IpfsId: "peer", Stake: quarter})
+	require.ErrorContains(t, err, "not enought")
+	_, err = ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: worker.address, PublicIp: "127.0.0.2", IpfsId: "peer", Stake: half})
+	require.NoError(t, err)
+
+	registered, err = k.Workers.Get(ctx, worker.address)
+	require.NoError(t, err)
+	require.True(t, registered.Enabled)
+	require.Equal(t, stake, *registered.Reputation.Staked)
+	require.Equal(t, int32(3), registered.Reputation.Solutions)
+	require.Equal(t, "127.0.0.2", registered.PublicIp)
+	require.Equal(t, half.Amount, bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(worker.address), stake.Denom).Amount)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/janction/audioStem"
+)
+
+// WorkerOf returns the worker a message was sent for. Workers send their messages themselves
+// or through the signer their operator set, in which case the message acts for the operator.
+func (k Keeper) WorkerOf(ctx context.Context, sender string) (string, error) {
+	operator, err := k.WorkerSigners.Get(ctx, sender)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sender, nil
+	}
+	return operator, err
+}
+
+// SetWorkerSigner proposes the signer of the worker, which only signs for it once it accepts
+// with AcceptWorkerSigner, so no address works for a worker without its consent. The current
+// signer keeps signing until then. An empty signer removes the current and the proposed one.
+func (k Keeper) SetWorkerSigner(ctx context.Context, worker *audioStem.Worker, signer string) error {
+	if signer == "" {
+		if err := k.setSigner(ctx, worker, ""); err != nil {
+			return err
+		}
+		worker.PendingSigner = ""
+		return k.Workers.Set(ctx, worker.Address, *worker)
+	}
+
+	if err := k.checkSigner(ctx, worker, signer); err != nil {
+		return err
+	}
+	worker.PendingSigner = signer
+	return k.Workers.Set(ctx, worker.Address, *worker)
+}
+
+// AcceptWorkerSigner makes the signer the operator of the worker proposed sign for it,
+// replacing the previous one.
+func (k Keeper) AcceptWorkerSigner(ctx context.Context, worker *audioStem.Worker, signer string) error {
+	if worker.PendingSigner == "" || worker.PendingSigner != signer {
+		return audioStem.ErrInvalidSigner.Wrapf("worker %s didn't set %s as its signer", worker.Address, signer)
+	}
+	// the signer could have become a worker or a signer since it was proposed
+	if err := k.checkSigner(ctx, worker, signer); err != nil {
+		return err
+	}
+	if err := k.setSigner(ctx, worker, signer); err != nil {
+		return err
+	}
+	worker.PendingSigner = ""
+	return k.Workers.Set(ctx, worker.Address, *worker)
+}
+
+// checkSigner checks the address can sign for the worker.
+func (k Keeper) checkSigner(ctx context.Context, worker *audioStem.Worker, signer string) error {
+	if signer == worker.Address {
+		return audioStem.ErrInvalidSigner.Wrap("the operator already signs its messages")
+	}
+	if operator, err := k.WorkerSigners.Get(ctx, signer); err == nil && operator != worker.Address {
+		return audioStem.ErrInvalidSigner.Wrapf("%s already signs for worker %s", signer, operator)
+	}
+	// a registered worker sends messages for itself
+	if found, err := k.Workers.Has(ctx, signer); err != nil || found {
+		return audioStem.ErrInvalidSigner.Wrapf("%s is a worker", signer)
+	}
+	return nil
+}
+
+// setSigner replaces the signer of the worker in the index of signers.
+func (k Keeper) setSigner(ctx context.Context, worker *audioStem.Worker, signer string) error {
+	if worker.Signer != "" {
+		if err := k.WorkerSigners.Remove(ctx, worker.Signer); err != nil {
+			return err
+		}
+	}
+	worker.Signer = signer
+	if signer != "" {
+		return k.WorkerSigners.Set(ctx, signer, worker.Address)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+func TestWorkerSigner(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	operator, hot, other := newCommitter(t), newCommitter(t), newCommitter(t)
+	for _, c := range []committer{operator, other} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true}))
+	}
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{{ThreadId: "1-0", TaskId: "1"}}})
+	require.NoError(t, k.Params.Set(ctx, audioStem.DefaultParams()))
+
+	// the key of the signer can't propose for the operator before it is set
+	signed := committer{address: operator.address, key: hot.key, salt: hot.salt}
+	proposal := signed.propose(t, testStems(100))
+	proposal.Creator = hot.address
+	_, err := ms.ProposeSolution(ctx, proposal)
+	require.Error(t, err)
+
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address, Signer: hot.address})
+	require.NoError(t, err)
+	_, err = ms.AcceptWorkerSigner(ctx, &audioStem.MsgAcceptWorkerSigner{Creator: hot.address, Worker: operator.address})
+	require.NoError(t, err)
+	worker, err := k.WorkerOf(ctx, hot.address)
+	require.NoError(t, err)
+	require.Equal(t, operator.address, worker)
+
+	// the signer works on behalf of the operator, which is the one on the thread
+	_, err = ms.SubscribeWorkerToTask(ctx, &audioStem.MsgSubscribeWorkerToTask{Address: hot.address, TaskId: "1", ThreadId: "1-0"})
+	require.NoError(t, err)
+	_, err = ms.ProposeSolution(ctx, proposal)
+	require.NoError(t, err)
+	thread, err := k.Threads.Get(ctx, collections.Join("1", uint32(0)))
+	require.NoError(t, err)
+	require.Equal(t, []string{operator.address}, thread.Workers)
+	require.Equal(t, operator.address, thread.Solution.ProposedBy)
+	stored, err := k.Workers.Get(ctx, operator.address)
+	require.NoError(t, err)
+	require.Equal(t, "1", stored.CurrentTaskId)
+
+	// only the operator changes its signer
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: hot.address, Signer: other.address})
+	require.Error(t, err)
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: other.address, Signer: hot.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address, Signer: other.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+	_, err = ms.AddWorker(ctx, &audioStem.MsgAddWorker{Creator: hot.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+
+	// the previous signer keeps signing until the replacement accepts, which frees it
+	replacement := newCommitter(t)
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address, Signer: replacement.address})
+	require.NoError(t, err)
+	worker, err = k.WorkerOf(ctx, hot.address)
+	require.NoError(t, err)
+	require.Equal(t, operator.address, worker)
+	_, err = ms.AcceptWorkerSigner(ctx, &audioStem.MsgAcceptWorkerSigner{Creator: replacement.address, Worker: operator.address})
+	require.NoError(t, err)
+	worker, err = k.WorkerOf(ctx, hot.address)
+	require.NoError(t, err)
+	require.Equal(t, hot.address, worker)
+	worker, err = k.WorkerOf(ctx, replacement.address)
+	require.NoError(t, err)
+	require.Equal(t, operator.address, worker)
+
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address})
+	require.NoError(t, err)
+	worker, err = k.WorkerOf(ctx, replacement.address)
+	require.NoError(t, err)
+	require.Equal(t, replacement.address, worker)
+	stored, err = k.Workers.Get(ctx, operator.address)
+	require.NoError(t, err)
+	require.Empty(t, stored.Signer)
+}
+
+func TestWorkerSigner_Consent(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	ms := msgServer{k: k}
+
+	operator, victim, other := newCommitter(t), newCommitter(t), newCommitter(t)
+	for _, c := range []committer{operator, other} {
+		require.NoError(t, k.Workers.Set(ctx, c.address, audioStem.Worker{Address: c.address, Enabled: true}))
+	}
+
+	// the operator claims an address it doesn't control, which doesn't sign for it
+	_, err := ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address, Signer: victim.address})
+	require.NoError(t, err)
+	worker, err := k.WorkerOf(ctx, victim.address)
+	require.NoError(t, err)
+	require.Equal(t, victim.address, worker)
+	stored, err := k.Workers.Get(ctx, operator.address)
+	require.NoError(t, err)
+	require.Empty(t, stored.Signer)
+	require.Equal(t, victim.address, stored.PendingSigner)
+
+	// the address only accepts the worker that set it
+	_, err = ms.AcceptWorkerSigner(ctx, &audioStem.MsgAcceptWorkerSigner{Creator: victim.address, Worker: other.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+	_, err = ms.AcceptWorkerSigner(ctx, &audioStem.MsgAcceptWorkerSigner{Creator: other.address, Worker: operator.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+
+	// removing the signer withdraws the proposal
+	_, err = ms.SetWorkerSigner(ctx, &audioStem.MsgSetWorkerSigner{Creator: operator.address})
+	require.NoError(t, err)
+	_, err = ms.AcceptWorkerSigner(ctx, &audioStem.MsgAcceptWorkerSigner{Creator: victim.address, Worker: operator.address})
+	require.ErrorIs(t, err, audioStem.ErrInvalidSigner)
+	worker, err = k.WorkerOf(ctx, victim.address)
+	require.NoError(t, err)
+	require.Equal(t, victim.address, worker)
+}
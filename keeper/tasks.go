@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/janction/audioStem"
+)
+
+// SetTask stores the task and keeps the index of open tasks up to date with it. Its threads
+// are stored apart with SetThread, so they are dropped from the stored task. Every change
+// of a task goes through it, so the block hooks only iterate the work that is still pending
+// instead of every task ever created.
+func (k Keeper) SetTask(ctx context.Context, task audioStem.AudioStemTask) error {
+	task.Threads = nil
+	if err := k.AudioStemTasks.Set(ctx, task.TaskId, task); err != nil {
+		return err
+	}
+	return k.indexTask(ctx, task)
+}
+
+// indexTask adds the task to the index of open tasks or removes it from it.
+func (k Keeper) indexTask(ctx context.Context, task audioStem.AudioStemTask) error {
+	return setIndex(ctx, k.PendingAudioStemTasks, task.TaskId, !task.Completed)
+}
+
+// GetTask returns the task together with its threads, as it is served by the queries.
+func (k Keeper) GetTask(ctx context.Context, taskId string) (audioStem.AudioStemTask, error) {
+	task, err := k.AudioStemTasks.Get(ctx, taskId)
+	if err != nil {
+		return task, err
+	}
+	threads, err := k.TaskThreads(ctx, taskId)
+	if err != nil {
+		return task, err
+	}
+	task.Threads = make([]*audioStem.AudioStemThread, len(threads))
+	for i := range threads {
+		task.Threads[i] = &threads[i]
+	}
+	return task, nil
+}
+
+// SetThread stores the thread under its task and index, and keeps the index of revealing
+// threads up to date with it.
+func (k Keeper) SetThread(ctx context.Context, thread audioStem.AudioStemThread) error {
+	key := collections.Join(thread.TaskId, thread.Index)
+	if err := k.Threads.Set(ctx, key, thread); err != nil {
+		return err
+	}
+	return setIndex(ctx, k.RevealingThreads, key, isRevealing(&thread))
+}
+
+// GetThread returns the thread of the task with the given id.
+func (k Keeper) GetThread(ctx context.Context, taskId, threadId string) (audioStem.AudioStemThread, error) {
+	index, err := audioStem.ThreadIndex(taskId, threadId)
+	if err != nil {
+		return audioStem.AudioStemThread{}, err
+	}
+	return k.Threads.Get(ctx, collections.Join(taskId, index))
+}
+
+// TaskThreads returns the threads of the task, ordered by their index.
+func (k Keeper) TaskThreads(ctx context.Context, taskId string) ([]audioStem.AudioStemThread, error) {
+	iter, err := k.Threads.Iterate(ctx, collections.NewPrefixedPairRange[string, uint32](taskId))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// setIndex adds the key to the index or removes it from it. Keys that aren't in the index
+// are not removed, so tasks that never needed action don't leave deletions behind.
+func setIndex[K any](ctx context.Context, index collections.KeySet[K], key K, indexed bool) error {
+	if indexed {
+		return index.Set(ctx, key)
+	}
+	found, err := index.Has(ctx, key)
+	if err != nil || !found {
+		return err
+	}
+	return index.Remove(ctx, key)
+}
+
+// isRevealing returns true if the commitments of the thread are being revealed, so its
+// solution is evaluated once they are revealed or the deadline passes.
+func isRevealing(thread *audioStem.AudioStemThread) bool {
+	return thread.RevealDeadline > 0 && !thread.Completed && thread.Solution != nil && !thread.Solution.Accepted
+}
+
+// PendingTaskIds returns the ids of the tasks that are not completed.
+func (k Keeper) PendingTaskIds(ctx context.Context) ([]string, error) {
+	iter, err := k.PendingAudioStemTasks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+// RevealingThreadKeys returns the task id and index of the threads revealing their
+// commitments.
+func (k Keeper) RevealingThreadKeys(ctx context.Context) ([]collections.Pair[string, uint32], error) {
+	iter, err := k.RevealingThreads.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+)
+
+// storeTask stores the task and its threads, each one at its position in the task.
+func storeTask(t *testing.T, k Keeper, ctx types.Context, task audioStem.AudioStemTask) {
+	require.NoError(t, k.SetTask(ctx, task))
+	for i, thread := range task.Threads {
+		thread.TaskId = task.TaskId
+		thread.Index = uint32(i)
+		require.NoError(t, k.SetThread(ctx, *thread))
+	}
+}
+
+func TestSetTaskIndexes(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	task := audioStem.AudioStemTask{TaskId: "1", Threads: []*audioStem.AudioStemThread{{ThreadId: "1-0"}, {ThreadId: "1-1"}}}
+	storeTask(t, k, ctx, task)
+	taskIds, err := k.PendingTaskIds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, taskIds)
+	keys, err := k.RevealingThreadKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// threads are stored apart from their task
+	stored, err := k.AudioStemTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, stored.Threads)
+	stored, err = k.GetTask(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, stored.Threads, 2)
+	require.Equal(t, uint32(1), stored.Threads[1].Index)
+
+	// once the validations are closed, the thread reveals its commitments
+	thread := *task.Threads[0]
+	thread.Solution = &audioStem.AudioStemThread_Solution{ProposedBy: "worker"}
+	thread.RevealDeadline = 5
+	require.NoError(t, k.SetThread(ctx, thread))
+	keys, err = k.RevealingThreadKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint32]{collections.Join("1", uint32(0))}, keys)
+
+	thread.Solution.Accepted = true
+	require.NoError(t, k.SetThread(ctx, thread))
+	keys, err = k.RevealingThreadKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	task.Completed = true
+	require.NoError(t, k.SetTask(ctx, task))
+	taskIds, err = k.PendingTaskIds(ctx)
+	require.NoError(t, err)
+	require.Empty(t, taskIds)
+}
+
+func TestTaskThreads(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	// the ids of task 1 and task 10 start the same way
+	for _, taskId := range []string{"1", "10"} {
+		storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: taskId, Threads: []*audioStem.AudioStemThread{
+			{ThreadId: taskId + "-0"}, {ThreadId: taskId + "-1"},
+		}})
+	}
+
+	threads, err := k.TaskThreads(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, threads, 2)
+	for i, thread := range threads {
+		require.Equal(t, "1", thread.TaskId)
+		require.Equal(t, uint32(i), thread.Index)
+	}
+
+	thread, err := k.GetThread(ctx, "10", "10-1")
+	require.NoError(t, err)
+	require.Equal(t, "10", thread.TaskId)
+	require.Equal(t, uint32(1), thread.Index)
+	_, err = k.GetThread(ctx, "1", "1-2")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = k.GetThread(ctx, "1", "2-0")
+	require.ErrorIs(t, err, audioStem.ErrInvalidThreadId)
+}
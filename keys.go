@@ -10,4 +10,7 @@ var (
 	WorkerKey                = collections.NewPrefix("audioStemWorker")
 	TaskInfoKey              = collections.NewPrefix(0)
 	PendingAudioStemTasksKey = collections.NewPrefix(1)
+	WorkerSignerKey          = collections.NewPrefix(2)
+	RevealingThreadsKey      = collections.NewPrefix(3)
+	AudioStemThreadKey       = collections.NewPrefix(4)
 )
@@ -0,0 +1,198 @@
+// Package merkle commits to a set of named values with a single root. Any value can later
+// be revealed on its own, together with a proof of its inclusion in the root, so a worker
+// can commit to all its stems at once and reveal only some of them.
+//
+// Trees follow RFC 6962: leaves and nodes are hashed with different prefixes, and a tree
+// of n leaves is split at the largest power of two below n.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// Entry is a value of the tree, identified by its key.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Tree is a merkle tree over entries sorted by key.
+type Tree struct {
+	keys   []string
+	leaves [][]byte
+}
+
+// New builds the tree of the entries. The order of the entries doesn't matter, but their
+// keys must be unique.
+func New(entries []Entry) (*Tree, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("merkle tree without entries")
+	}
+	sorted := slices.Clone(entries)
+	slices.SortFunc(sorted, func(a, b Entry) int { return strings.Compare(a.Key, b.Key) })
+
+	tree := &Tree{}
+	for i, entry := range sorted {
+		if i > 0 && entry.Key == sorted[i-1].Key {
+			return nil, fmt.Errorf("duplicated merkle entry %s", entry.Key)
+		}
+		tree.keys = append(tree.keys, entry.Key)
+		tree.leaves = append(tree.leaves, leafHash(entry))
+	}
+	return tree, nil
+}
+
+// Root returns the hash that commits to every entry of the tree.
+func (t *Tree) Root() []byte {
+	return rootOf(t.leaves)
+}
+
+// Proof returns the proof of inclusion of the entry with the key.
+func (t *Tree) Proof(key string) (Proof, error) {
+	index, found := slices.BinarySearch(t.keys, key)
+	if !found {
+		return Proof{}, fmt.Errorf("merkle entry %s not found", key)
+	}
+	return Proof{Index: index, Total: len(t.leaves), Path: pathOf(index, t.leaves)}, nil
+}
+
+// Proof is the audit path from a leaf to the root of a tree with Total leaves. The root only
+// binds Total through the rightmost leaf, so revealing a whole tree means proving every index
+// from 0 to Total-1.
+type Proof struct {
+	Index int
+	Total int
+	Path  [][]byte
+}
+
+// Root returns the root of the tree the entry belongs to according to the proof. The entry
+// is included in a tree if the result is equal to its root.
+func (p Proof) Root(entry Entry) ([]byte, error) {
+	if p.Index < 0 || p.Index >= p.Total {
+		return nil, fmt.Errorf("merkle index %d out of %d leaves", p.Index, p.Total)
+	}
+
+	// RFC 9162, section 2.1.3.2
+	index, last := p.Index, p.Total-1
+	hash := leafHash(entry)
+	for _, sibling := range p.Path {
+		if last == 0 {
+			return nil, errors.New("merkle proof is too long")
+		}
+		if index%2 == 1 || index == last {
+			hash = nodeHash(sibling, hash)
+			for index%2 == 0 && index != 0 {
+				index >>= 1
+				last >>= 1
+			}
+		} else {
+			hash = nodeHash(hash, sibling)
+		}
+		index >>= 1
+		last >>= 1
+	}
+	if last != 0 {
+		return nil, errors.New("merkle proof is too short")
+	}
+	return hash, nil
+}
+
+// Verify tells if the proof includes the entry in the root.
+func (p Proof) Verify(root []byte, entry Entry) bool {
+	computed, err := p.Root(entry)
+	return err == nil && bytes.Equal(computed, root)
+}
+
+// Encode returns the proof as index,total followed by the hex hashes of the path.
+func (p Proof) Encode() string {
+	parts := []string{strconv.Itoa(p.Index), strconv.Itoa(p.Total)}
+	for _, hash := range p.Path {
+		parts = append(parts, hex.EncodeToString(hash))
+	}
+	return strings.Join(parts, ",")
+}
+
+// DecodeProof parses a proof returned by Encode.
+func DecodeProof(s string) (Proof, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) < 2 {
+		return Proof{}, fmt.Errorf("invalid merkle proof %s", s)
+	}
+	index, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Proof{}, fmt.Errorf("invalid merkle proof index: %w", err)
+	}
+	total, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Proof{}, fmt.Errorf("invalid merkle proof size: %w", err)
+	}
+
+	proof := Proof{Index: index, Total: total}
+	for _, part := range parts[2:] {
+		hash, err := hex.DecodeString(part)
+		if err != nil || len(hash) != sha256.Size {
+			return Proof{}, fmt.Errorf("invalid merkle proof hash %s", part)
+		}
+		proof.Path = append(proof.Path, hash)
+	}
+	return proof, nil
+}
+
+// leafHash hashes the key with its length, so the boundary between key and value is unambiguous.
+func leafHash(entry Entry) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(binary.AppendUvarint(nil, uint64(len(entry.Key))))
+	h.Write([]byte(entry.Key))
+	h.Write([]byte(entry.Value))
+	return h.Sum(nil)
+}
+
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// split returns the largest power of two smaller than n.
+func split(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+func rootOf(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := split(len(leaves))
+	return nodeHash(rootOf(leaves[:k]), rootOf(leaves[k:]))
+}
+
+func pathOf(index int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+	k := split(len(leaves))
+	if index < k {
+		return append(pathOf(index, leaves[:k]), rootOf(leaves[k:]))
+	}
+	return append(pathOf(index-k, leaves[k:]), rootOf(leaves[:k]))
+}
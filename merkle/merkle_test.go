@@ -0,0 +1,84 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func entries(n int) []Entry {
+	var result []Entry
+	for i := 0; i < n; i++ {
+		result = append(result, Entry{Key: fmt.Sprintf("stem%02d.wav", i), Value: fmt.Sprintf("hash%d", i)})
+	}
+	return result
+}
+
+func TestProofs(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		tree, err := New(entries(n))
+		require.NoError(t, err)
+		root := tree.Root()
+
+		for _, entry := range entries(n) {
+			proof, err := tree.Proof(entry.Key)
+			require.NoError(t, err)
+			require.True(t, proof.Verify(root, entry), "%d leaves, entry %s", n, entry.Key)
+
+			decoded, err := DecodeProof(proof.Encode())
+			require.NoError(t, err)
+			require.True(t, decoded.Verify(root, entry))
+
+			require.False(t, proof.Verify(root, Entry{Key: entry.Key, Value: "other"}))
+			if n > 1 {
+				wrongIndex := proof
+				wrongIndex.Index = (proof.Index + 1) % n
+				require.False(t, wrongIndex.Verify(root, entry))
+			}
+			// the size is only bound to the path of the rightmost leaf
+			if proof.Index == n-1 {
+				wrongSize := proof
+				wrongSize.Total++
+				require.False(t, wrongSize.Verify(root, entry))
+			}
+		}
+	}
+}
+
+func TestRootIsCanonical(t *testing.T) {
+	ordered, err := New(entries(5))
+	require.NoError(t, err)
+
+	reversed := entries(5)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	shuffled, err := New(reversed)
+	require.NoError(t, err)
+	require.Equal(t, ordered.Root(), shuffled.Root())
+
+	// the boundary between key and value is part of the leaf
+	a, err := New([]Entry{{Key: "ab", Value: "c"}})
+	require.NoError(t, err)
+	b, err := New([]Entry{{Key: "a", Value: "bc"}})
+	require.NoError(t, err)
+	require.NotEqual(t, a.Root(), b.Root())
+}
+
+func TestInvalidTrees(t *testing.T) {
+	_, err := New(nil)
+	require.Error(t, err)
+	_, err = New([]Entry{{Key: "a"}, {Key: "a"}})
+	require.ErrorContains(t, err, "duplicated")
+
+	tree, err := New(entries(3))
+	require.NoError(t, err)
+	_, err = tree.Proof("missing.wav")
+	require.Error(t, err)
+
+	for _, s := range []string{"", "1", "a,2", "0,b", "0,2,zz", "0,2,abcd"} {
+		_, err := DecodeProof(s)
+		require.Error(t, err, s)
+	}
+}
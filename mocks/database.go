@@ -1,18 +1,82 @@
 package mocks
 
-import "github.com/stretchr/testify/mock"
+import (
+	"github.com/janction/audioStem/db"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ db.Database = (*DB)(nil)
 
 type DB struct {
 	mock.Mock
 }
 
+func (m *DB) AddTask(taskId, threadId string) error {
+	args := m.Called(taskId, threadId)
+	return args.Error(0)
+}
+
+func (m *DB) ReadTask(taskId, threadId string) (*db.Task, error) {
+	args := m.Called(taskId, threadId)
+	task, _ := args.Get(0).(*db.Task)
+	return task, args.Error(1)
+}
+
 func (m *DB) UpdateTask(taskId, threadId string, workerSubscribed bool) error {
 	args := m.Called(taskId, threadId, workerSubscribed)
 	return args.Error(0)
 }
 
-func (m *DB) UpdateThread(id string, downloadStarted, downloadCompleted, workStarted, workCompleted, solProposed, verificationStarted, solutionRevealed bool, submitionStarted bool) error {
-	args := m.Called(id, downloadStarted, downloadCompleted, workStarted, workCompleted, solProposed, verificationStarted, solutionRevealed, submitionStarted)
+func (m *DB) ReadSubscribedTasks() ([]db.Task, error) {
+	args := m.Called()
+	tasks, _ := args.Get(0).([]db.Task)
+	return tasks, args.Error(1)
+}
+
+func (m *DB) AddThread(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *DB) ReadThread(id string) (*db.Thread, error) {
+	args := m.Called(id)
+	thread, _ := args.Get(0).(*db.Thread)
+	return thread, args.Error(1)
+}
+
+func (m *DB) DeleteThread(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *DB) RenameThread(taskId, from, to string) error {
+	args := m.Called(taskId, from, to)
+	return args.Error(0)
+}
+
+func (m *DB) TransitionThread(id string, from, to db.ThreadState) error {
+	args := m.Called(id, from, to)
+	return args.Error(0)
+}
+
+func (m *DB) ReadThreadTransitions(id string) ([]db.ThreadTransition, error) {
+	args := m.Called(id)
+	transitions, _ := args.Get(0).([]db.ThreadTransition)
+	return transitions, args.Error(1)
+}
+
+func (m *DB) Addworker(address string) error {
+	args := m.Called(address)
+	return args.Error(0)
+}
+
+func (m *DB) IsWorkerRegistered(address string) (bool, error) {
+	args := m.Called(address)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *DB) DeleteWorker(address string) error {
+	args := m.Called(address)
 	return args.Error(0)
 }
 
@@ -20,3 +84,97 @@ func (m *DB) AddLogEntry(threadId, log string, timestamp, severity int64) error
 	args := m.Called(threadId, log, timestamp, severity)
 	return args.Error(0)
 }
+
+func (m *DB) ReadLogs(filter db.LogFilter) ([]db.LogEntry, int, error) {
+	args := m.Called(filter)
+	logs, _ := args.Get(0).([]db.LogEntry)
+	return logs, args.Int(1), args.Error(2)
+}
+
+func (m *DB) PruneLogs(before int64) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *DB) AddIPFSWorker(address string) error {
+	args := m.Called(address)
+	return args.Error(0)
+}
+
+func (m *DB) IsIPFSWorkerAdded(address string) (bool, error) {
+	args := m.Called(address)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *DB) AddPin(threadId, cid string) error {
+	args := m.Called(threadId, cid)
+	return args.Error(0)
+}
+
+func (m *DB) ReadPins(threadId string) ([]string, error) {
+	args := m.Called(threadId)
+	cids, _ := args.Get(0).([]string)
+	return cids, args.Error(1)
+}
+
+func (m *DB) DeletePin(threadId, cid string) error {
+	args := m.Called(threadId, cid)
+	return args.Error(0)
+}
+
+func (m *DB) SetSalt(threadId, salt string) error {
+	args := m.Called(threadId, salt)
+	return args.Error(0)
+}
+
+func (m *DB) ReadSalt(threadId string) (string, error) {
+	args := m.Called(threadId)
+	return args.String(0), args.Error(1)
+}
+
+func (m *DB) AddRenderDuration(threadId string, threadNumber, durationInSeconds int) error {
+	args := m.Called(threadId, threadNumber, durationInSeconds)
+	return args.Error(0)
+}
+
+func (m *DB) GetAverageRenderTime(threadId string) (int, error) {
+	args := m.Called(threadId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *DB) EnqueueJob(kind db.JobKind, key string, payload []byte, maxAttempts int) (bool, error) {
+	args := m.Called(kind, key, payload, maxAttempts)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *DB) ClaimJobs(now int64, limit int) ([]db.Job, error) {
+	args := m.Called(now, limit)
+	jobs, _ := args.Get(0).([]db.Job)
+	return jobs, args.Error(1)
+}
+
+func (m *DB) CompleteJob(id int64) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *DB) FailJob(id int64, jobErr error, retryAt int64) error {
+	args := m.Called(id, jobErr, retryAt)
+	return args.Error(0)
+}
+
+func (m *DB) ResetRunningJobs() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *DB) ReadJob(id int64) (*db.Job, error) {
+	args := m.Called(id)
+	job, _ := args.Get(0).(*db.Job)
+	return job, args.Error(1)
+}
+
+func (m *DB) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
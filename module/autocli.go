@@ -19,6 +19,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "index"},
 					},
 				},
+				{
+					RpcMethod: "GetAudioStemThread",
+					Use:       "get-audio-stem-thread taskId index",
+					Short:     "Gets a single thread of a task by its index",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "task_id"},
+						{ProtoField: "index"},
+					},
+				},
 				{
 					RpcMethod: "GetPendingAudioStemTasks",
 					Use:       "get-pending-audio-stem-tasks",
@@ -32,6 +41,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkers",
+					Use:       "get-workers",
+					Short:     "Gets the registered workers",
+				},
+				{
+					RpcMethod: "GetParams",
+					Use:       "get-params",
+					Short:     "Gets the parameters of the module",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -55,7 +74,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "AddWorker",
 					Use:       "add-worker [public_ip] [ipfs_id] [stake]--from [workerAddress]",
 					Short:     "Registers a new worker that will perform audio stem tasks",
-					Long:      "", // TODO Add long
+					Long:      "Registers a new worker that will perform audio stem tasks. A worker disabled because slashes left its stake below the minimum runs it again to top the stake up.",
 					Example:   "", // TODO add exampe
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "public_ip"},
@@ -77,7 +96,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "ProposeSolution",
-					Use:       "propose-solution [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
+					Use:       "propose-solution [taskId] [threadId] [publicKey] [commitment] [signature] --from [workerAddress]",
 					Short:     "Proposes a solution to a thread.",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
@@ -85,7 +104,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
 						{ProtoField: "public_key"},
-						{ProtoField: "signatures", Varargs: true},
+						{ProtoField: "commitment"},
+						{ProtoField: "signature"},
 					},
 				},
 				{
@@ -103,7 +123,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "SubmitValidation",
-					Use:       "submit-validation [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
+					Use:       "submit-validation [taskId] [threadId] [publicKey] [commitment] [signature] --from [workerAddress]",
 					Short:     "Submit a validation to a proposed solution",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
@@ -111,21 +131,84 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
 						{ProtoField: "public_key"},
-						{ProtoField: "signatures", Varargs: true},
+						{ProtoField: "commitment"},
+						{ProtoField: "signature"},
 					},
 				},
 				{
 					RpcMethod: "RevealSolution",
-					Use:       "reveal-solution [taskId] [threadId] [solution] --from [workerAddress]",
+					Use:       "reveal-solution [taskId] [threadId] [salt] [stems] --from [workerAddress]",
 					Short:     "Reveals the CiDs of the solution",
-					Long:      "", // TODO Add long
-					Example:   "", // TODO add exampe
+					Long:      "Reveals the stems committed in the proposed solution, together with the salt of the commitment. Each stem is a JSON file with its filename, cid, hash, fingerprint and proof.",
+					Example:   "reveal-solution 1 1-0 salt vocals.wav.json drums.wav.json bass.wav.json other.wav.json --from worker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+						{ProtoField: "salt"},
+						{ProtoField: "stems", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RevealValidation",
+					Use:       "reveal-validation [taskId] [threadId] [salt] [stems] --from [workerAddress]",
+					Short:     "Reveals the stems committed in a validation",
+					Long:      "Reveals the stems committed in a validation, together with the salt of the commitment. Each stem is a JSON file with its filename, cid, hash, fingerprint and proof. Any subset of the committed stems can be revealed.",
+					Example:   "reveal-validation 1 1-0 salt vocals.wav.json bass.wav.json --from worker",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
+						{ProtoField: "salt"},
 						{ProtoField: "stems", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "ReportInvalidInput",
+					Use:       "report-invalid-input [taskId] [threadId] [reason] --from [workerAddress]",
+					Short:     "Reports the input of a task as audio that can't be decoded",
+					Long:      "Once enough workers report the input of a task, the task is rejected and the requester refunded minus the probing fee.",
+					Example:   "report-invalid-input 1 1-0 \"unsupported format\" --from alice",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+						{ProtoField: "reason"},
+					},
+				},
+				{
+					RpcMethod: "LeaveThread",
+					Use:       "leave-thread [taskId] [threadId] --from [workerAddress]",
+					Short:     "Leaves a thread whose input the worker can't process",
+					Long:      "Frees the place of the worker in the thread, so another worker can take it. Only workers that didn't propose or validate a solution of the thread can leave it.",
+					Example:   "leave-thread 1 1-0 --from alice",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+					},
+				},
+				{
+					RpcMethod: "SetWorkerSigner",
+					Use:       "set-worker-signer [signer] --from [workerAddress]",
+					Short:     "Lets a hot key send the messages of a worker once it accepts",
+					Long:      "Sent by the operator that staked the worker. Once the signer accepts with accept-worker-signer, it subscribes, proposes, validates, reveals and submits on behalf of the worker, while winnings and slashes apply to the operator. Without a signer, the current one is removed.",
+					Example:   "set-worker-signer cosmos1... --from operator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signer", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "AcceptWorkerSigner",
+					Use:       "accept-worker-signer [workerAddress] --from [signer]",
+					Short:     "Accepts to send the messages of a worker",
+					Long:      "Sent by the hot key the operator of the worker set with set-worker-signer. Until it accepts, the key can't act for the worker.",
+					Example:   "accept-worker-signer cosmos1... --from signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					// only the authority can update the params, through a governance proposal
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
 			},
 		},
 	}
@@ -4,12 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"path/filepath"
-	"slices"
-	"strconv"
 
 	"cosmossdk.io/core/appmodule"
-	"cosmossdk.io/math"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,9 +16,7 @@ import (
 
 	"github.com/janction/audioStem"
 	"github.com/janction/audioStem/audioStemLogger"
-	"github.com/janction/audioStem/ipfs"
 	"github.com/janction/audioStem/keeper"
-	"github.com/janction/audioStem/vm"
 )
 
 var (
@@ -32,14 +26,15 @@ var (
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 6
 
 type AppModule struct {
 	cdc    codec.Codec
 	keeper keeper.Keeper
 }
 
-// NewAppModule creates a new AppModule object
+// NewAppModule creates a new AppModule object. The off-chain work of a worker isn't done
+// by the module, but by the worker daemon that follows the chain.
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 	return AppModule{
 		cdc:    cdc,
@@ -80,10 +75,22 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	audioStem.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(audioStem.ModuleName, 1, m.Migrate1to2); err != nil {
-	//     panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", audioStem.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(audioStem.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", audioStem.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(audioStem.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", audioStem.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(audioStem.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", audioStem.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(audioStem.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", audioStem.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(audioStem.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", audioStem.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the module.
@@ -122,141 +129,42 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-func (am AppModule) getPendingAudioStemTask(ctx context.Context) (bool, audioStem.AudioStemTask) {
-	params, _ := am.keeper.Params.Get(ctx)
-	ti, err := am.keeper.AudioStemTaskInfo.Get(ctx)
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	k := am.keeper
 
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	// we only look at the threads revealing their commitments, evaluating the revealed ones
+	keys, err := k.RevealingThreadKeys(ctx)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	nextId := int(ti.NextId)
-	for i := 0; i < nextId; i++ {
-		task, err := am.keeper.AudioStemTasks.Get(ctx, strconv.Itoa(i))
+	for _, key := range keys {
+		thread, err := k.Threads.Get(ctx, key)
 		if err != nil {
 			continue
 		}
+		// commitments are evaluated once everyone revealed them or the deadline passed
+		revealed := thread.RevealDeadline > 0 && (thread.IsRevealed() || height > thread.RevealDeadline)
+		if revealed && !thread.Completed && thread.Solution != nil && !thread.Solution.Accepted {
+			audioStemLogger.Logger.Info("Solution revealed, we verify it for thread %s ", thread.ThreadId)
 
-		// we only search for in progress and with the reward this node will accept
-		if !task.Completed && task.Reward.Amount.GTE(math.NewInt(am.keeper.Configuration.MinReward)) {
-			for _, value := range task.Threads {
-				if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) {
-					return true, task
-				}
-			}
-		}
-	}
-	return false, audioStem.AudioStemTask{}
-}
-
-func (am AppModule) BeginBlock(ctx context.Context) error {
-	k := am.keeper
-
-	// we adjust the amount of minimum validators per thread based on the amount of
-	// registered workers
-	params, _ := k.Params.Get(ctx)
-	count := 0
-	iterator, _ := k.Workers.Iterate(ctx, nil)
-	for iterator.Valid() {
-		count++
-		iterator.Next()
-	}
-	// we adjust the min validators dinamycally. Max 7
-	if count > 1 && count < 7 {
-		params.MinValidators = int64(count)
-		k.Params.Set(ctx, params)
-	}
-
-	if k.Configuration.Enabled && k.Configuration.WorkerAddress != "" {
-		worker, _ := k.Workers.Get(ctx, k.Configuration.WorkerAddress)
-		if worker.Enabled && worker.CurrentTaskId != "" {
-			// we have to start some work!
-			task, err := k.AudioStemTasks.Get(ctx, worker.CurrentTaskId)
+			task, err := k.AudioStemTasks.Get(ctx, key.K1())
 			if err != nil {
-				audioStemLogger.Logger.Error("error processing task %v. %v", task.TaskId, err.Error())
-				return nil
+				continue
 			}
-			thread := *task.Threads[worker.CurrentThreadIndex]
-			dbThread, _ := k.DB.ReadThread(thread.ThreadId)
-			audioStemLogger.Logger.Info("local thread %s is: downloadStarted: %s, downloadCompleted: %s, workStarted: %s, workCompleted: %s, solutionProposed: %s, verificationStarted: %s, solutionRevealed: %s, submitionStarted: %s", dbThread.ID, strconv.FormatBool(dbThread.DownloadStarted), strconv.FormatBool(dbThread.DownloadCompleted), strconv.FormatBool(dbThread.WorkStarted), strconv.FormatBool(dbThread.WorkCompleted), strconv.FormatBool(dbThread.SolutionProposed), strconv.FormatBool(dbThread.VerificationStarted), strconv.FormatBool(dbThread.SolutionRevealed), strconv.FormatBool(dbThread.SubmitionStarted))
-
-			workPath := filepath.Join(k.Configuration.RootPath, "audioStems", thread.ThreadId)
-
-			if !thread.Completed && !dbThread.DownloadStarted {
-				audioStemLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
-			} else {
-				if dbThread.WorkStarted {
-					// if we are already working but the container is exited, it means there was an error, so we trigger it again
-					isExited, err := vm.IsContainerExited(thread.ThreadId)
-					if err != nil {
-						audioStemLogger.Logger.Error("unable to determine if container myBlender%s is running: %s", thread.ThreadId, err.Error())
-					}
-					if isExited {
-						audioStemLogger.Logger.Info("container myBlender%s is existed. We restarted", thread.ThreadId)
-						go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
-					}
-
-				}
-
-				// if ipfs didn't download yet, then we make sure we are still downloading a file at least
-				if !dbThread.DownloadCompleted {
-					if !ipfs.IsDownloadStarted(workPath) {
-						audioStemLogger.Logger.Info("IPFS hasn't downloaded any file. Resetting work...")
-						k.DB.UpdateThread(thread.ThreadId, false, false, false, false, false, false, false, false)
-					} else {
-						audioStemLogger.Logger.Debug("ipfs download in progress...")
-					}
-				}
+			if err := k.ResolveReveals(ctx, &task, &thread); err != nil {
+				audioStemLogger.Logger.Error("unable to resolve reveals of thread %s: %s", thread.ThreadId, err.Error())
+				continue
 			}
-
-			// we completed the work, so lets propose a solution
-			if thread.Solution == nil && dbThread.WorkCompleted && !dbThread.SolutionProposed {
-				audioStemLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.ProposeSolution(am.cdc, k.Configuration.WorkerName, worker.Address, k.Configuration.RootPath, &k.DB)
+			if err := k.SetThread(ctx, thread); err != nil {
+				return err
 			}
-
-			// someone already submited solution, lets submit our verification
-			if thread.Solution != nil && thread.Solution.ProposedBy != "" && !dbThread.VerificationStarted {
-				// start verification
-				audioStemLogger.Logger.Info("Started verification for thread %s", thread.ThreadId)
-				go thread.SubmitVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, k.Configuration.RootPath, &k.DB)
+			if err := k.SetTask(ctx, task); err != nil {
+				return err
 			}
 		}
 	}
 
-	// Thread validationwork  can be executed by any node, being worker or not
-	// we iterate for each video rendering task, looking for pending validations
-	am.keeper.AudioStemTasks.Walk(ctx, nil, func(key string, task audioStem.AudioStemTask) (bool, error) {
-		if !task.Completed {
-			for _, thread := range task.Threads {
-				if (len(thread.Validations) > 1 || len(thread.Validations) == len(thread.Workers)) && !thread.Completed && thread.Solution != nil && !thread.Solution.Accepted {
-					audioStemLogger.Logger.Info("Solution revealed, we verify it for thread %s ", thread.ThreadId)
-
-					thread.EvaluateVerifications()
-					accepted := thread.IsSolutionAccepted()
-					if accepted {
-						thread.Solution.Accepted = true
-						k.AudioStemTasks.Set(ctx, task.TaskId, task)
-					} else {
-						// we might not have enought validations or solution is not valid
-						// TODO implement
-					}
-
-				}
-
-				// if we are the node that needs to submit the solution of an accepted thread
-				// then we so it here
-				if thread.Solution != nil && thread.Solution.Accepted && thread.Solution.Dir == "" && thread.Solution.ProposedBy == am.keeper.Configuration.WorkerAddress {
-					localThread, _ := am.keeper.DB.ReadThread(thread.ThreadId)
-					if !localThread.SubmitionStarted {
-						go thread.SubmitSolution(ctx, am.keeper.Configuration.WorkerAddress, am.keeper.Configuration.RootPath, &am.keeper.DB)
-					}
-				}
-			}
-		}
-		return false, nil // keep walking
-	})
-
 	return nil
 }
 
@@ -265,124 +173,36 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 func (am AppModule) EndBlock(ctx context.Context) error {
 	k := am.keeper
 
-	// we validate if this node is enabled to perform work
-	if k.Configuration.Enabled && k.Configuration.WorkerAddress != "" {
-		// we validate if the worker is idle
-		worker, _ := k.Workers.Get(ctx, k.Configuration.WorkerAddress)
-
-		if worker.Address == "" {
-			isRegistered, _ := k.DB.IsWorkerRegistered(k.Configuration.WorkerAddress)
-			if !isRegistered {
-				// the worker is not registered, so we do it with the stake
-				params, _ := am.keeper.Params.Get(ctx)
-				audioStemLogger.Logger.Info("Registering Worker %s", k.Configuration.WorkerAddress)
-				go worker.RegisterWorker(k.Configuration.WorkerAddress, *params.MinWorkerStaking, &k.DB)
-			}
+	// only the tasks that are not completed yet can complete
+	taskIds, err := k.PendingTaskIds(ctx)
+	if err != nil {
+		return err
+	}
+	for _, taskId := range taskIds {
+		task, err := k.AudioStemTasks.Get(ctx, taskId)
+		if err != nil {
+			continue
 		}
-
-		if worker.Enabled && worker.CurrentTaskId == "" {
-			// we find any task in progress that has enought reward
-			audioStemLogger.Logger.Info(" worker %v is idle ", worker.Address)
-			found, task := am.getPendingAudioStemTask(ctx)
-			audioStemLogger.Logger.Debug("Found task: %v, taskId: %s", found, task.TaskId)
-			if found {
-				params, _ := am.keeper.Params.Get(ctx)
-				for _, value := range task.Threads {
-
-					if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) && !slices.Contains(value.Workers, worker.Address) {
-						//we found our next thread
-						dbTask, _ := k.DB.ReadTask(task.TaskId, value.ThreadId)
-						if !dbTask.WorkerSubscribed {
-							audioStemLogger.Logger.Info(" registering worker %v in task %s thread %s ", worker.Address, task.TaskId, value.ThreadId)
-							k.DB.UpdateTask(task.TaskId, value.ThreadId, true)
-							go task.SubscribeWorkerToTask(ctx, worker.Address, task.TaskId, value.ThreadId, &k.DB)
-							break
-						}
-					}
-				}
-			} else {
-				audioStemLogger.Logger.Info("No audio Stem tasks available for me to work on")
-			}
+		threads, err := k.TaskThreads(ctx, taskId)
+		if err != nil {
+			continue
 		}
-	}
-
-	maxId, _ := k.AudioStemTaskInfo.Get(ctx)
-	for i := 0; i < int(maxId.NextId); i++ {
-		task, _ := k.AudioStemTasks.Get(ctx, strconv.Itoa(i))
-		if !task.Completed {
-			for _, thread := range task.Threads {
-				if len(thread.Validations) > 0 && len(thread.Workers) > 0 {
-					// we check if we have enought validations to reveal the solution
-					if (len(thread.Validations) > 1 || len(thread.Validations) == len(thread.Workers)) && !thread.Completed && thread.Solution.ProposedBy == am.keeper.Configuration.WorkerAddress {
-						db, _ := k.DB.ReadThread(thread.ThreadId)
-						if !db.SolutionRevealed {
-							// We have reached enought validations, if we are the winning node, is time to reveal the solution
-							audioStemLogger.Logger.Info("Time to reveal solution!!!!!!")
-							go thread.RevealSolution(am.keeper.Configuration.RootPath, &k.DB)
-						}
-					}
-				}
+		completed := true
+		for _, thread := range threads {
+			if !thread.Completed {
+				// we found at least one thread not completed, so task isn't complete
+				completed = false
+				break
 			}
 		}
-	}
-
-	for i := 0; i < int(maxId.NextId); i++ {
-		task, _ := k.AudioStemTasks.Get(ctx, strconv.Itoa(i))
-		if !task.Completed {
-			completed := true
-			for _, thread := range task.Threads {
-				if !thread.Completed {
-					// we found at least one thread not completed, so task isn't complete
-					completed = false
-					break
-				}
-			}
-			if completed {
-				// all threads are over, we mark the task as completed
-				task.Completed = true
-				k.AudioStemTasks.Set(ctx, task.TaskId, task)
+		if completed {
+			// all threads are over, we mark the task as completed
+			task.Completed = true
+			if err := k.SetTask(ctx, task); err != nil {
+				return err
 			}
 		}
 	}
 
-	// we now will connect to the IPFS nodes of new workers
-	k.Workers.Walk(ctx, nil, func(address string, worker audioStem.Worker) (stop bool, err error) {
-		isAdded, _ := k.DB.IsIPFSWorkerAdded(address)
-		if worker.IpfsId != "" && worker.PublicIp != "" && !isAdded {
-			audioStemLogger.Logger.Info("Connecting to IPFS node %s at %s", worker.IpfsId, worker.PublicIp)
-			ipfs.EnsureIPFSRunning()
-			go ipfs.ConnectToIPFSNode(worker.PublicIp, worker.IpfsId)
-
-			// ✅ Mark worker as processed
-			am.keeper.DB.AddIPFSWorker(address)
-			return true, nil
-		}
-		return false, nil // Continue iterating
-	})
-
-	return nil
-}
-
-func (am AppModule) EvaluateCompletedThread(ctx context.Context, task *audioStem.AudioStemTask, index int) error {
-	//TODO  implement validations. What happens if a validation is false?
-	thread := task.Threads[index]
-
-	for _, worker := range thread.Workers {
-		// we reset all workers
-		worker, _ := am.keeper.Workers.Get(ctx, worker)
-		worker.CurrentTaskId = ""
-		worker.CurrentThreadIndex = 0
-		// we increase reputation
-		worker.Reputation.Points = worker.Reputation.Points + 1
-		worker.Reputation.Validations = worker.Reputation.Validations + 1
-		// we pay for the validation
-		winning := thread.GetValidatorReward(worker.Address, task.GetValidatorsReward())
-		worker.Reputation.Winnings = worker.Reputation.Winnings.Add(winning)
-		am.keeper.Workers.Set(ctx, worker.Address, worker)
-	}
-
-	task.Threads[index].Completed = true
-	am.keeper.AudioStemTasks.Set(ctx, task.TaskId, *task)
-
 	return nil
 }
@@ -0,0 +1,116 @@
+package module
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/keeper"
+)
+
+// newTestModule returns the module over a keeper backed by an in-memory store, without a bank.
+func newTestModule(tb testing.TB) (AppModule, testutil.TestContext) {
+	key := storetypes.NewKVStoreKey(audioStem.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(tb, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authority := authtypes.NewModuleAddress("gov")
+
+	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), runtime.NewKVStoreService(key), authority.String(), bankkeeper.BaseKeeper{})
+
+	testCtx.Ctx = testCtx.Ctx.WithBlockHeight(1)
+	require.NoError(tb, k.Params.Set(testCtx.Ctx, audioStem.DefaultParams()))
+	require.NoError(tb, k.AudioStemTaskInfo.Set(testCtx.Ctx, audioStem.AudioStemTaskInfo{NextId: 1}))
+	return NewAppModule(encCfg.Codec, k), testCtx
+}
+
+// revealingTask returns a task whose only thread reveals its commitments until the deadline.
+func revealingTask(taskId string, deadline int64) (audioStem.AudioStemTask, audioStem.AudioStemThread) {
+	return audioStem.AudioStemTask{TaskId: taskId}, audioStem.AudioStemThread{
+		TaskId:         taskId,
+		ThreadId:       taskId + "-0",
+		Workers:        []string{"worker"},
+		Solution:       &audioStem.AudioStemThread_Solution{ProposedBy: "worker"},
+		RevealDeadline: deadline,
+	}
+}
+
+// storeTask stores the task and its thread.
+func storeTask(tb testing.TB, k keeper.Keeper, ctx sdk.Context, task audioStem.AudioStemTask, thread audioStem.AudioStemThread) {
+	require.NoError(tb, k.SetTask(ctx, task))
+	require.NoError(tb, k.SetThread(ctx, thread))
+}
+
+func TestBlockHooks(t *testing.T) {
+	am, testCtx := newTestModule(t)
+	k, ctx := am.keeper, testCtx.Ctx
+
+	task, thread := revealingTask("1", 5)
+	storeTask(t, k, ctx, task, thread)
+	storeTask(t, k, ctx, audioStem.AudioStemTask{TaskId: "2"}, audioStem.AudioStemThread{TaskId: "2", ThreadId: "2-0", Completed: true})
+
+	// before the deadline, the solution can still be revealed
+	require.NoError(t, am.BeginBlock(ctx.WithBlockHeight(5)))
+	thread, err := k.GetThread(ctx, "1", "1-0")
+	require.NoError(t, err)
+	require.NotNil(t, thread.Solution)
+
+	// the solution wasn't revealed in time, so it is rejected
+	require.NoError(t, am.BeginBlock(ctx.WithBlockHeight(6)))
+	thread, err = k.GetThread(ctx, "1", "1-0")
+	require.NoError(t, err)
+	require.Nil(t, thread.Solution)
+	require.Equal(t, []string{"worker"}, thread.RejectedWorkers)
+	keys, err := k.RevealingThreadKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	require.NoError(t, am.EndBlock(ctx))
+	done, err := k.AudioStemTasks.Get(ctx, "2")
+	require.NoError(t, err)
+	require.True(t, done.Completed)
+	taskIds, err := k.PendingTaskIds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, taskIds)
+}
+
+// BenchmarkBlockHooks shows the cost of a block doesn't depend on the amount of completed
+// tasks, only on the work still pending.
+func BenchmarkBlockHooks(b *testing.B) {
+	const open = 10
+	for _, completed := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("completed=%d", completed), func(b *testing.B) {
+			am, testCtx := newTestModule(b)
+			ctx := testCtx.Ctx
+			for i := 0; i < completed; i++ {
+				task, thread := revealingTask(strconv.Itoa(i), 1)
+				task.Completed = true
+				thread.Completed = true
+				storeTask(b, am.keeper, ctx, task, thread)
+			}
+			for i := completed; i < completed+open; i++ {
+				task, thread := revealingTask(strconv.Itoa(i), 1<<30)
+				storeTask(b, am.keeper, ctx, task, thread)
+			}
+			require.NoError(b, am.keeper.AudioStemTaskInfo.Set(ctx, audioStem.AudioStemTaskInfo{NextId: int64(completed + open)}))
+			// the tasks were created in previous blocks
+			testCtx.CMS.Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, am.BeginBlock(ctx))
+				require.NoError(b, am.EndBlock(ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package audioStem
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+)
+
+// MaxAmountFiles is the maximum amount of files, and so of threads, of a task.
+const MaxAmountFiles = 100
+
+// Instruments are the stems htdemucs separates, one of them can be requested in a task.
+var Instruments = []string{"vocals", "drums", "bass", "other"}
+
+var (
+	_ sdk.HasValidateBasic = &MsgCreateAudioStemTask{}
+	_ sdk.HasValidateBasic = &MsgAddWorker{}
+	_ sdk.HasValidateBasic = &MsgSubscribeWorkerToTask{}
+	_ sdk.HasValidateBasic = &MsgProposeSolution{}
+	_ sdk.HasValidateBasic = &MsgRevealSolution{}
+	_ sdk.HasValidateBasic = &MsgRevealValidation{}
+	_ sdk.HasValidateBasic = &MsgSubmitValidation{}
+	_ sdk.HasValidateBasic = &MsgSubmitSolution{}
+	_ sdk.HasValidateBasic = &MsgReportInvalidInput{}
+	_ sdk.HasValidateBasic = &MsgLeaveThread{}
+	_ sdk.HasValidateBasic = &MsgSetWorkerSigner{}
+	_ sdk.HasValidateBasic = &MsgAcceptWorkerSigner{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// ValidateBasic performs the stateless checks of the task.
+func (msg *MsgCreateAudioStemTask) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := cid.Decode(msg.Cid); err != nil {
+		return ErrInvalidCid.Wrapf("%s: %s", msg.Cid, err)
+	}
+	if msg.AmountFiles < 1 || msg.AmountFiles > MaxAmountFiles {
+		return ErrInvalidAmountFiles.Wrapf("%d files, must be between 1 and %d", msg.AmountFiles, MaxAmountFiles)
+	}
+	if !slices.Contains(Instruments, msg.Instrument) {
+		return ErrInvalidInstrument.Wrapf("%q is not one of %v", msg.Instrument, Instruments)
+	}
+	if msg.Reward == nil {
+		return ErrInvalidCoin.Wrap("reward is missing")
+	}
+	return validatePositiveCoin(*msg.Reward)
+}
+
+// ValidateBasic performs the stateless checks of the worker. The public ip and the ipfs id
+// are optional, but must be valid when set.
+func (msg *MsgAddWorker) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.PublicIp != "" && net.ParseIP(msg.PublicIp) == nil {
+		return ErrWorkerInvalidPublicIp.Wrap(msg.PublicIp)
+	}
+	if msg.IpfsId != "" {
+		if _, err := peer.Decode(msg.IpfsId); err != nil {
+			return ErrWorkerInvalidIpfsId.Wrapf("%s: %s", msg.IpfsId, err)
+		}
+	}
+	return validatePositiveCoin(msg.Stake)
+}
+
+// ValidateBasic performs the stateless checks of the subscription.
+func (msg *MsgSubscribeWorkerToTask) ValidateBasic() error {
+	if err := validateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidateThreadId(msg.TaskId, msg.ThreadId)
+}
+
+// ValidateBasic performs the stateless checks of the proposed solution.
+func (msg *MsgProposeSolution) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	return validateCommitment(msg.PublicKey, msg.Commitment, msg.Signature)
+}
+
+// ValidateBasic performs the stateless checks of the validation.
+func (msg *MsgSubmitValidation) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	return validateCommitment(msg.PublicKey, msg.Commitment, msg.Signature)
+}
+
+// ValidateBasic performs the stateless checks of the revealed solution.
+func (msg *MsgRevealSolution) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	return validateReveals(msg.Salt, msg.Stems)
+}
+
+// ValidateBasic performs the stateless checks of the revealed validation.
+func (msg *MsgRevealValidation) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	return validateReveals(msg.Salt, msg.Stems)
+}
+
+// ValidateBasic performs the stateless checks of the submitted solution.
+func (msg *MsgSubmitSolution) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	if _, err := cid.Decode(msg.Dir); err != nil {
+		return ErrInvalidCid.Wrapf("%s: %s", msg.Dir, err)
+	}
+	if msg.AverageStemSeconds < 0 {
+		return ErrInvalidSolution.Wrapf("negative average stem seconds %d", msg.AverageStemSeconds)
+	}
+	return nil
+}
+
+// ValidateBasic performs the stateless checks of the report.
+func (msg *MsgReportInvalidInput) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateThreadId(msg.TaskId, msg.ThreadId); err != nil {
+		return err
+	}
+	if strings.TrimSpace(msg.Reason) == "" {
+		return ErrInvalidInputReport.Wrap("reason is empty")
+	}
+	return nil
+}
+
+// ValidateBasic performs the stateless checks of the thread the worker leaves.
+func (msg *MsgLeaveThread) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	return ValidateThreadId(msg.TaskId, msg.ThreadId)
+}
+
+// ValidateBasic performs the stateless checks of the signer. An empty signer removes it.
+func (msg *MsgSetWorkerSigner) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Signer == "" {
+		return nil
+	}
+	if err := validateAddress(msg.Signer); err != nil {
+		return err
+	}
+	if msg.Signer == msg.Creator {
+		return ErrInvalidSigner.Wrap("the operator already signs its messages")
+	}
+	return nil
+}
+
+// ValidateBasic performs the stateless checks of the accepted signer.
+func (msg *MsgAcceptWorkerSigner) ValidateBasic() error {
+	if err := validateAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Worker); err != nil {
+		return err
+	}
+	if msg.Worker == msg.Creator {
+		return ErrInvalidSigner.Wrap("the operator already signs its messages")
+	}
+	return nil
+}
+
+// ValidateBasic performs the stateless checks of the params update.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if err := validateAddress(msg.Authority); err != nil {
+		return err
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return ErrInvalidParams.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ValidateThreadId checks the thread is one of the threads GenerateThreads creates for the
+// task, which are identified by the task id and their index.
+func ValidateThreadId(taskId, threadId string) error {
+	_, err := ThreadIndex(taskId, threadId)
+	return err
+}
+
+// FormatThreadId returns the id of the thread at index of the task. The index is separated
+// from the task id, so the threads of two tasks never share an id.
+func FormatThreadId(taskId string, index uint32) string {
+	return fmt.Sprintf("%s-%d", taskId, index)
+}
+
+// ThreadIndex returns the index of the thread in the task, which together with the task
+// id is the key of the thread in the store.
+func ThreadIndex(taskId, threadId string) (uint32, error) {
+	if _, err := strconv.ParseUint(taskId, 10, 64); err != nil {
+		return 0, ErrInvalidThreadId.Wrapf("invalid task id %q", taskId)
+	}
+	index, found := strings.CutPrefix(threadId, taskId+"-")
+	if !found {
+		return 0, ErrInvalidThreadId.Wrapf("thread %q of task %s", threadId, taskId)
+	}
+	// the index is formatted without leading zeros, so every thread has a single id
+	i, err := strconv.ParseUint(index, 10, 32)
+	if err != nil || strconv.FormatUint(i, 10) != index || i >= MaxAmountFiles {
+		return 0, ErrInvalidThreadId.Wrapf("thread %q of task %s", threadId, taskId)
+	}
+	return uint32(i), nil
+}
+
+func validateAddress(address string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return ErrInvalidAddress.Wrapf("%q: %s", address, err)
+	}
+	return nil
+}
+
+func validatePositiveCoin(coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return ErrInvalidCoin.Wrap(err.Error())
+	}
+	if !coin.IsPositive() {
+		return ErrInvalidCoin.Wrapf("%s is not positive", coin)
+	}
+	return nil
+}
+
+// validateCommitment checks the format of a commitment and its signature. Whether the key
+// belongs to the signer and signed the commitment is checked by the keeper.
+func validateCommitment(publicKey, commitment, signature string) error {
+	if decoded, err := hex.DecodeString(commitment); err != nil || len(decoded) != sha256.Size {
+		return ErrInvalidCommitment.Wrapf("%q is not a hex sha256 hash", commitment)
+	}
+	if key, err := base64.StdEncoding.DecodeString(publicKey); err != nil || len(key) == 0 {
+		return ErrInvalidPublicKey.Wrapf("%q is not base64", publicKey)
+	}
+	if sig, err := audioStemCrypto.DecodeSignatureFromCLI(signature); err != nil || len(sig) == 0 {
+		return ErrInvalidSignature.Wrapf("%q is not base64", signature)
+	}
+	return nil
+}
+
+func validateReveals(salt string, reveals []*StemReveal) error {
+	if salt == "" {
+		return ErrInvalidReveal.Wrap("salt is empty")
+	}
+	if len(reveals) == 0 {
+		return ErrInvalidReveal.Wrap("no stems revealed")
+	}
+	filenames := make(map[string]bool)
+	for _, reveal := range reveals {
+		if reveal == nil || reveal.Filename == "" || reveal.Cid == "" || reveal.Hash == "" {
+			return ErrInvalidReveal.Wrapf("stem %v needs a filename, cid and hash", reveal)
+		}
+		if filenames[reveal.Filename] {
+			return ErrInvalidReveal.Wrapf("stem %s is revealed twice", reveal.Filename)
+		}
+		filenames[reveal.Filename] = true
+	}
+	return nil
+}
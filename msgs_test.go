@@ -0,0 +1,142 @@
+package audioStem
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress    = "cosmos1rhpm69anjmyre6yh0a7dd2luk4nfykc7wa8e5d"
+	testSigner     = "cosmos1kkrrcnz3svt5lmctx37f4w409atya9jmu5xl95"
+	testCid        = "QmdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR1n"
+	testPeerId     = "12D3KooWD3eckifWpRn9wQpMG9R9hX3sD158z7EqHWmweQAJU5SA"
+	testCommitment = "73e1ca4d80bd613c1e9f4601dd7afcc85e6255b199dead781a79be13e82a85b6"
+)
+
+var (
+	testKey       = base64.StdEncoding.EncodeToString([]byte("key"))
+	testSignature = base64.StdEncoding.EncodeToString([]byte("signature"))
+)
+
+func coin(amount int64) *sdk.Coin {
+	return &sdk.Coin{Denom: "jct", Amount: math.NewInt(amount)}
+}
+
+func validReveals() []*StemReveal {
+	return []*StemReveal{{Filename: "bass.wav", Cid: "cid", Hash: "hash"}, {Filename: "vocals.wav", Cid: "cid", Hash: "hash"}}
+}
+
+func TestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  sdk.HasValidateBasic
+		err  *errors.Error
+	}{
+		{"task", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 3, Instrument: "vocals", Reward: coin(10)}, nil},
+		{"task by invalid creator", &MsgCreateAudioStemTask{Creator: "alice", Cid: testCid, AmountFiles: 3, Instrument: "vocals", Reward: coin(10)}, ErrInvalidAddress},
+		{"task with invalid cid", &MsgCreateAudioStemTask{Creator: testAddress, Cid: "cid", AmountFiles: 3, Instrument: "vocals", Reward: coin(10)}, ErrInvalidCid},
+		{"task without files", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 0, Instrument: "vocals", Reward: coin(10)}, ErrInvalidAmountFiles},
+		{"task with negative files", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: -1, Instrument: "vocals", Reward: coin(10)}, ErrInvalidAmountFiles},
+		{"task with too many files", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: MaxAmountFiles + 1, Instrument: "vocals", Reward: coin(10)}, ErrInvalidAmountFiles},
+		{"task without instrument", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 3, Reward: coin(10)}, ErrInvalidInstrument},
+		{"task without reward", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 3, Instrument: "vocals"}, ErrInvalidCoin},
+		{"task with zero reward", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 3, Instrument: "vocals", Reward: coin(0)}, ErrInvalidCoin},
+		{"task with negative reward", &MsgCreateAudioStemTask{Creator: testAddress, Cid: testCid, AmountFiles: 3, Instrument: "vocals", Reward: coin(-1)}, ErrInvalidCoin},
+
+		{"worker", &MsgAddWorker{Creator: testAddress, PublicIp: "203.0.113.7", IpfsId: testPeerId, Stake: *coin(10)}, nil},
+		{"worker with ipv6", &MsgAddWorker{Creator: testAddress, PublicIp: "2001:db8::1", IpfsId: testPeerId, Stake: *coin(10)}, nil},
+		{"worker without ipfs", &MsgAddWorker{Creator: testAddress, Stake: *coin(10)}, nil},
+		{"worker with invalid address", &MsgAddWorker{Creator: "", Stake: *coin(10)}, ErrInvalidAddress},
+		{"worker with invalid ip", &MsgAddWorker{Creator: testAddress, PublicIp: "203.0.113", Stake: *coin(10)}, ErrWorkerInvalidPublicIp},
+		{"worker with invalid ipfs id", &MsgAddWorker{Creator: testAddress, IpfsId: "peer", Stake: *coin(10)}, ErrWorkerInvalidIpfsId},
+		{"worker without stake", &MsgAddWorker{Creator: testAddress, Stake: *coin(0)}, ErrInvalidCoin},
+
+		{"subscription", &MsgSubscribeWorkerToTask{Address: testAddress, TaskId: "1", ThreadId: "1-0"}, nil},
+		{"subscription with invalid address", &MsgSubscribeWorkerToTask{Address: "worker", TaskId: "1", ThreadId: "1-0"}, ErrInvalidAddress},
+		{"subscription to thread of another task", &MsgSubscribeWorkerToTask{Address: testAddress, TaskId: "2", ThreadId: "1-0"}, ErrInvalidThreadId},
+
+		{"solution", &MsgProposeSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", PublicKey: testKey, Commitment: testCommitment, Signature: testSignature}, nil},
+		{"solution with short commitment", &MsgProposeSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", PublicKey: testKey, Commitment: "73e1", Signature: testSignature}, ErrInvalidCommitment},
+		{"solution without key", &MsgProposeSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Commitment: testCommitment, Signature: testSignature}, ErrInvalidPublicKey},
+		{"solution without signature", &MsgProposeSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", PublicKey: testKey, Commitment: testCommitment}, ErrInvalidSignature},
+		{"validation", &MsgSubmitValidation{Creator: testAddress, TaskId: "1", ThreadId: "1-0", PublicKey: testKey, Commitment: testCommitment, Signature: testSignature}, nil},
+		{"validation with invalid signature", &MsgSubmitValidation{Creator: testAddress, TaskId: "1", ThreadId: "1-0", PublicKey: testKey, Commitment: testCommitment, Signature: "not base64!"}, ErrInvalidSignature},
+
+		{"revealed solution", &MsgRevealSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt", Stems: validReveals()}, nil},
+		{"revealed solution without salt", &MsgRevealSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Stems: validReveals()}, ErrInvalidReveal},
+		{"revealed solution without stems", &MsgRevealSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt"}, ErrInvalidReveal},
+		{"revealed solution with repeated stem", &MsgRevealSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt", Stems: append(validReveals(), validReveals()[0])}, ErrInvalidReveal},
+		{"revealed validation", &MsgRevealValidation{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt", Stems: validReveals()[:1]}, nil},
+		{"revealed validation without hash", &MsgRevealValidation{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt", Stems: []*StemReveal{{Filename: "bass.wav", Cid: "cid"}}}, ErrInvalidReveal},
+		{"revealed validation with nil stem", &MsgRevealValidation{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Salt: "salt", Stems: []*StemReveal{nil}}, ErrInvalidReveal},
+
+		{"submitted solution", &MsgSubmitSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Dir: testCid, AverageStemSeconds: 30}, nil},
+		{"submitted solution with invalid dir", &MsgSubmitSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Dir: "/tmp"}, ErrInvalidCid},
+		{"submitted solution with negative duration", &MsgSubmitSolution{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Dir: testCid, AverageStemSeconds: -1}, ErrInvalidSolution},
+
+		{"report", &MsgReportInvalidInput{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Reason: "not audio"}, nil},
+		{"signer", &MsgSetWorkerSigner{Creator: testAddress, Signer: testSigner}, nil},
+		{"signer removed", &MsgSetWorkerSigner{Creator: testAddress}, nil},
+		{"signer with invalid address", &MsgSetWorkerSigner{Creator: testAddress, Signer: "hot"}, ErrInvalidAddress},
+		{"signer of itself", &MsgSetWorkerSigner{Creator: testAddress, Signer: testAddress}, ErrInvalidSigner},
+		{"accepted signer", &MsgAcceptWorkerSigner{Creator: testSigner, Worker: testAddress}, nil},
+		{"accepted signer without worker", &MsgAcceptWorkerSigner{Creator: testSigner}, ErrInvalidAddress},
+		{"accepted signer of itself", &MsgAcceptWorkerSigner{Creator: testAddress, Worker: testAddress}, ErrInvalidSigner},
+
+		{"report without reason", &MsgReportInvalidInput{Creator: testAddress, TaskId: "1", ThreadId: "1-0", Reason: " "}, ErrInvalidInputReport},
+		{"leave", &MsgLeaveThread{Creator: testAddress, TaskId: "1", ThreadId: "1-0"}, nil},
+		{"leave thread of another task", &MsgLeaveThread{Creator: testAddress, TaskId: "2", ThreadId: "1-0"}, ErrInvalidThreadId},
+
+		{"params", &MsgUpdateParams{Authority: testAddress, Params: DefaultParams()}, nil},
+		{"params with invalid authority", &MsgUpdateParams{Authority: "gov", Params: DefaultParams()}, ErrInvalidAddress},
+		{"unset params", &MsgUpdateParams{Authority: testAddress}, ErrInvalidParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidateThreadId(t *testing.T) {
+	tests := []struct {
+		taskId   string
+		threadId string
+		valid    bool
+	}{
+		{"1", "1-0", true},
+		{"1", "1-99", true},
+		{"12", "12-0", true},
+		{"12", "12-99", true},
+		{"0", "0-0", true},
+		{"1", "10", false},
+		{"1", "1-", false},
+		{"1", "1-00", false},
+		{"1", "2-0", false},
+		{"12", "1-20", false},
+		{"1", "1-+1", false},
+		{"1", "1--1", false},
+		{"", "-0", false},
+		{"task", "task-0", false},
+		{"1", "1-" + strings.Repeat("9", 30), false},
+	}
+	for _, tt := range tests {
+		err := ValidateThreadId(tt.taskId, tt.threadId)
+		if tt.valid {
+			require.NoError(t, err, "task %q thread %q", tt.taskId, tt.threadId)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidThreadId, "task %q thread %q", tt.taskId, tt.threadId)
+		}
+	}
+}
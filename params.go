@@ -1,6 +1,8 @@
 package audioStem
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,19 +13,79 @@ func DefaultParams() Params {
 		// Set default values here.
 		MinWorkerStaking:    &sdk.Coin{Denom: "jct", Amount: math.NewInt(1000000)},
 		MaxWorkersPerThread: 2,
-		MinValidators:       1,
+		// every stem of a solution must be matched by two validations, and a fifth of them is enough
+		MinValidators:        2,
+		MinValidValidations:  2,
+		MinValidStemsPercent: 20,
+		// two workers must agree the input is invalid, and they keep 10% of the reward for probing it
+		MinInvalidInputReports: 2,
+		ProbingFeePercent:      10,
+		// stems of honest workers differ slightly between builds of the model
+		MinStemSimilarityPercent: 90,
+		RevealPeriodBlocks:       20,
+		MaxSolutionAttempts:      3,
+		RejectionSlashPercent:    10,
 	}
 }
 
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
-	// Sanity check goes here.
+	if p.MinWorkerStaking == nil || !p.MinWorkerStaking.IsValid() {
+		return fmt.Errorf("min worker staking must be a valid coin, got %v", p.MinWorkerStaking)
+	}
+
+	// a thread needs a worker proposing the solution and another one validating it
+	if p.MaxWorkersPerThread < 2 {
+		return fmt.Errorf("max workers per thread must be at least 2, got %v", p.MaxWorkersPerThread)
+	}
 
 	// We can't have more validators that the amount of workers allowed per thread
-	if p.MinValidators > p.MaxWorkersPerThread {
-		// error
+	if p.MinValidators < 1 || p.MinValidators > p.MaxWorkersPerThread {
+		return fmt.Errorf("min validators must be between 1 and the %v workers per thread, got %v", p.MaxWorkersPerThread, p.MinValidators)
+	}
+
+	if p.MinValidValidations < 1 || p.MinValidValidations > p.MaxWorkersPerThread {
+		return fmt.Errorf("min valid validations must be between 1 and the %v workers per thread, got %v", p.MaxWorkersPerThread, p.MinValidValidations)
+	}
+
+	if p.MinValidStemsPercent < 1 || p.MinValidStemsPercent > 100 {
+		return fmt.Errorf("valid stems must be a percentage above 0, got %v", p.MinValidStemsPercent)
+	}
+
+	if p.MinInvalidInputReports < 1 {
+		return fmt.Errorf("min invalid input reports must be at least 1, got %v", p.MinInvalidInputReports)
+	}
+
+	if p.ProbingFeePercent < 0 || p.ProbingFeePercent > 100 {
+		return fmt.Errorf("probing fee must be a percentage, got %v", p.ProbingFeePercent)
+	}
+
+	if p.MinStemSimilarityPercent < 1 || p.MinStemSimilarityPercent > 100 {
+		return fmt.Errorf("stem similarity must be a percentage above 0, got %v", p.MinStemSimilarityPercent)
+	}
+
+	if p.RejectionSlashPercent < 0 || p.RejectionSlashPercent > 100 {
+		return fmt.Errorf("rejection slash must be a percentage, got %v", p.RejectionSlashPercent)
+	}
+
+	if p.RevealPeriodBlocks < 1 {
+		return fmt.Errorf("reveal period must be at least 1 block, got %v", p.RevealPeriodBlocks)
+	}
+
+	if p.MaxSolutionAttempts < 1 {
+		return fmt.Errorf("max solution attempts must be at least 1, got %v", p.MaxSolutionAttempts)
 	}
 
-	// if any of the values is zero thats another mistake
 	return nil
 }
+
+// StemSimilarityThreshold returns the minimum similarity between 0 and 1 for a validated stem
+// to match the solution.
+func (p Params) StemSimilarityThreshold() float64 {
+	return float64(p.MinStemSimilarityPercent) / 100
+}
+
+// AcceptancePolicy returns the policy that accepts or rejects solutions under these params.
+func (p Params) AcceptancePolicy() AcceptancePolicy {
+	return QuorumPolicy{MinValidators: p.MinValidators, Quorum: p.MinValidValidations, MinValidStemsPercent: p.MinValidStemsPercent}
+}
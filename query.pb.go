@@ -6,7 +6,7 @@ package audioStem
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -123,17 +123,123 @@ func (m *QueryGetAudioStemTaskResponse) GetAudioStemTask() *AudioStemTask {
 	return nil
 }
 
-// QueryGetGameRequest is the request type for the Query/GetGame RPC
+// QueryGetAudioStemThreadRequest is the request type for the Query/GetAudioStemThread RPC
+// method.
+type QueryGetAudioStemThreadRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Index  uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryGetAudioStemThreadRequest) Reset()         { *m = QueryGetAudioStemThreadRequest{} }
+func (m *QueryGetAudioStemThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAudioStemThreadRequest) ProtoMessage()    {}
+func (*QueryGetAudioStemThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{2}
+}
+func (m *QueryGetAudioStemThreadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAudioStemThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAudioStemThreadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAudioStemThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAudioStemThreadRequest.Merge(m, src)
+}
+func (m *QueryGetAudioStemThreadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAudioStemThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAudioStemThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAudioStemThreadRequest proto.InternalMessageInfo
+
+func (m *QueryGetAudioStemThreadRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *QueryGetAudioStemThreadRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// QueryGetAudioStemThreadResponse is the response type for the Query/GetAudioStemThread RPC
 // method.
+type QueryGetAudioStemThreadResponse struct {
+	// empty if the thread doesn't exist
+	Thread *AudioStemThread `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+}
+
+func (m *QueryGetAudioStemThreadResponse) Reset()         { *m = QueryGetAudioStemThreadResponse{} }
+func (m *QueryGetAudioStemThreadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAudioStemThreadResponse) ProtoMessage()    {}
+func (*QueryGetAudioStemThreadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{3}
+}
+func (m *QueryGetAudioStemThreadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAudioStemThreadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAudioStemThreadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAudioStemThreadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAudioStemThreadResponse.Merge(m, src)
+}
+func (m *QueryGetAudioStemThreadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAudioStemThreadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAudioStemThreadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAudioStemThreadResponse proto.InternalMessageInfo
+
+func (m *QueryGetAudioStemThreadResponse) GetThread() *AudioStemThread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+// QueryGetAudioStemLogsRequest selects the logs a worker keeps for a thread. Logs live in
+// the database of the worker, so they are read with its logs command instead of the chain.
 type QueryGetAudioStemLogsRequest struct {
 	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	// since filters out the logs with a timestamp before it.
+	Since int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	// min_severity filters out the logs less severe than it.
+	MinSeverity AudioStemLogs_AudioStemLog_SEVERITY `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=janction.audioStem.v1.AudioStemLogs_AudioStemLog_SEVERITY" json:"min_severity,omitempty"`
+	Pagination  *query.PageRequest                  `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetAudioStemLogsRequest) Reset()         { *m = QueryGetAudioStemLogsRequest{} }
 func (m *QueryGetAudioStemLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAudioStemLogsRequest) ProtoMessage()    {}
 func (*QueryGetAudioStemLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{2}
+	return fileDescriptor_9094a7effb89da29, []int{4}
 }
 func (m *QueryGetAudioStemLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,18 +275,38 @@ func (m *QueryGetAudioStemLogsRequest) GetThreadId() string {
 	return ""
 }
 
-// QueryGetGameResponse is the response type for the Query/GetGame RPC
-// method.
+func (m *QueryGetAudioStemLogsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *QueryGetAudioStemLogsRequest) GetMinSeverity() AudioStemLogs_AudioStemLog_SEVERITY {
+	if m != nil {
+		return m.MinSeverity
+	}
+	return AudioStemLogs_AudioStemLog_INFO
+}
+
+func (m *QueryGetAudioStemLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetAudioStemLogsResponse is the page of logs the worker read for a thread.
 type QueryGetAudioStemLogsResponse struct {
-	// Game defines the game at the requested index.
-	AudioStemLogs *AudioStemLogs `protobuf:"bytes,1,opt,name=audio_stem_logs,json=audioStemLogs,proto3" json:"audio_stem_logs,omitempty"`
+	AudioStemLogs *AudioStemLogs      `protobuf:"bytes,1,opt,name=audio_stem_logs,json=audioStemLogs,proto3" json:"audio_stem_logs,omitempty"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetAudioStemLogsResponse) Reset()         { *m = QueryGetAudioStemLogsResponse{} }
 func (m *QueryGetAudioStemLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAudioStemLogsResponse) ProtoMessage()    {}
 func (*QueryGetAudioStemLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{3}
+	return fileDescriptor_9094a7effb89da29, []int{5}
 }
 func (m *QueryGetAudioStemLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,14 +342,22 @@ func (m *QueryGetAudioStemLogsResponse) GetAudioStemLogs() *AudioStemLogs {
 	return nil
 }
 
+func (m *QueryGetAudioStemLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetPendingAudioStemTaskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetPendingAudioStemTaskRequest) Reset()         { *m = QueryGetPendingAudioStemTaskRequest{} }
 func (m *QueryGetPendingAudioStemTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPendingAudioStemTaskRequest) ProtoMessage()    {}
 func (*QueryGetPendingAudioStemTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{4}
+	return fileDescriptor_9094a7effb89da29, []int{6}
 }
 func (m *QueryGetPendingAudioStemTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,15 +386,23 @@ func (m *QueryGetPendingAudioStemTaskRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetPendingAudioStemTaskRequest proto.InternalMessageInfo
 
+func (m *QueryGetPendingAudioStemTaskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetPendingAudioStemTaskResponse struct {
-	AudioStemTasks []*AudioStemTask `protobuf:"bytes,1,rep,name=audio_stem_tasks,json=audioStemTasks,proto3" json:"audio_stem_tasks,omitempty"`
+	AudioStemTasks []*AudioStemTask    `protobuf:"bytes,1,rep,name=audio_stem_tasks,json=audioStemTasks,proto3" json:"audio_stem_tasks,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetPendingAudioStemTaskResponse) Reset()         { *m = QueryGetPendingAudioStemTaskResponse{} }
 func (m *QueryGetPendingAudioStemTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPendingAudioStemTaskResponse) ProtoMessage()    {}
 func (*QueryGetPendingAudioStemTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{5}
+	return fileDescriptor_9094a7effb89da29, []int{7}
 }
 func (m *QueryGetPendingAudioStemTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,6 +438,13 @@ func (m *QueryGetPendingAudioStemTaskResponse) GetAudioStemTasks() []*AudioStemT
 	return nil
 }
 
+func (m *QueryGetPendingAudioStemTaskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetWorkerRequest struct {
 	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 }
@@ -304,7 +453,7 @@ func (m *QueryGetWorkerRequest) Reset()         { *m = QueryGetWorkerRequest{} }
 func (m *QueryGetWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetWorkerRequest) ProtoMessage()    {}
 func (*QueryGetWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{6}
+	return fileDescriptor_9094a7effb89da29, []int{8}
 }
 func (m *QueryGetWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +497,7 @@ func (m *QueryGetWorkerResponse) Reset()         { *m = QueryGetWorkerResponse{}
 func (m *QueryGetWorkerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetWorkerResponse) ProtoMessage()    {}
 func (*QueryGetWorkerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9094a7effb89da29, []int{7}
+	return fileDescriptor_9094a7effb89da29, []int{9}
 }
 func (m *QueryGetWorkerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,55 +533,254 @@ func (m *QueryGetWorkerResponse) GetWorker() *Worker {
 	return nil
 }
 
+type QueryGetWorkersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetWorkersRequest) Reset()         { *m = QueryGetWorkersRequest{} }
+func (m *QueryGetWorkersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWorkersRequest) ProtoMessage()    {}
+func (*QueryGetWorkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{10}
+}
+func (m *QueryGetWorkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWorkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWorkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWorkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWorkersRequest.Merge(m, src)
+}
+func (m *QueryGetWorkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWorkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWorkersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWorkersRequest proto.InternalMessageInfo
+
+func (m *QueryGetWorkersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetWorkersResponse struct {
+	Workers    []Worker            `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetWorkersResponse) Reset()         { *m = QueryGetWorkersResponse{} }
+func (m *QueryGetWorkersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWorkersResponse) ProtoMessage()    {}
+func (*QueryGetWorkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{11}
+}
+func (m *QueryGetWorkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWorkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWorkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWorkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWorkersResponse.Merge(m, src)
+}
+func (m *QueryGetWorkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWorkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWorkersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWorkersResponse proto.InternalMessageInfo
+
+func (m *QueryGetWorkersResponse) GetWorkers() []Worker {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+func (m *QueryGetWorkersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetParamsRequest struct {
+}
+
+func (m *QueryGetParamsRequest) Reset()         { *m = QueryGetParamsRequest{} }
+func (m *QueryGetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParamsRequest) ProtoMessage()    {}
+func (*QueryGetParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{12}
+}
+func (m *QueryGetParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParamsRequest.Merge(m, src)
+}
+func (m *QueryGetParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetParamsRequest proto.InternalMessageInfo
+
+type QueryGetParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryGetParamsResponse) Reset()         { *m = QueryGetParamsResponse{} }
+func (m *QueryGetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParamsResponse) ProtoMessage()    {}
+func (*QueryGetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9094a7effb89da29, []int{13}
+}
+func (m *QueryGetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParamsResponse.Merge(m, src)
+}
+func (m *QueryGetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetParamsResponse proto.InternalMessageInfo
+
+func (m *QueryGetParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryGetAudioStemTaskRequest)(nil), "janction.audioStem.v1.QueryGetAudioStemTaskRequest")
 	proto.RegisterType((*QueryGetAudioStemTaskResponse)(nil), "janction.audioStem.v1.QueryGetAudioStemTaskResponse")
+	proto.RegisterType((*QueryGetAudioStemThreadRequest)(nil), "janction.audioStem.v1.QueryGetAudioStemThreadRequest")
+	proto.RegisterType((*QueryGetAudioStemThreadResponse)(nil), "janction.audioStem.v1.QueryGetAudioStemThreadResponse")
 	proto.RegisterType((*QueryGetAudioStemLogsRequest)(nil), "janction.audioStem.v1.QueryGetAudioStemLogsRequest")
 	proto.RegisterType((*QueryGetAudioStemLogsResponse)(nil), "janction.audioStem.v1.QueryGetAudioStemLogsResponse")
 	proto.RegisterType((*QueryGetPendingAudioStemTaskRequest)(nil), "janction.audioStem.v1.QueryGetPendingAudioStemTaskRequest")
 	proto.RegisterType((*QueryGetPendingAudioStemTaskResponse)(nil), "janction.audioStem.v1.QueryGetPendingAudioStemTaskResponse")
 	proto.RegisterType((*QueryGetWorkerRequest)(nil), "janction.audioStem.v1.QueryGetWorkerRequest")
 	proto.RegisterType((*QueryGetWorkerResponse)(nil), "janction.audioStem.v1.QueryGetWorkerResponse")
+	proto.RegisterType((*QueryGetWorkersRequest)(nil), "janction.audioStem.v1.QueryGetWorkersRequest")
+	proto.RegisterType((*QueryGetWorkersResponse)(nil), "janction.audioStem.v1.QueryGetWorkersResponse")
+	proto.RegisterType((*QueryGetParamsRequest)(nil), "janction.audioStem.v1.QueryGetParamsRequest")
+	proto.RegisterType((*QueryGetParamsResponse)(nil), "janction.audioStem.v1.QueryGetParamsResponse")
 }
 
 func init() { proto.RegisterFile("janction/audioStem/v1/query.proto", fileDescriptor_9094a7effb89da29) }
 
 var fileDescriptor_9094a7effb89da29 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x4a, 0x8a, 0x9d, 0xa2, 0x96, 0xa1, 0x2d, 0x65, 0x6d, 0xd6, 0x66, 0x5a, 0x41,
-	0xb4, 0xee, 0xd2, 0xb4, 0x22, 0xd4, 0x93, 0x5e, 0x44, 0x28, 0xfe, 0xa8, 0x82, 0xe0, 0xa5, 0x4c,
-	0xb3, 0xc3, 0x76, 0x4d, 0x77, 0x5f, 0xba, 0x33, 0x89, 0x16, 0xc9, 0xc5, 0x93, 0x47, 0x41, 0xea,
-	0x1f, 0x21, 0x08, 0xfe, 0x19, 0x1e, 0x0b, 0x5e, 0x3c, 0x4a, 0x22, 0xf8, 0x6f, 0xc8, 0xee, 0xce,
-	0x24, 0xd9, 0x65, 0x37, 0x59, 0x7b, 0xdb, 0x37, 0x79, 0xdf, 0xf7, 0x3e, 0xef, 0xcd, 0x77, 0x82,
-	0xeb, 0x6f, 0x58, 0xd0, 0x94, 0x1e, 0x04, 0x36, 0xeb, 0x38, 0x1e, 0xbc, 0x90, 0xdc, 0xb7, 0xbb,
-	0x9b, 0xf6, 0x71, 0x87, 0x87, 0x27, 0x56, 0x3b, 0x04, 0x09, 0x64, 0x51, 0xa7, 0x58, 0xc3, 0x14,
-	0xab, 0xbb, 0x69, 0x14, 0x28, 0xe5, 0x49, 0x9b, 0x8b, 0x44, 0x69, 0xac, 0xb8, 0x00, 0xee, 0x11,
-	0xb7, 0x59, 0xdb, 0xb3, 0x59, 0x10, 0x80, 0x64, 0x51, 0xbe, 0xfe, 0xf5, 0x5a, 0x13, 0x84, 0x0f,
-	0x22, 0xe9, 0x95, 0x69, 0x6a, 0x2c, 0xb8, 0xe0, 0x42, 0xfc, 0x69, 0x47, 0x5f, 0xc9, 0x29, 0xdd,
-	0xc6, 0x2b, 0xcf, 0xa3, 0xa4, 0x47, 0x5c, 0x3e, 0xd0, 0x4d, 0x5f, 0x32, 0xd1, 0xda, 0xe3, 0xc7,
-	0x1d, 0x2e, 0x24, 0x59, 0xc0, 0x55, 0x2f, 0x70, 0xf8, 0xbb, 0x65, 0xb4, 0x8a, 0x6e, 0xce, 0xee,
-	0x25, 0x01, 0xf5, 0x71, 0xad, 0x40, 0x25, 0xda, 0x10, 0x08, 0x4e, 0x76, 0xf1, 0xd5, 0x78, 0x86,
-	0x7d, 0x21, 0xb9, 0xbf, 0x2f, 0x99, 0x68, 0xc5, 0x05, 0xe6, 0x1a, 0xeb, 0x56, 0xee, 0xec, 0x56,
-	0xba, 0xcc, 0x65, 0x36, 0x1e, 0xd2, 0x9d, 0x1c, 0xc8, 0x5d, 0x70, 0x85, 0x86, 0x34, 0xf0, 0x25,
-	0x79, 0x18, 0x72, 0xe6, 0x3c, 0x76, 0x14, 0xe7, 0x30, 0xce, 0x45, 0x4d, 0xb4, 0xb9, 0xa8, 0x47,
-	0xe0, 0x8a, 0xb2, 0xa8, 0x71, 0x99, 0x11, 0x6a, 0x14, 0xd2, 0x1b, 0x78, 0x4d, 0xb7, 0x7b, 0xc6,
-	0x03, 0xc7, 0x0b, 0xdc, 0xbc, 0xb5, 0xd2, 0x2e, 0x5e, 0x9f, 0x9c, 0xa6, 0xe0, 0x9e, 0xe0, 0xf9,
-	0xcc, 0x1e, 0x23, 0xba, 0x8b, 0xa5, 0x17, 0x79, 0x25, 0xb5, 0x48, 0x41, 0x6d, 0xbc, 0xa8, 0xfb,
-	0xbe, 0x82, 0xb0, 0xc5, 0x43, 0xbd, 0xc2, 0x25, 0x3c, 0xf3, 0x36, 0x3e, 0x50, 0x0b, 0x54, 0x11,
-	0x7d, 0x8a, 0x97, 0xb2, 0x02, 0x85, 0x76, 0x37, 0xa5, 0x98, 0x6b, 0xd4, 0x0a, 0x80, 0x94, 0x4c,
-	0x25, 0x37, 0x4e, 0xab, 0xb8, 0x1a, 0x57, 0x24, 0x5f, 0x11, 0x9e, 0xcf, 0x1a, 0x88, 0x6c, 0x15,
-	0x54, 0x99, 0x64, 0x52, 0x63, 0xfb, 0xff, 0x44, 0xc9, 0x00, 0xf4, 0xf6, 0xc7, 0xbf, 0xdf, 0x6f,
-	0xa1, 0x0f, 0x3f, 0xff, 0x7c, 0xbe, 0xb0, 0x4a, 0x4c, 0x3b, 0xff, 0xf1, 0xbd, 0x8f, 0x0d, 0xdf,
-	0x23, 0xdf, 0x32, 0xb0, 0xd1, 0x65, 0x97, 0x87, 0x1d, 0x33, 0x6b, 0x79, 0xd8, 0x71, 0x97, 0x52,
-	0x6b, 0x04, 0xbb, 0x46, 0xea, 0x45, 0xb0, 0xda, 0xf5, 0x3d, 0xf2, 0x05, 0xe1, 0xd9, 0xe1, 0x9d,
-	0x91, 0x8d, 0x29, 0x3d, 0x53, 0x5e, 0x30, 0xee, 0x94, 0xcc, 0x56, 0x68, 0x1b, 0x23, 0xb4, 0x3a,
-	0xb9, 0x5e, 0x84, 0x96, 0x5c, 0x7f, 0x8f, 0x9c, 0x22, 0xbc, 0x5c, 0xe0, 0x7a, 0x41, 0x76, 0xa6,
-	0x74, 0x9e, 0xf0, 0xa4, 0x8c, 0xfb, 0xe7, 0xd2, 0xaa, 0x19, 0x2a, 0x0f, 0xef, 0xfd, 0xe8, 0x9b,
-	0xe8, 0xac, 0x6f, 0xa2, 0xdf, 0x7d, 0x13, 0x7d, 0x1a, 0x98, 0x95, 0xb3, 0x81, 0x59, 0xf9, 0x35,
-	0x30, 0x2b, 0xaf, 0x6b, 0xae, 0x27, 0x0f, 0x3b, 0x07, 0x56, 0x13, 0xfc, 0x9c, 0xe1, 0x0e, 0x66,
-	0xe2, 0x3f, 0xd2, 0xad, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xd8, 0xaa, 0x1c, 0xf8, 0x05,
-	0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4b, 0x1c, 0x49,
+	0x14, 0x9f, 0xf2, 0x63, 0x5c, 0xcb, 0xd5, 0x95, 0xc2, 0x8f, 0xa1, 0x57, 0xdb, 0xb1, 0x57, 0x76,
+	0xc5, 0x75, 0xbb, 0x71, 0xd4, 0x5d, 0x50, 0x76, 0x61, 0x05, 0x23, 0x82, 0x44, 0x33, 0x9a, 0x84,
+	0x04, 0xc2, 0xa4, 0x66, 0xa6, 0x68, 0x3b, 0xda, 0x5d, 0x63, 0x57, 0x8f, 0x89, 0x04, 0x2f, 0x39,
+	0x85, 0x9c, 0x02, 0x21, 0xb9, 0xe7, 0x9a, 0x53, 0x4e, 0xb9, 0xe6, 0xea, 0x51, 0xc8, 0x21, 0x39,
+	0x85, 0xa0, 0x81, 0xfc, 0x09, 0xb9, 0x86, 0xae, 0x8f, 0x19, 0x7b, 0x9c, 0x99, 0x1e, 0xc5, 0xdb,
+	0xbc, 0xea, 0xf7, 0x7b, 0xef, 0xf7, 0x7e, 0xef, 0xbd, 0xaa, 0x81, 0xe3, 0x0f, 0xb0, 0x57, 0x08,
+	0x1c, 0xea, 0x59, 0xb8, 0x5c, 0x74, 0xe8, 0x66, 0x40, 0x5c, 0x6b, 0x7f, 0xc6, 0xda, 0x2b, 0x13,
+	0xff, 0xc0, 0x2c, 0xf9, 0x34, 0xa0, 0x68, 0x50, 0xb9, 0x98, 0x15, 0x17, 0x73, 0x7f, 0x46, 0x6b,
+	0x80, 0x0c, 0x0e, 0x4a, 0x84, 0x09, 0xa4, 0x36, 0x62, 0x53, 0x6a, 0xef, 0x12, 0x0b, 0x97, 0x1c,
+	0x0b, 0x7b, 0x1e, 0x0d, 0x70, 0xe8, 0xaf, 0xbe, 0xfe, 0x5a, 0xa0, 0xcc, 0xa5, 0x4c, 0xe4, 0xaa,
+	0x49, 0xaa, 0x0d, 0xd8, 0xd4, 0xa6, 0xfc, 0xa7, 0x15, 0xfe, 0x92, 0xa7, 0x53, 0x12, 0x92, 0xc7,
+	0x8c, 0x54, 0x70, 0x79, 0x12, 0xe0, 0x19, 0xab, 0x84, 0x6d, 0xc7, 0xe3, 0xf1, 0x85, 0xaf, 0x31,
+	0x07, 0x47, 0x6e, 0x84, 0x1e, 0x2b, 0x24, 0xf8, 0x5f, 0x11, 0xdc, 0xc2, 0x6c, 0x27, 0x4b, 0xf6,
+	0xca, 0x84, 0x05, 0x68, 0x00, 0x76, 0x3a, 0x5e, 0x91, 0x3c, 0x4a, 0x81, 0x34, 0x98, 0xec, 0xce,
+	0x0a, 0xc3, 0x70, 0xe1, 0x68, 0x03, 0x14, 0x2b, 0x51, 0x8f, 0x11, 0xb4, 0x06, 0x7f, 0xe1, 0xf5,
+	0xe6, 0x58, 0x40, 0xdc, 0x5c, 0x80, 0xd9, 0x0e, 0x0f, 0xd0, 0x93, 0x99, 0x30, 0xeb, 0xea, 0x64,
+	0x46, 0xc3, 0xf4, 0xe2, 0xb3, 0xa6, 0xb1, 0x0e, 0xf5, 0xf3, 0xe9, 0xb6, 0x7d, 0x82, 0x8b, 0x8a,
+	0xe6, 0x30, 0xec, 0x0a, 0x93, 0xe4, 0x9c, 0xa2, 0x24, 0x9a, 0x0c, 0xcd, 0xd5, 0x62, 0x95, 0x7f,
+	0x5b, 0x1a, 0x4c, 0xf6, 0x2a, 0xfe, 0x18, 0x8e, 0x35, 0x0c, 0x28, 0x2b, 0xf8, 0x0f, 0x26, 0x03,
+	0x7e, 0x22, 0x89, 0xff, 0x1e, 0x4b, 0x5c, 0xe0, 0x25, 0xca, 0xf8, 0x0e, 0xea, 0x28, 0xbb, 0x46,
+	0x6d, 0xa6, 0x28, 0x6b, 0xf0, 0x27, 0xe1, 0xba, 0xaa, 0x38, 0x57, 0xec, 0x90, 0x35, 0x73, 0xbc,
+	0x02, 0xe1, 0xac, 0xdb, 0xb3, 0xc2, 0x40, 0xf7, 0xe0, 0xcf, 0xae, 0xe3, 0xe5, 0x18, 0xd9, 0x27,
+	0xbe, 0x13, 0x1c, 0xa4, 0xda, 0xd3, 0x60, 0xb2, 0x2f, 0xb3, 0x10, 0x47, 0x2c, 0x4c, 0x1a, 0xb1,
+	0xcc, 0xcd, 0xe5, 0x5b, 0xcb, 0xd9, 0xd5, 0xad, 0x3b, 0xd9, 0x1e, 0xd7, 0xf1, 0x36, 0x65, 0x38,
+	0x74, 0x0d, 0xc2, 0xea, 0x78, 0xa4, 0x3a, 0x64, 0xd5, 0x62, 0x96, 0xcc, 0x70, 0x96, 0x4c, 0x31,
+	0x7a, 0x72, 0x96, 0xcc, 0x0d, 0x6c, 0x13, 0x59, 0x4c, 0xf6, 0x0c, 0xd2, 0x78, 0x07, 0xea, 0x4c,
+	0x87, 0xa8, 0xbc, 0xee, 0x74, 0xec, 0x52, 0x9b, 0xb5, 0x3a, 0x1d, 0x3c, 0x4c, 0x75, 0x3a, 0x42,
+	0x13, 0xad, 0x44, 0x78, 0xb7, 0xf1, 0x40, 0x7f, 0xc4, 0xf2, 0x16, 0x54, 0x22, 0xc4, 0x5d, 0xf8,
+	0x9b, 0xe2, 0xbd, 0x41, 0xbc, 0xa2, 0xe3, 0xd9, 0x75, 0x57, 0x22, 0xaa, 0x13, 0xb8, 0xb4, 0x4e,
+	0xef, 0x01, 0x9c, 0x68, 0x9e, 0x4f, 0xca, 0x75, 0x1d, 0xf6, 0xd7, 0x2c, 0x53, 0xa8, 0x57, 0x7b,
+	0xcb, 0xdb, 0xd4, 0x17, 0xd9, 0xa6, 0x2b, 0x14, 0xcc, 0x82, 0x83, 0xaa, 0x80, 0xdb, 0xd4, 0xdf,
+	0x21, 0xbe, 0x92, 0x68, 0x08, 0x26, 0x1f, 0xf2, 0x03, 0xb5, 0x8d, 0xc2, 0x32, 0xd6, 0xe1, 0x50,
+	0x2d, 0x40, 0xd6, 0x38, 0x1f, 0x41, 0xf4, 0x64, 0x46, 0x1b, 0x54, 0x26, 0x61, 0x2a, 0xe0, 0xfd,
+	0xda, 0x80, 0xec, 0xaa, 0xbb, 0xf4, 0x1a, 0xc0, 0xe1, 0x73, 0x29, 0x24, 0xe9, 0x7f, 0x61, 0x97,
+	0xe0, 0xa1, 0xfa, 0xd1, 0x9c, 0xf5, 0x52, 0xc7, 0xd1, 0xe7, 0xb1, 0x44, 0x56, 0x61, 0xae, 0xae,
+	0x0f, 0xc3, 0xd5, 0x3e, 0x6c, 0x60, 0x1f, 0xbb, 0x4a, 0x04, 0xe3, 0x66, 0x55, 0x1e, 0xf5, 0x41,
+	0x52, 0x5f, 0x84, 0xc9, 0x12, 0x3f, 0x89, 0xd1, 0x5b, 0xc0, 0x24, 0x73, 0x09, 0xc9, 0x7c, 0x4c,
+	0xc2, 0x4e, 0x1e, 0x17, 0xbd, 0x01, 0xb0, 0xbf, 0xf6, 0x11, 0x40, 0xb3, 0x0d, 0x62, 0x35, 0x7b,
+	0x68, 0xb4, 0xb9, 0x8b, 0x81, 0x44, 0x19, 0xc6, 0x9f, 0x4f, 0xbf, 0xbd, 0x9d, 0x02, 0x4f, 0x3e,
+	0x7c, 0x7d, 0xd1, 0x96, 0x46, 0xba, 0x55, 0xff, 0xb1, 0x7d, 0xcc, 0x2f, 0xfd, 0x43, 0xf4, 0x0c,
+	0x40, 0x74, 0xfe, 0xc6, 0x47, 0xf3, 0x2d, 0x67, 0x3e, 0xfb, 0xe4, 0x68, 0x7f, 0x5f, 0x14, 0x26,
+	0x29, 0x77, 0x72, 0xca, 0xe8, 0x15, 0x80, 0xdd, 0x95, 0x91, 0x42, 0xd3, 0x31, 0xc1, 0x22, 0xeb,
+	0xa5, 0xfd, 0xd5, 0xa2, 0xb7, 0xcc, 0x38, 0x5d, 0x15, 0x69, 0x1c, 0x8d, 0x35, 0x12, 0x49, 0x4c,
+	0xe5, 0x21, 0x7a, 0x09, 0x60, 0xaa, 0xc1, 0x8d, 0xc4, 0xd0, 0x42, 0x4c, 0xe6, 0x26, 0xf7, 0xa6,
+	0xb6, 0x78, 0x29, 0xac, 0xac, 0x21, 0x81, 0x7c, 0x08, 0xab, 0x2b, 0x88, 0x5a, 0x93, 0x40, 0x2d,
+	0x82, 0x66, 0xb6, 0xea, 0x1e, 0x6d, 0x12, 0xe5, 0x3d, 0x12, 0x3b, 0x10, 0xdb, 0xa3, 0xc8, 0xea,
+	0xc5, 0xf6, 0x28, 0xba, 0x8f, 0x32, 0xe1, 0xd2, 0x3f, 0x47, 0x27, 0x3a, 0x38, 0x3e, 0xd1, 0xc1,
+	0x97, 0x13, 0x1d, 0x3c, 0x3f, 0xd5, 0x13, 0xc7, 0xa7, 0x7a, 0xe2, 0xd3, 0xa9, 0x9e, 0xb8, 0x3b,
+	0x6a, 0x3b, 0xc1, 0x76, 0x39, 0x6f, 0x16, 0xa8, 0x5b, 0xa7, 0x83, 0xf9, 0x24, 0xff, 0x3b, 0x37,
+	0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x54, 0xd0, 0xdd, 0xd4, 0xaa, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -449,9 +797,14 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// GetAudioStemTask returns the task based on the taskId
 	GetAudioStemTask(ctx context.Context, in *QueryGetAudioStemTaskRequest, opts ...grpc.CallOption) (*QueryGetAudioStemTaskResponse, error)
-	GetAudioStemLogs(ctx context.Context, in *QueryGetAudioStemLogsRequest, opts ...grpc.CallOption) (*QueryGetAudioStemLogsResponse, error)
+	// GetAudioStemThread returns a single thread of a task by its index
+	GetAudioStemThread(ctx context.Context, in *QueryGetAudioStemThreadRequest, opts ...grpc.CallOption) (*QueryGetAudioStemThreadResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingAudioStemTasks(ctx context.Context, in *QueryGetPendingAudioStemTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingAudioStemTaskResponse, error)
+	// GetWorkers returns the registered workers.
+	GetWorkers(ctx context.Context, in *QueryGetWorkersRequest, opts ...grpc.CallOption) (*QueryGetWorkersResponse, error)
+	// GetParams returns the parameters of the module.
+	GetParams(ctx context.Context, in *QueryGetParamsRequest, opts ...grpc.CallOption) (*QueryGetParamsResponse, error)
 }
 
 type queryClient struct {
@@ -471,9 +824,9 @@ func (c *queryClient) GetAudioStemTask(ctx context.Context, in *QueryGetAudioSte
 	return out, nil
 }
 
-func (c *queryClient) GetAudioStemLogs(ctx context.Context, in *QueryGetAudioStemLogsRequest, opts ...grpc.CallOption) (*QueryGetAudioStemLogsResponse, error) {
-	out := new(QueryGetAudioStemLogsResponse)
-	err := c.cc.Invoke(ctx, "/janction.audioStem.v1.Query/GetAudioStemLogs", in, out, opts...)
+func (c *queryClient) GetAudioStemThread(ctx context.Context, in *QueryGetAudioStemThreadRequest, opts ...grpc.CallOption) (*QueryGetAudioStemThreadResponse, error) {
+	out := new(QueryGetAudioStemThreadResponse)
+	err := c.cc.Invoke(ctx, "/janction.audioStem.v1.Query/GetAudioStemThread", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -498,13 +851,36 @@ func (c *queryClient) GetPendingAudioStemTasks(ctx context.Context, in *QueryGet
 	return out, nil
 }
 
+func (c *queryClient) GetWorkers(ctx context.Context, in *QueryGetWorkersRequest, opts ...grpc.CallOption) (*QueryGetWorkersResponse, error) {
+	out := new(QueryGetWorkersResponse)
+	err := c.cc.Invoke(ctx, "/janction.audioStem.v1.Query/GetWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetParams(ctx context.Context, in *QueryGetParamsRequest, opts ...grpc.CallOption) (*QueryGetParamsResponse, error) {
+	out := new(QueryGetParamsResponse)
+	err := c.cc.Invoke(ctx, "/janction.audioStem.v1.Query/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetAudioStemTask returns the task based on the taskId
 	GetAudioStemTask(context.Context, *QueryGetAudioStemTaskRequest) (*QueryGetAudioStemTaskResponse, error)
-	GetAudioStemLogs(context.Context, *QueryGetAudioStemLogsRequest) (*QueryGetAudioStemLogsResponse, error)
+	// GetAudioStemThread returns a single thread of a task by its index
+	GetAudioStemThread(context.Context, *QueryGetAudioStemThreadRequest) (*QueryGetAudioStemThreadResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingAudioStemTasks(context.Context, *QueryGetPendingAudioStemTaskRequest) (*QueryGetPendingAudioStemTaskResponse, error)
+	// GetWorkers returns the registered workers.
+	GetWorkers(context.Context, *QueryGetWorkersRequest) (*QueryGetWorkersResponse, error)
+	// GetParams returns the parameters of the module.
+	GetParams(context.Context, *QueryGetParamsRequest) (*QueryGetParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -514,8 +890,8 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) GetAudioStemTask(ctx context.Context, req *QueryGetAudioStemTaskRequest) (*QueryGetAudioStemTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemTask not implemented")
 }
-func (*UnimplementedQueryServer) GetAudioStemLogs(ctx context.Context, req *QueryGetAudioStemLogsRequest) (*QueryGetAudioStemLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemLogs not implemented")
+func (*UnimplementedQueryServer) GetAudioStemThread(ctx context.Context, req *QueryGetAudioStemThreadRequest) (*QueryGetAudioStemThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudioStemThread not implemented")
 }
 func (*UnimplementedQueryServer) GetWorker(ctx context.Context, req *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorker not implemented")
@@ -523,6 +899,12 @@ func (*UnimplementedQueryServer) GetWorker(ctx context.Context, req *QueryGetWor
 func (*UnimplementedQueryServer) GetPendingAudioStemTasks(ctx context.Context, req *QueryGetPendingAudioStemTaskRequest) (*QueryGetPendingAudioStemTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAudioStemTasks not implemented")
 }
+func (*UnimplementedQueryServer) GetWorkers(ctx context.Context, req *QueryGetWorkersRequest) (*QueryGetWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkers not implemented")
+}
+func (*UnimplementedQueryServer) GetParams(ctx context.Context, req *QueryGetParamsRequest) (*QueryGetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -546,20 +928,20 @@ func _Query_GetAudioStemTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetAudioStemLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAudioStemLogsRequest)
+func _Query_GetAudioStemThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAudioStemThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetAudioStemLogs(ctx, in)
+		return srv.(QueryServer).GetAudioStemThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/janction.audioStem.v1.Query/GetAudioStemLogs",
+		FullMethod: "/janction.audioStem.v1.Query/GetAudioStemThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAudioStemLogs(ctx, req.(*QueryGetAudioStemLogsRequest))
+		return srv.(QueryServer).GetAudioStemThread(ctx, req.(*QueryGetAudioStemThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -600,6 +982,42 @@ func _Query_GetPendingAudioStemTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.audioStem.v1.Query/GetWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkers(ctx, req.(*QueryGetWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.audioStem.v1.Query/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*QueryGetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "janction.audioStem.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -609,8 +1027,8 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_GetAudioStemTask_Handler,
 		},
 		{
-			MethodName: "GetAudioStemLogs",
-			Handler:    _Query_GetAudioStemLogs_Handler,
+			MethodName: "GetAudioStemThread",
+			Handler:    _Query_GetAudioStemThread_Handler,
 		},
 		{
 			MethodName: "GetWorker",
@@ -620,6 +1038,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingAudioStemTasks",
 			Handler:    _Query_GetPendingAudioStemTasks_Handler,
 		},
+		{
+			MethodName: "GetWorkers",
+			Handler:    _Query_GetWorkers_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/audioStem/v1/query.proto",
@@ -690,7 +1116,7 @@ func (m *QueryGetAudioStemTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAudioStemLogsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAudioStemThreadRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -700,20 +1126,112 @@ func (m *QueryGetAudioStemLogsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAudioStemLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAudioStemThreadRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAudioStemLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAudioStemThreadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ThreadId)))
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAudioStemThreadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAudioStemThreadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAudioStemThreadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Thread != nil {
+		{
+			size, err := m.Thread.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAudioStemLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAudioStemLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAudioStemLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinSeverity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinSeverity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Since != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ThreadId)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -740,6 +1258,18 @@ func (m *QueryGetAudioStemLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.AudioStemLogs != nil {
 		{
 			size, err := m.AudioStemLogs.MarshalToSizedBuffer(dAtA[:i])
@@ -775,6 +1305,18 @@ func (m *QueryGetPendingAudioStemTaskRequest) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -798,6 +1340,18 @@ func (m *QueryGetPendingAudioStemTaskResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.AudioStemTasks) > 0 {
 		for iNdEx := len(m.AudioStemTasks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -880,6 +1434,146 @@ func (m *QueryGetWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetWorkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWorkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWorkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWorkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWorkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWorkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -917,6 +1611,35 @@ func (m *QueryGetAudioStemTaskResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetAudioStemThreadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGetAudioStemThreadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Thread != nil {
+		l = m.Thread.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGetAudioStemLogsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -927,6 +1650,16 @@ func (m *QueryGetAudioStemLogsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Since != 0 {
+		n += 1 + sovQuery(uint64(m.Since))
+	}
+	if m.MinSeverity != 0 {
+		n += 1 + sovQuery(uint64(m.MinSeverity))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -940,6 +1673,10 @@ func (m *QueryGetAudioStemLogsResponse) Size() (n int) {
 		l = m.AudioStemLogs.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -949,6 +1686,10 @@ func (m *QueryGetPendingAudioStemTaskRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -964,42 +1705,731 @@ func (m *QueryGetPendingAudioStemTaskResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryGetWorkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Worker != nil {
+		l = m.Worker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AudioStemTask == nil {
+				m.AudioStemTask = &AudioStemTask{}
+			}
+			if err := m.AudioStemTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAudioStemThreadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemThreadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemThreadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAudioStemThreadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemThreadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemThreadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thread", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thread == nil {
+				m.Thread = &AudioStemThread{}
+			}
+			if err := m.Thread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAudioStemLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSeverity", wireType)
+			}
+			m.MinSeverity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSeverity |= AudioStemLogs_AudioStemLog_SEVERITY(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAudioStemLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAudioStemLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAudioStemLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AudioStemLogs == nil {
+				m.AudioStemLogs = &AudioStemLogs{}
+			}
+			if err := m.AudioStemLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Worker != nil {
-		l = m.Worker.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPendingAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,17 +2452,17 @@ func (m *QueryGetAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAudioStemTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1042,23 +2472,27 @@ func (m *QueryGetAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1081,7 +2515,7 @@ func (m *QueryGetAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPendingAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,15 +2538,15 @@ func (m *QueryGetAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAudioStemTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1139,10 +2573,44 @@ func (m *QueryGetAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AudioStemTask == nil {
-				m.AudioStemTask = &AudioStemTask{}
+			m.AudioStemTasks = append(m.AudioStemTasks, &AudioStemTask{})
+			if err := m.AudioStemTasks[len(m.AudioStemTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.AudioStemTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1167,7 +2635,7 @@ func (m *QueryGetAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAudioStemLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,15 +2658,15 @@ func (m *QueryGetAudioStemLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAudioStemLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAudioStemLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,7 +2694,7 @@ func (m *QueryGetAudioStemLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1249,7 +2717,7 @@ func (m *QueryGetAudioStemLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAudioStemLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1272,15 +2740,15 @@ func (m *QueryGetAudioStemLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAudioStemLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAudioStemLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1307,10 +2775,10 @@ func (m *QueryGetAudioStemLogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AudioStemLogs == nil {
-				m.AudioStemLogs = &AudioStemLogs{}
+			if m.Worker == nil {
+				m.Worker = &Worker{}
 			}
-			if err := m.AudioStemLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1335,7 +2803,7 @@ func (m *QueryGetAudioStemLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPendingAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1358,12 +2826,48 @@ func (m *QueryGetPendingAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1385,7 +2889,7 @@ func (m *QueryGetPendingAudioStemTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPendingAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1408,15 +2912,15 @@ func (m *QueryGetPendingAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingAudioStemTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1443,8 +2947,44 @@ func (m *QueryGetPendingAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AudioStemTasks = append(m.AudioStemTasks, &AudioStemTask{})
-			if err := m.AudioStemTasks[len(m.AudioStemTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workers = append(m.Workers, Worker{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1469,7 +3009,7 @@ func (m *QueryGetPendingAudioStemTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1492,44 +3032,12 @@ func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1551,7 +3059,7 @@ func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1574,15 +3082,15 @@ func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1609,10 +3117,7 @@ func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Worker == nil {
-				m.Worker = &Worker{}
-			}
-			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
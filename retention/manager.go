@@ -0,0 +1,204 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/janction/audioStem/audioStemLogger"
+	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/ipfs"
+)
+
+// Config sets how long the storage of a thread is kept once it is completed.
+type Config struct {
+	// RootPath is the home of the node. Threads are stored at RootPath/audioStems/threadId
+	RootPath string
+	// GracePeriod is how long a completed thread is kept before it is collected
+	GracePeriod time.Duration
+	// Quota is the most bytes thread storage may use. Completed threads are collected,
+	// oldest first, to stay under it. Zero means no quota.
+	Quota int64
+}
+
+// Report describes what a collection did.
+type Report struct {
+	// Collected are the threads whose storage was removed
+	Collected []string
+	// Unpinned is the amount of CIDs unpinned
+	Unpinned int
+	// Freed is the amount of bytes removed from disk
+	Freed int64
+	// Used is the amount of bytes thread storage uses after the collection
+	Used int64
+}
+
+// Manager unpins the solutions and removes the local files of completed threads.
+type Manager struct {
+	database db.Database
+	conf     Config
+	now      func() time.Time
+}
+
+// NewManager creates a manager of the storage of the threads of this worker.
+func NewManager(database db.Database, conf Config) *Manager {
+	return &Manager{database: database, conf: conf, now: time.Now}
+}
+
+// threadStorage is the storage used by a thread.
+type threadStorage struct {
+	id          string
+	size        int64
+	completed   bool
+	completedAt time.Time
+}
+
+// Collect removes the storage of threads completed longer than the grace period ago,
+// and then of the oldest completed threads until the quota is met.
+func (m *Manager) Collect(ctx context.Context) (Report, error) {
+	report := Report{}
+	threads, err := m.threads()
+	if err != nil {
+		return report, err
+	}
+
+	var kept []threadStorage
+	for _, thread := range threads {
+		report.Used += thread.size
+		if thread.completed && !m.now().Before(thread.completedAt.Add(m.conf.GracePeriod)) {
+			if err := m.collect(ctx, thread, &report); err != nil {
+				return report, err
+			}
+			continue
+		}
+		kept = append(kept, thread)
+	}
+
+	if m.conf.Quota > 0 && report.Used > m.conf.Quota {
+		sort.SliceStable(kept, func(i, j int) bool { return kept[i].completedAt.Before(kept[j].completedAt) })
+		for _, thread := range kept {
+			if report.Used <= m.conf.Quota {
+				break
+			}
+			if !thread.completed {
+				continue
+			}
+			if err := m.collect(ctx, thread, &report); err != nil {
+				return report, err
+			}
+		}
+		if report.Used > m.conf.Quota {
+			audioStemLogger.Logger.Error("thread storage uses %v bytes, over the quota of %v, and only threads in progress are left", report.Used, m.conf.Quota)
+		}
+	}
+
+	return report, nil
+}
+
+// threads returns the threads that have files in this node.
+func (m *Manager) threads() ([]threadStorage, error) {
+	root := filepath.Join(m.conf.RootPath, "audioStems")
+	entries, err := os.ReadDir(root)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read thread storage: %w", err)
+	}
+
+	var threads []threadStorage
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		thread := threadStorage{id: entry.Name()}
+		thread.size, err = dirSize(filepath.Join(root, thread.id))
+		if err != nil {
+			return nil, err
+		}
+
+		local, err := m.database.ReadThread(thread.id)
+		if err != nil {
+			return nil, err
+		}
+		if local.State == db.ThreadCompleted {
+			thread.completed = true
+			thread.completedAt, err = m.completedAt(thread.id)
+			if err != nil {
+				return nil, err
+			}
+		}
+		threads = append(threads, thread)
+	}
+	return threads, nil
+}
+
+// completedAt returns when the thread was completed. Threads completed before transitions
+// were recorded are considered completed long ago.
+func (m *Manager) completedAt(threadId string) (time.Time, error) {
+	transitions, err := m.database.ReadThreadTransitions(threadId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for i := len(transitions) - 1; i >= 0; i-- {
+		if transitions[i].To == db.ThreadCompleted {
+			return time.Unix(transitions[i].Timestamp, 0), nil
+		}
+	}
+	return time.Unix(0, 0), nil
+}
+
+// collect unpins the content of a thread and removes its files. Pins are forgotten one
+// by one, so an interrupted collection picks up where it stopped.
+func (m *Manager) collect(ctx context.Context, thread threadStorage, report *Report) error {
+	pins, err := m.database.ReadPins(thread.id)
+	if err != nil {
+		return err
+	}
+	for _, cid := range pins {
+		if err := ipfs.DefaultClient().Unpin(ctx, cid); err != nil {
+			return fmt.Errorf("unable to unpin %s of thread %s: %w", cid, thread.id, err)
+		}
+		if err := m.database.DeletePin(thread.id, cid); err != nil {
+			return err
+		}
+		report.Unpinned++
+	}
+
+	if err := os.RemoveAll(filepath.Join(m.conf.RootPath, "audioStems", thread.id)); err != nil {
+		return fmt.Errorf("unable to remove the files of thread %s: %w", thread.id, err)
+	}
+	audioStemLogger.Logger.Info("collected storage of thread %s, %v bytes freed", thread.id, thread.size)
+
+	report.Collected = append(report.Collected, thread.id)
+	report.Freed += thread.size
+	report.Used -= thread.size
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to measure %s: %w", dir, err)
+	}
+	return size, nil
+}
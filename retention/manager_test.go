@@ -0,0 +1,108 @@
+package retention
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/stretchr/testify/require"
+)
+
+type fixture struct {
+	root     string
+	database *db.Memory
+	node     *ipfs.Fake
+}
+
+func newFixture(t *testing.T) *fixture {
+	node := ipfs.NewFake()
+	previous := ipfs.DefaultClient()
+	ipfs.SetClient(node)
+	t.Cleanup(func() { ipfs.SetClient(previous) })
+
+	return &fixture{root: t.TempDir(), database: db.NewMemory(), node: node}
+}
+
+// addThread creates a thread with size bytes of files and a pinned solution.
+func (f *fixture) addThread(t *testing.T, id string, size int, completed bool) string {
+	dir := filepath.Join(f.root, "audioStems", id)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "input.mp3"), make([]byte, size), 0644))
+
+	cid, err := f.node.AddDir(context.Background(), dir)
+	require.NoError(t, err)
+	require.NoError(t, f.database.AddPin(id, cid))
+
+	_, err = f.database.ReadThread(id)
+	require.NoError(t, err)
+	if completed {
+		require.NoError(t, f.database.TransitionThread(id, db.ThreadIdle, db.ThreadCompleted))
+	}
+	return cid
+}
+
+func (f *fixture) manager(grace time.Duration, quota int64, now time.Time) *Manager {
+	m := NewManager(f.database, Config{RootPath: f.root, GracePeriod: grace, Quota: quota})
+	m.now = func() time.Time { return now }
+	return m
+}
+
+func TestCollect_GracePeriod(t *testing.T) {
+	f := newFixture(t)
+	completedCid := f.addThread(t, "completed", 100, true)
+	activeCid := f.addThread(t, "active", 50, false)
+
+	// still within the grace period
+	report, err := f.manager(time.Hour, 0, time.Now()).Collect(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, report.Collected)
+	require.Equal(t, int64(150), report.Used)
+
+	report, err = f.manager(time.Hour, 0, time.Now().Add(2*time.Hour)).Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"completed"}, report.Collected)
+	require.Equal(t, 1, report.Unpinned)
+	require.Equal(t, int64(100), report.Freed)
+	require.Equal(t, int64(50), report.Used)
+
+	require.False(t, f.node.Pinned(completedCid))
+	require.True(t, f.node.Pinned(activeCid))
+	require.NoDirExists(t, filepath.Join(f.root, "audioStems", "completed"))
+	require.DirExists(t, filepath.Join(f.root, "audioStems", "active"))
+	pins, err := f.database.ReadPins("completed")
+	require.NoError(t, err)
+	require.Empty(t, pins)
+}
+
+func TestCollect_Quota(t *testing.T) {
+	f := newFixture(t)
+	f.addThread(t, "old", 100, true)
+	time.Sleep(1100 * time.Millisecond)
+	f.addThread(t, "recent", 100, true)
+	f.addThread(t, "active", 100, false)
+
+	report, err := f.manager(24*time.Hour, 250, time.Now()).Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"old"}, report.Collected)
+	require.Equal(t, int64(200), report.Used)
+
+	// threads in progress are never collected, even over the quota
+	report, err = f.manager(24*time.Hour, 50, time.Now()).Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"recent"}, report.Collected)
+	require.Equal(t, int64(100), report.Used)
+	require.DirExists(t, filepath.Join(f.root, "audioStems", "active"))
+}
+
+func TestCollect_NoStorage(t *testing.T) {
+	f := newFixture(t)
+
+	report, err := f.manager(0, 0, time.Now()).Collect(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, report.Collected)
+	require.Zero(t, report.Used)
+}
@@ -31,18 +31,21 @@ const (
 	AudioStemLogs_AudioStemLog_INFO    AudioStemLogs_AudioStemLog_SEVERITY = 0
 	AudioStemLogs_AudioStemLog_SUCCESS AudioStemLogs_AudioStemLog_SEVERITY = 1
 	AudioStemLogs_AudioStemLog_ERROR   AudioStemLogs_AudioStemLog_SEVERITY = 2
+	AudioStemLogs_AudioStemLog_WARNING AudioStemLogs_AudioStemLog_SEVERITY = 3
 )
 
 var AudioStemLogs_AudioStemLog_SEVERITY_name = map[int32]string{
 	0: "INFO",
 	1: "SUCCESS",
 	2: "ERROR",
+	3: "WARNING",
 }
 
 var AudioStemLogs_AudioStemLog_SEVERITY_value = map[string]int32{
 	"INFO":    0,
 	"SUCCESS": 1,
 	"ERROR":   2,
+	"WARNING": 3,
 }
 
 func (x AudioStemLogs_AudioStemLog_SEVERITY) String() string {
@@ -57,7 +60,24 @@ func (AudioStemLogs_AudioStemLog_SEVERITY) EnumDescriptor() ([]byte, []int) {
 type Params struct {
 	MinWorkerStaking    *types.Coin `protobuf:"bytes,1,opt,name=min_worker_staking,json=minWorkerStaking,proto3" json:"min_worker_staking,omitempty"`
 	MaxWorkersPerThread int64       `protobuf:"varint,2,opt,name=max_workers_per_thread,json=maxWorkersPerThread,proto3" json:"max_workers_per_thread,omitempty"`
-	MinValidators       int64       `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// validations a thread waits for before its commitments are revealed
+	MinValidators int64 `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// amount of workers that must report the input of a task as invalid to reject it
+	MinInvalidInputReports int64 `protobuf:"varint,4,opt,name=min_invalid_input_reports,json=minInvalidInputReports,proto3" json:"min_invalid_input_reports,omitempty"`
+	// percentage of the reward of a rejected task paid to the workers that probed it
+	ProbingFeePercent int64 `protobuf:"varint,5,opt,name=probing_fee_percent,json=probingFeePercent,proto3" json:"probing_fee_percent,omitempty"`
+	// minimum similarity, as a percentage, between the fingerprints of a validated stem and the solution
+	MinStemSimilarityPercent int64 `protobuf:"varint,6,opt,name=min_stem_similarity_percent,json=minStemSimilarityPercent,proto3" json:"min_stem_similarity_percent,omitempty"`
+	// blocks workers have to reveal their commitments once a thread has enough validations
+	RevealPeriodBlocks int64 `protobuf:"varint,7,opt,name=reveal_period_blocks,json=revealPeriodBlocks,proto3" json:"reveal_period_blocks,omitempty"`
+	// solutions a thread can reject before its task is refunded
+	MaxSolutionAttempts int64 `protobuf:"varint,8,opt,name=max_solution_attempts,json=maxSolutionAttempts,proto3" json:"max_solution_attempts,omitempty"`
+	// percentage of the stake taken from a worker whose solution is rejected
+	RejectionSlashPercent int64 `protobuf:"varint,9,opt,name=rejection_slash_percent,json=rejectionSlashPercent,proto3" json:"rejection_slash_percent,omitempty"`
+	// validations that must match a stem of the solution for the stem to be valid
+	MinValidValidations int64 `protobuf:"varint,10,opt,name=min_valid_validations,json=minValidValidations,proto3" json:"min_valid_validations,omitempty"`
+	// minimum percentage of the stems of a solution that must be valid for it to be accepted
+	MinValidStemsPercent int64 `protobuf:"varint,11,opt,name=min_valid_stems_percent,json=minValidStemsPercent,proto3" json:"min_valid_stems_percent,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -114,6 +134,62 @@ func (m *Params) GetMinValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetMinInvalidInputReports() int64 {
+	if m != nil {
+		return m.MinInvalidInputReports
+	}
+	return 0
+}
+
+func (m *Params) GetProbingFeePercent() int64 {
+	if m != nil {
+		return m.ProbingFeePercent
+	}
+	return 0
+}
+
+func (m *Params) GetMinStemSimilarityPercent() int64 {
+	if m != nil {
+		return m.MinStemSimilarityPercent
+	}
+	return 0
+}
+
+func (m *Params) GetRevealPeriodBlocks() int64 {
+	if m != nil {
+		return m.RevealPeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSolutionAttempts() int64 {
+	if m != nil {
+		return m.MaxSolutionAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRejectionSlashPercent() int64 {
+	if m != nil {
+		return m.RejectionSlashPercent
+	}
+	return 0
+}
+
+func (m *Params) GetMinValidValidations() int64 {
+	if m != nil {
+		return m.MinValidValidations
+	}
+	return 0
+}
+
+func (m *Params) GetMinValidStemsPercent() int64 {
+	if m != nil {
+		return m.MinValidStemsPercent
+	}
+	return 0
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -195,6 +271,10 @@ type Worker struct {
 	CurrentThreadIndex int32              `protobuf:"varint,6,opt,name=current_thread_index,json=currentThreadIndex,proto3" json:"current_thread_index,omitempty"`
 	PublicIp           string             `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	IpfsId             string             `protobuf:"bytes,8,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	// hot key that sends the messages of the worker, which can't move its stake
+	Signer string `protobuf:"bytes,9,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signer the operator set, which sends the messages of the worker once it accepts
+	PendingSigner string `protobuf:"bytes,10,opt,name=pending_signer,json=pendingSigner,proto3" json:"pending_signer,omitempty"`
 }
 
 func (m *Worker) Reset()         { *m = Worker{} }
@@ -279,6 +359,20 @@ func (m *Worker) GetIpfsId() string {
 	return ""
 }
 
+func (m *Worker) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *Worker) GetPendingSigner() string {
+	if m != nil {
+		return m.PendingSigner
+	}
+	return ""
+}
+
 type Worker_Reputation struct {
 	Staked          *types.Coin `protobuf:"bytes,1,opt,name=staked,proto3" json:"staked,omitempty"`
 	Points          int64       `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
@@ -366,15 +460,21 @@ func (m *Worker_Reputation) GetRenderDurations() []int64 {
 // Audio Stem Task
 // @cid the IPFS CID submitted by a task requester
 type AudioStemTask struct {
-	TaskId      string             `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	Requester   string             `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
-	Cid         string             `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	AmountFiles int32              `protobuf:"varint,4,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
-	Instrument  string             `protobuf:"bytes,5,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Mp3         bool               `protobuf:"varint,6,opt,name=mp3,proto3" json:"mp3,omitempty"`
-	Completed   bool               `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
-	Reward      *types.Coin        `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
-	Threads     []*AudioStemThread `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	TaskId      string      `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Requester   string      `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Cid         string      `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	AmountFiles int32       `protobuf:"varint,4,opt,name=amount_files,json=amountFiles,proto3" json:"amount_files,omitempty"`
+	Instrument  string      `protobuf:"bytes,5,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Mp3         bool        `protobuf:"varint,6,opt,name=mp3,proto3" json:"mp3,omitempty"`
+	Completed   bool        `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Reward      *types.Coin `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
+	// threads are stored in their own collection, only filled in query responses
+	Threads []*AudioStemThread `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	// the input was rejected by the workers as not being valid audio
+	Invalid             bool                                `protobuf:"varint,10,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	InvalidInputReports []*AudioStemTask_InvalidInputReport `protobuf:"bytes,11,rep,name=invalid_input_reports,json=invalidInputReports,proto3" json:"invalid_input_reports,omitempty"`
+	// no solution was accepted within the attempts, so the reward was refunded
+	Failed bool `protobuf:"varint,12,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (m *AudioStemTask) Reset()         { *m = AudioStemTask{} }
@@ -473,6 +573,87 @@ func (m *AudioStemTask) GetThreads() []*AudioStemThread {
 	return nil
 }
 
+func (m *AudioStemTask) GetInvalid() bool {
+	if m != nil {
+		return m.Invalid
+	}
+	return false
+}
+
+func (m *AudioStemTask) GetInvalidInputReports() []*AudioStemTask_InvalidInputReport {
+	if m != nil {
+		return m.InvalidInputReports
+	}
+	return nil
+}
+
+func (m *AudioStemTask) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+type AudioStemTask_InvalidInputReport struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ThreadId string `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AudioStemTask_InvalidInputReport) Reset()         { *m = AudioStemTask_InvalidInputReport{} }
+func (m *AudioStemTask_InvalidInputReport) String() string { return proto.CompactTextString(m) }
+func (*AudioStemTask_InvalidInputReport) ProtoMessage()    {}
+func (*AudioStemTask_InvalidInputReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8128c416e7a81b, []int{3, 0}
+}
+func (m *AudioStemTask_InvalidInputReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AudioStemTask_InvalidInputReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AudioStemTask_InvalidInputReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AudioStemTask_InvalidInputReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AudioStemTask_InvalidInputReport.Merge(m, src)
+}
+func (m *AudioStemTask_InvalidInputReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *AudioStemTask_InvalidInputReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_AudioStemTask_InvalidInputReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AudioStemTask_InvalidInputReport proto.InternalMessageInfo
+
+func (m *AudioStemTask_InvalidInputReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *AudioStemTask_InvalidInputReport) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *AudioStemTask_InvalidInputReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // A Video Rendering Thread is the smallest unit of work for a Task.
 // Workers will try to complete a thread as soon as possible to submit first a solution
 type AudioStemThread struct {
@@ -487,6 +668,13 @@ type AudioStemThread struct {
 	Solution           *AudioStemThread_Solution     `protobuf:"bytes,9,opt,name=solution,proto3" json:"solution,omitempty"`
 	Validations        []*AudioStemThread_Validation `protobuf:"bytes,10,rep,name=validations,proto3" json:"validations,omitempty"`
 	AverageStemSeconds int64                         `protobuf:"varint,11,opt,name=average_stem_seconds,json=averageStemSeconds,proto3" json:"average_stem_seconds,omitempty"`
+	// block height until which commitments can be revealed, zero while they are still accepted
+	RevealDeadline int64 `protobuf:"varint,12,opt,name=reveal_deadline,json=revealDeadline,proto3" json:"reveal_deadline,omitempty"`
+	// solutions rejected so far, the workers that proposed them can't work on the thread again
+	Attempts        int64    `protobuf:"varint,13,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	RejectedWorkers []string `protobuf:"bytes,14,rep,name=rejected_workers,json=rejectedWorkers,proto3" json:"rejected_workers,omitempty"`
+	// position of the thread in the task, together with the task id it keys the thread
+	Index uint32 `protobuf:"varint,15,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (m *AudioStemThread) Reset()         { *m = AudioStemThread{} }
@@ -599,12 +787,45 @@ func (m *AudioStemThread) GetAverageStemSeconds() int64 {
 	return 0
 }
 
+func (m *AudioStemThread) GetRevealDeadline() int64 {
+	if m != nil {
+		return m.RevealDeadline
+	}
+	return 0
+}
+
+func (m *AudioStemThread) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *AudioStemThread) GetRejectedWorkers() []string {
+	if m != nil {
+		return m.RejectedWorkers
+	}
+	return nil
+}
+
+func (m *AudioStemThread) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 type AudioStemThread_Solution struct {
 	ProposedBy string                  `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
 	Stems      []*AudioStemThread_Stem `protobuf:"bytes,2,rep,name=stems,proto3" json:"stems,omitempty"`
 	PublicKey  string                  `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	Dir        string                  `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
 	Accepted   bool                    `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Salt       string                  `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the proposer
+	Commitment string `protobuf:"bytes,7,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *AudioStemThread_Solution) Reset()         { *m = AudioStemThread_Solution{} }
@@ -675,11 +896,37 @@ func (m *AudioStemThread_Solution) GetAccepted() bool {
 	return false
 }
 
+func (m *AudioStemThread_Solution) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *AudioStemThread_Solution) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *AudioStemThread_Solution) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 type AudioStemThread_Validation struct {
 	Validator string                  `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Stems     []*AudioStemThread_Stem `protobuf:"bytes,2,rep,name=stems,proto3" json:"stems,omitempty"`
 	PublicKey string                  `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	IsReverse bool                    `protobuf:"varint,4,opt,name=is_reverse,json=isReverse,proto3" json:"is_reverse,omitempty"`
+	Salt      string                  `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	// hash of the merkle root of the stems, the salt and the address of the validator
+	Commitment string `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signature of the commitment
+	Signature string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *AudioStemThread_Validation) Reset()         { *m = AudioStemThread_Validation{} }
@@ -743,13 +990,37 @@ func (m *AudioStemThread_Validation) GetIsReverse() bool {
 	return false
 }
 
+func (m *AudioStemThread_Validation) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *AudioStemThread_Validation) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *AudioStemThread_Validation) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 type AudioStemThread_Stem struct {
 	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
 	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
 	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	// energy of each frequency band over time, encoded as base64
+	Fingerprint string `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// proof of inclusion of the stem in the merkle root of the commitment
+	Proof string `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *AudioStemThread_Stem) Reset()         { *m = AudioStemThread_Stem{} }
@@ -792,13 +1063,6 @@ func (m *AudioStemThread_Stem) GetFilename() string {
 	return ""
 }
 
-func (m *AudioStemThread_Stem) GetSignature() string {
-	if m != nil {
-		return m.Signature
-	}
-	return ""
-}
-
 func (m *AudioStemThread_Stem) GetCid() string {
 	if m != nil {
 		return m.Cid
@@ -827,6 +1091,20 @@ func (m *AudioStemThread_Stem) GetInvalidCount() int64 {
 	return 0
 }
 
+func (m *AudioStemThread_Stem) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *AudioStemThread_Stem) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
 // Stores information about the Audio stem  task
 type AudioStemTaskInfo struct {
 	NextId int64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
@@ -1044,6 +1322,7 @@ func init() {
 	proto.RegisterType((*Worker)(nil), "janction.audioStem.v1.Worker")
 	proto.RegisterType((*Worker_Reputation)(nil), "janction.audioStem.v1.Worker.Reputation")
 	proto.RegisterType((*AudioStemTask)(nil), "janction.audioStem.v1.AudioStemTask")
+	proto.RegisterType((*AudioStemTask_InvalidInputReport)(nil), "janction.audioStem.v1.AudioStemTask.InvalidInputReport")
 	proto.RegisterType((*AudioStemThread)(nil), "janction.audioStem.v1.AudioStemThread")
 	proto.RegisterType((*AudioStemThread_Solution)(nil), "janction.audioStem.v1.AudioStemThread.Solution")
 	proto.RegisterType((*AudioStemThread_Validation)(nil), "janction.audioStem.v1.AudioStemThread.Validation")
@@ -1057,87 +1336,114 @@ func init() {
 func init() { proto.RegisterFile("janction/audioStem/v1/types.proto", fileDescriptor_2c8128c416e7a81b) }
 
 var fileDescriptor_2c8128c416e7a81b = []byte{
-	// 1266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0x1b, 0xc5,
-	0x1a, 0xce, 0xc6, 0x5f, 0xeb, 0xd7, 0x49, 0x9b, 0xce, 0xc9, 0xe9, 0xd9, 0xfa, 0x9c, 0xf8, 0xb8,
-	0x16, 0x54, 0x46, 0x05, 0xbb, 0x49, 0x25, 0x10, 0x54, 0x48, 0x34, 0x21, 0x2d, 0x56, 0x2b, 0x5a,
-	0x8d, 0x4b, 0x10, 0x08, 0x69, 0x35, 0xf6, 0x4e, 0x9d, 0x21, 0xde, 0xd9, 0x65, 0x66, 0x9c, 0x26,
-	0x37, 0x88, 0x9f, 0xc0, 0xef, 0x40, 0xe2, 0x06, 0x71, 0xc3, 0x3d, 0x48, 0xe5, 0xae, 0x42, 0x42,
-	0x82, 0x1b, 0x84, 0xda, 0x1f, 0x02, 0x9a, 0x8f, 0x5d, 0xdb, 0x49, 0x9a, 0x14, 0x09, 0x71, 0xb7,
-	0xf3, 0xbc, 0x1f, 0x33, 0xef, 0xf3, 0x7e, 0xcc, 0x2c, 0x5c, 0xfe, 0x94, 0xf0, 0xa1, 0x62, 0x09,
-	0xef, 0x92, 0x49, 0xc4, 0x92, 0xbe, 0xa2, 0x71, 0x77, 0x7f, 0xbd, 0xab, 0x0e, 0x53, 0x2a, 0x3b,
-	0xa9, 0x48, 0x54, 0x82, 0xfe, 0x9d, 0xa9, 0x74, 0x72, 0x95, 0xce, 0xfe, 0x7a, 0xbd, 0x31, 0x4c,
-	0x64, 0x9c, 0xc8, 0xee, 0x80, 0x48, 0xda, 0xdd, 0x5f, 0x1f, 0x50, 0x45, 0xd6, 0xbb, 0xc3, 0x84,
-	0x71, 0x6b, 0x56, 0xbf, 0x64, 0xe5, 0xa1, 0x59, 0x75, 0xed, 0xc2, 0x89, 0x56, 0x47, 0xc9, 0x28,
-	0xb1, 0xb8, 0xfe, 0xb2, 0x68, 0xeb, 0x6b, 0x0f, 0xca, 0xf7, 0x89, 0x20, 0xb1, 0x44, 0xb7, 0x01,
-	0xc5, 0x8c, 0x87, 0x8f, 0x12, 0xb1, 0x47, 0x45, 0x28, 0x15, 0xd9, 0x63, 0x7c, 0x14, 0x78, 0x4d,
-	0xaf, 0x5d, 0xdb, 0xb8, 0xd4, 0x71, 0xbe, 0xf4, 0xc6, 0x1d, 0xb7, 0x71, 0x67, 0x2b, 0x61, 0x1c,
-	0xaf, 0xc4, 0x8c, 0x7f, 0x68, 0x6c, 0xfa, 0xd6, 0x04, 0x5d, 0x87, 0x8b, 0x31, 0x39, 0x70, 0x8e,
-	0x64, 0x98, 0x52, 0x11, 0xaa, 0x5d, 0x41, 0x49, 0x14, 0x2c, 0x36, 0xbd, 0x76, 0x01, 0xff, 0x2b,
-	0x26, 0x07, 0xd6, 0x42, 0xde, 0xa7, 0xe2, 0x81, 0x11, 0xa1, 0x97, 0xe1, 0x9c, 0xde, 0x7d, 0x9f,
-	0x8c, 0x59, 0x44, 0x54, 0x22, 0x64, 0x50, 0x30, 0xca, 0xcb, 0x31, 0xe3, 0x3b, 0x39, 0xd8, 0xfa,
-	0x71, 0x11, 0x96, 0x6e, 0x53, 0x4e, 0x25, 0x93, 0x7d, 0x45, 0x14, 0x45, 0x37, 0xa0, 0x9c, 0x9a,
-	0xf3, 0xbb, 0x93, 0xae, 0x75, 0x4e, 0x64, 0xae, 0x63, 0x83, 0xdc, 0x2c, 0x3e, 0xfe, 0xed, 0xff,
-	0x0b, 0xd8, 0x99, 0xa0, 0x4f, 0xe0, 0x42, 0xae, 0xf4, 0x80, 0xc8, 0xbd, 0x1e, 0x7f, 0x98, 0x98,
-	0x7d, 0x6b, 0x1b, 0xed, 0xe7, 0xf8, 0xb9, 0x79, 0x54, 0xdf, 0xb9, 0x3c, 0xee, 0x08, 0x85, 0x47,
-	0xbc, 0xdf, 0x65, 0x52, 0x05, 0xc5, 0x66, 0xa1, 0x5d, 0xdb, 0xb8, 0xfa, 0x1c, 0xef, 0x3d, 0x1e,
-	0xd1, 0x03, 0x1a, 0xcd, 0x6d, 0x72, 0xe2, 0x06, 0xda, 0x17, 0x7a, 0x1b, 0x2a, 0x8e, 0xe4, 0xa0,
-	0x64, 0xdc, 0x3e, 0x2f, 0x78, 0xcb, 0xb6, 0x73, 0x94, 0xd9, 0xb4, 0xbe, 0x29, 0x42, 0xd9, 0x4a,
-	0xd0, 0x06, 0x54, 0x48, 0x14, 0x09, 0x2a, 0x2d, 0x8d, 0xd5, 0xcd, 0xe0, 0xa7, 0x6f, 0x5f, 0x5b,
-	0x75, 0x39, 0xbf, 0x69, 0x25, 0x7d, 0x25, 0x18, 0x1f, 0xe1, 0x4c, 0x11, 0xbd, 0x07, 0x20, 0x68,
-	0x3a, 0x51, 0x44, 0xef, 0x77, 0x06, 0x6b, 0x76, 0x9b, 0x0e, 0xce, 0xf5, 0xf1, 0x8c, 0x2d, 0x0a,
-	0xa0, 0x42, 0x39, 0x19, 0x8c, 0x69, 0x14, 0x14, 0x9b, 0x5e, 0xdb, 0xc7, 0xd9, 0x12, 0x5d, 0x81,
-	0xf3, 0xc3, 0x89, 0x10, 0x94, 0xab, 0x50, 0x11, 0xb9, 0x17, 0xb2, 0x28, 0x28, 0xe9, 0xf3, 0xe1,
-	0x65, 0x07, 0x1b, 0xb2, 0x23, 0x74, 0x0d, 0x56, 0x73, 0x3d, 0x53, 0x4f, 0x21, 0xd3, 0x4c, 0x06,
-	0xe5, 0xa6, 0xd7, 0x2e, 0x61, 0x94, 0x29, 0x1b, 0x91, 0xe1, 0x18, 0xfd, 0x17, 0xaa, 0xe9, 0x64,
-	0x30, 0x66, 0xc3, 0x90, 0xa5, 0x41, 0xc5, 0xf8, 0xf4, 0x2d, 0xd0, 0x4b, 0xd1, 0x7f, 0xa0, 0xc2,
-	0xd2, 0x87, 0x52, 0x6f, 0xe7, 0x1b, 0x51, 0x59, 0x2f, 0x7b, 0x51, 0xfd, 0x0f, 0x0f, 0x60, 0x1a,
-	0x04, 0x5a, 0x87, 0xb2, 0xee, 0x13, 0x1a, 0x9d, 0xdd, 0x26, 0x4e, 0x11, 0x5d, 0x84, 0x72, 0x9a,
-	0x30, 0xae, 0xa4, 0x6b, 0x06, 0xb7, 0x42, 0x4d, 0xa8, 0xb9, 0xda, 0x67, 0x09, 0xb7, 0xc5, 0x5f,
-	0xc2, 0xb3, 0x10, 0xfa, 0x1f, 0x54, 0x65, 0x32, 0x9e, 0x58, 0x79, 0xd1, 0xc8, 0xa7, 0x00, 0xba,
-	0x01, 0xfe, 0x23, 0xc6, 0x39, 0xe3, 0x23, 0x69, 0x28, 0x3a, 0xed, 0x30, 0xae, 0x10, 0x72, 0x03,
-	0xf4, 0x0a, 0xac, 0x08, 0xca, 0x23, 0x2a, 0xc2, 0x68, 0x22, 0xdc, 0x09, 0xca, 0xcd, 0x42, 0xbb,
-	0x80, 0xcf, 0x5b, 0xfc, 0xdd, 0x0c, 0x6e, 0xfd, 0xba, 0x08, 0xcb, 0x73, 0xe5, 0xa9, 0x23, 0x52,
-	0x26, 0x0b, 0xb6, 0x74, 0xb0, 0x5b, 0xa1, 0xd7, 0xa1, 0x2a, 0xe8, 0x67, 0x13, 0x2a, 0x15, 0x15,
-	0x26, 0xd8, 0xd3, 0xaa, 0x6a, 0xaa, 0x8a, 0x56, 0xa0, 0x30, 0x64, 0x91, 0x61, 0xa0, 0x8a, 0xf5,
-	0x27, 0xba, 0x0c, 0x4b, 0x24, 0x4e, 0x26, 0x5c, 0x85, 0x0f, 0xd9, 0x98, 0x66, 0xc1, 0xd7, 0x2c,
-	0x76, 0x4b, 0x43, 0xa8, 0x01, 0xc0, 0xb8, 0x54, 0x62, 0x12, 0x53, 0xae, 0x5c, 0x8d, 0xcc, 0x20,
-	0xda, 0x69, 0x9c, 0x5e, 0x37, 0xf5, 0xe0, 0x63, 0xfd, 0xa9, 0xe9, 0x1c, 0x26, 0x71, 0x3a, 0xa6,
-	0x8a, 0x46, 0xa6, 0x00, 0x7c, 0x3c, 0x05, 0x74, 0x66, 0x05, 0x7d, 0x44, 0x84, 0x2d, 0x80, 0xd3,
-	0x33, 0x6b, 0x15, 0xd1, 0x3b, 0x50, 0xb1, 0xb5, 0x27, 0x83, 0xaa, 0xe9, 0xc6, 0x2b, 0x67, 0x8e,
-	0x10, 0xa3, 0x8e, 0x33, 0xb3, 0xd6, 0x17, 0x3e, 0x9c, 0x3f, 0x22, 0xd4, 0x75, 0x9a, 0x55, 0x74,
-	0x46, 0xb0, 0x6f, 0x81, 0x5e, 0xa4, 0xeb, 0x34, 0x6b, 0x8b, 0xc5, 0x39, 0xee, 0x8f, 0x73, 0x58,
-	0x07, 0x5f, 0x93, 0xc7, 0x49, 0x4c, 0x0d, 0x7f, 0x55, 0x9c, 0xaf, 0xff, 0x76, 0xf2, 0x82, 0xe9,
-	0x5c, 0xf2, 0x9b, 0x85, 0x76, 0x35, 0x1f, 0x39, 0xe8, 0x0e, 0xf8, 0x59, 0xc9, 0x06, 0x55, 0x43,
-	0x6c, 0xf7, 0xc5, 0x48, 0xea, 0xf4, 0x9d, 0x19, 0xce, 0x1d, 0xa0, 0xfe, 0x7c, 0xcb, 0x80, 0x21,
-	0x7d, 0xfd, 0x05, 0xfd, 0xed, 0xe4, 0x96, 0xf3, 0x5d, 0x76, 0x0d, 0x56, 0xc9, 0x3e, 0x15, 0x64,
-	0x44, 0x43, 0xa9, 0x68, 0x1c, 0x4a, 0x3a, 0x4c, 0x78, 0x24, 0x83, 0x9a, 0xe9, 0x56, 0xe4, 0x64,
-	0xda, 0x51, 0xdf, 0x4a, 0xea, 0x3f, 0x7b, 0xe0, 0x67, 0xa7, 0x43, 0x6f, 0x42, 0x2d, 0x15, 0x49,
-	0x9a, 0x48, 0x1a, 0x85, 0x83, 0xc3, 0x33, 0x87, 0x29, 0x64, 0xca, 0x9b, 0x87, 0xe8, 0x26, 0x94,
-	0xf4, 0x8e, 0x7a, 0x30, 0x9c, 0x76, 0x45, 0x1c, 0x23, 0x46, 0xd1, 0x18, 0x5b, 0x4b, 0xb4, 0x06,
-	0xe0, 0x86, 0xda, 0x1e, 0x3d, 0x74, 0xd9, 0x77, 0x63, 0xee, 0x0e, 0x3d, 0xd4, 0x79, 0x8c, 0x98,
-	0x70, 0xe9, 0xd7, 0x9f, 0xba, 0x2a, 0xc8, 0x70, 0x48, 0x53, 0x9d, 0xc6, 0x92, 0x49, 0x63, 0xbe,
-	0xae, 0xff, 0xe0, 0x01, 0x4c, 0x59, 0xd2, 0xed, 0x9c, 0x5f, 0xce, 0x67, 0xc6, 0x35, 0x55, 0xfd,
-	0x07, 0xc2, 0x5a, 0x03, 0x60, 0x32, 0x14, 0x74, 0x9f, 0x0a, 0x49, 0xdd, 0x0d, 0x52, 0x65, 0x12,
-	0x5b, 0xa0, 0xfe, 0x95, 0x07, 0x45, 0xed, 0x6d, 0xae, 0x05, 0xbc, 0x23, 0x2d, 0xa0, 0x87, 0x2b,
-	0x1b, 0x71, 0xa2, 0x26, 0x82, 0xba, 0x5e, 0x9a, 0x02, 0x27, 0xb4, 0x13, 0x82, 0xe2, 0x2e, 0x91,
-	0xbb, 0x8e, 0x4b, 0xf3, 0xad, 0xdb, 0xc8, 0x84, 0xbd, 0xa5, 0xc7, 0x92, 0xa1, 0xb3, 0x80, 0x67,
-	0x10, 0xd4, 0x82, 0x25, 0xc6, 0x67, 0x34, 0xca, 0x46, 0x63, 0x0e, 0x6b, 0x5d, 0x85, 0x0b, 0xc7,
-	0x5e, 0x18, 0x7a, 0xc2, 0x72, 0x7a, 0xa0, 0xdc, 0x84, 0x2d, 0x60, 0xb7, 0x6a, 0x7d, 0x0e, 0xab,
-	0x27, 0x3d, 0x18, 0xd0, 0x2a, 0x94, 0xec, 0xf5, 0x67, 0xa3, 0xb4, 0x0b, 0x74, 0x1f, 0x96, 0xe7,
-	0x9e, 0x10, 0x26, 0xcc, 0xda, 0xc6, 0x4b, 0x2f, 0xf2, 0xd0, 0x71, 0x37, 0xc6, 0xbc, 0x83, 0xd6,
-	0x77, 0xb3, 0x77, 0xc1, 0xdd, 0x64, 0x24, 0x35, 0xc5, 0xd9, 0x70, 0x3a, 0x36, 0xac, 0xb6, 0xa1,
-	0x38, 0x4e, 0x46, 0x59, 0x1d, 0x9c, 0xd9, 0xa7, 0xda, 0xdf, 0xdc, 0x0a, 0x1b, 0xf3, 0xfa, 0xf7,
-	0x1e, 0x2c, 0xcd, 0xc2, 0x3a, 0x39, 0xe3, 0x64, 0xe4, 0x92, 0xa6, 0x3f, 0x75, 0x32, 0x15, 0x8b,
-	0xa9, 0x54, 0x24, 0x4e, 0xdd, 0x33, 0x72, 0x0a, 0xa0, 0x1d, 0xf0, 0xa5, 0x2e, 0x0d, 0xa6, 0x0e,
-	0x4d, 0xfa, 0xce, 0x6d, 0xbc, 0xf5, 0x97, 0xcf, 0xd2, 0xe9, 0x6f, 0xef, 0x6c, 0xe3, 0xde, 0x83,
-	0x8f, 0x70, 0xee, 0xab, 0xf5, 0x2a, 0xf8, 0x19, 0x8a, 0x7c, 0x28, 0xf6, 0xde, 0xbf, 0x75, 0x6f,
-	0x65, 0x01, 0xd5, 0xa0, 0xd2, 0xff, 0x60, 0x6b, 0x6b, 0xbb, 0xdf, 0x5f, 0xf1, 0x50, 0x15, 0x4a,
-	0xdb, 0x18, 0xdf, 0xc3, 0x2b, 0x8b, 0x9b, 0x6f, 0x3c, 0x7e, 0xda, 0xf0, 0x9e, 0x3c, 0x6d, 0x78,
-	0xbf, 0x3f, 0x6d, 0x78, 0x5f, 0x3e, 0x6b, 0x2c, 0x3c, 0x79, 0xd6, 0x58, 0xf8, 0xe5, 0x59, 0x63,
-	0xe1, 0xe3, 0xb5, 0x11, 0x53, 0xbb, 0x93, 0x41, 0x67, 0x98, 0xc4, 0xdd, 0xe3, 0x3f, 0x0a, 0x83,
-	0xb2, 0x79, 0xb8, 0x5f, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x46, 0x65, 0x04, 0x0e, 0x45, 0x0c,
-	0x00, 0x00,
+	// 1707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x16, 0xff, 0x77, 0x0f, 0xf5, 0x43, 0x8f, 0x65, 0x7b, 0xcd, 0xd4, 0xaa, 0x42, 0xb4, 0xa9,
+	0x8a, 0xa0, 0x64, 0x24, 0xb7, 0x09, 0xdc, 0x20, 0x68, 0x25, 0x47, 0x76, 0x99, 0x04, 0x8e, 0x30,
+	0x74, 0x1d, 0xb4, 0x28, 0xb0, 0x18, 0x72, 0x47, 0xd4, 0x44, 0xdc, 0x99, 0xed, 0xcc, 0x50, 0xb6,
+	0x6e, 0xf2, 0x0c, 0xbd, 0xeb, 0x13, 0x14, 0x7d, 0x81, 0x3e, 0x43, 0x91, 0xde, 0xa5, 0xbd, 0xea,
+	0x55, 0x11, 0xd8, 0x17, 0x7e, 0x85, 0xde, 0xb5, 0x98, 0x9f, 0x5d, 0x92, 0x92, 0x2c, 0xa9, 0x40,
+	0x91, 0xbb, 0x39, 0xbf, 0x33, 0xfb, 0xcd, 0x37, 0xe7, 0x1c, 0x12, 0xde, 0xfe, 0x92, 0xf0, 0x91,
+	0x66, 0x82, 0xf7, 0xc8, 0x34, 0x61, 0x62, 0xa0, 0x69, 0xda, 0x3b, 0xd9, 0xee, 0xe9, 0xd3, 0x8c,
+	0xaa, 0x6e, 0x26, 0x85, 0x16, 0xe8, 0x56, 0xee, 0xd2, 0x2d, 0x5c, 0xba, 0x27, 0xdb, 0xed, 0x8d,
+	0x91, 0x50, 0xa9, 0x50, 0xbd, 0x21, 0x51, 0xb4, 0x77, 0xb2, 0x3d, 0xa4, 0x9a, 0x6c, 0xf7, 0x46,
+	0x82, 0x71, 0x17, 0xd6, 0xbe, 0xeb, 0xec, 0xb1, 0x95, 0x7a, 0x4e, 0xf0, 0xa6, 0xf5, 0xb1, 0x18,
+	0x0b, 0xa7, 0x37, 0x2b, 0xa7, 0xed, 0x7c, 0x5b, 0x85, 0xfa, 0x01, 0x91, 0x24, 0x55, 0xe8, 0x31,
+	0xa0, 0x94, 0xf1, 0xf8, 0xb9, 0x90, 0xc7, 0x54, 0xc6, 0x4a, 0x93, 0x63, 0xc6, 0xc7, 0x51, 0x69,
+	0xb3, 0xb4, 0xd5, 0xdc, 0xb9, 0xdb, 0xf5, 0xb9, 0xcc, 0xc6, 0x5d, 0xbf, 0x71, 0xf7, 0xa1, 0x60,
+	0x1c, 0xb7, 0x52, 0xc6, 0xbf, 0xb0, 0x31, 0x03, 0x17, 0x82, 0xee, 0xc3, 0xed, 0x94, 0xbc, 0xf0,
+	0x89, 0x54, 0x9c, 0x51, 0x19, 0xeb, 0x23, 0x49, 0x49, 0x12, 0x95, 0x37, 0x4b, 0x5b, 0x15, 0x7c,
+	0x33, 0x25, 0x2f, 0x5c, 0x84, 0x3a, 0xa0, 0xf2, 0xa9, 0x35, 0xa1, 0x1f, 0xc2, 0xaa, 0xd9, 0xfd,
+	0x84, 0x4c, 0x58, 0x42, 0xb4, 0x90, 0x2a, 0xaa, 0x58, 0xe7, 0x95, 0x94, 0xf1, 0x67, 0x85, 0x12,
+	0x3d, 0x80, 0xbb, 0xc6, 0x8d, 0x71, 0xeb, 0x18, 0x33, 0x9e, 0x4d, 0x75, 0x2c, 0x69, 0x26, 0xa4,
+	0x56, 0x51, 0xd5, 0x46, 0xdc, 0x4e, 0x19, 0xef, 0x3b, 0x7b, 0xdf, 0x98, 0xb1, 0xb3, 0xa2, 0x2e,
+	0xdc, 0xcc, 0xa4, 0x18, 0x32, 0x3e, 0x8e, 0x0f, 0x29, 0x35, 0xc7, 0x1a, 0x51, 0xae, 0xa3, 0x9a,
+	0x0d, 0xba, 0xe1, 0x4d, 0x8f, 0x28, 0x3d, 0x70, 0x06, 0xf4, 0x11, 0xbc, 0x65, 0xb6, 0x52, 0x9a,
+	0xa6, 0xb1, 0x62, 0x29, 0x9b, 0x10, 0xc9, 0xf4, 0x69, 0x11, 0x57, 0xb7, 0x71, 0x51, 0xca, 0xb8,
+	0xb9, 0x9c, 0x41, 0xe1, 0x90, 0x87, 0xbf, 0x07, 0xeb, 0x92, 0x9e, 0x50, 0x32, 0x31, 0x11, 0x4c,
+	0x24, 0xf1, 0x70, 0x22, 0x46, 0xc7, 0x2a, 0x6a, 0xd8, 0x38, 0xe4, 0x6c, 0x07, 0xd6, 0xb4, 0x67,
+	0x2d, 0x68, 0x07, 0x6e, 0x19, 0xdc, 0x94, 0x98, 0x4c, 0xcd, 0xcd, 0xc7, 0x44, 0x6b, 0x9a, 0x66,
+	0x5a, 0x45, 0x41, 0x01, 0xdb, 0xc0, 0xdb, 0x76, 0xbd, 0x09, 0xbd, 0x0f, 0x77, 0x24, 0xfd, 0x92,
+	0x5a, 0xaa, 0xc4, 0x6a, 0x42, 0xd4, 0x51, 0x71, 0xc0, 0xd0, 0x46, 0xdd, 0x2a, 0xcc, 0x03, 0x63,
+	0xcd, 0x4f, 0x67, 0xf6, 0xca, 0xe1, 0xce, 0x41, 0x67, 0x82, 0xab, 0x08, 0xfc, 0x5e, 0x1e, 0xf5,
+	0x67, 0x33, 0x13, 0xfa, 0x19, 0xdc, 0x99, 0xc5, 0x18, 0x58, 0x54, 0xb1, 0x57, 0xd3, 0x46, 0xad,
+	0xe7, 0x51, 0x06, 0x11, 0xe5, 0xb7, 0xea, 0xfc, 0xad, 0x0c, 0xcb, 0x8f, 0x29, 0xa7, 0x8a, 0xa9,
+	0x81, 0x26, 0x9a, 0xa2, 0x0f, 0xa1, 0x9e, 0x59, 0xca, 0x79, 0x72, 0xdd, 0xeb, 0x5e, 0x48, 0xf6,
+	0xae, 0xe3, 0xe5, 0x5e, 0xf5, 0xeb, 0x7f, 0x7d, 0x7f, 0x09, 0xfb, 0x10, 0xf4, 0x3b, 0xb8, 0x51,
+	0x38, 0x3d, 0x25, 0xea, 0xb8, 0xcf, 0x0f, 0x85, 0xa5, 0x4a, 0x73, 0x67, 0xeb, 0x0d, 0x79, 0x76,
+	0xcf, 0xfa, 0xfb, 0x94, 0xe7, 0x13, 0xa1, 0xf8, 0x4c, 0xf6, 0xcf, 0x98, 0xd2, 0x51, 0x75, 0xb3,
+	0xb2, 0xd5, 0xdc, 0x79, 0xf7, 0x0d, 0xd9, 0xfb, 0x3c, 0xa1, 0x2f, 0x68, 0xb2, 0xb0, 0xc9, 0x85,
+	0x1b, 0x98, 0x5c, 0xe8, 0x23, 0x68, 0xf8, 0x77, 0x11, 0xd5, 0x6c, 0xda, 0x37, 0x7d, 0xbc, 0x7b,
+	0x20, 0x3e, 0x51, 0x1e, 0xd3, 0xf9, 0x53, 0x0d, 0xea, 0xce, 0x82, 0x76, 0xa0, 0x41, 0x92, 0x44,
+	0x52, 0xe5, 0x60, 0x0c, 0xf7, 0xa2, 0x7f, 0xfc, 0xe5, 0x27, 0xeb, 0xfe, 0x99, 0xee, 0x3a, 0xcb,
+	0x40, 0x4b, 0xc6, 0xc7, 0x38, 0x77, 0x44, 0xbf, 0x02, 0x90, 0x34, 0x9b, 0x6a, 0x7b, 0xa1, 0x57,
+	0xa0, 0xe6, 0xb6, 0xe9, 0xe2, 0xc2, 0x1f, 0xcf, 0xc5, 0xa2, 0x08, 0x1a, 0x94, 0x93, 0xe1, 0x84,
+	0x26, 0xf6, 0xd5, 0x05, 0x38, 0x17, 0xd1, 0x3b, 0xb0, 0x36, 0x9a, 0x4a, 0x49, 0xb9, 0x8e, 0x35,
+	0x51, 0xc7, 0x31, 0x4b, 0xec, 0x13, 0x0b, 0xf1, 0x8a, 0x57, 0x5b, 0xb0, 0x13, 0xf3, 0x3e, 0x0a,
+	0x3f, 0x5b, 0x02, 0x62, 0x66, 0x90, 0xb4, 0xef, 0xaa, 0x86, 0x51, 0xee, 0x6c, 0x4d, 0x16, 0x63,
+	0xf4, 0x16, 0x84, 0xd9, 0x74, 0x38, 0x61, 0xa3, 0x98, 0x65, 0xf6, 0x19, 0x85, 0x38, 0x70, 0x8a,
+	0x7e, 0x86, 0xee, 0x40, 0x83, 0x65, 0x87, 0xca, 0x6c, 0x17, 0x58, 0x53, 0xdd, 0x88, 0x76, 0x9f,
+	0xba, 0x62, 0x63, 0x4e, 0xa5, 0x7d, 0x10, 0x97, 0xc1, 0xe4, 0xfd, 0xd0, 0x2f, 0x60, 0x35, 0xa3,
+	0x3c, 0x31, 0x85, 0xc2, 0x47, 0xc2, 0x15, 0x91, 0x2b, 0xde, 0x7f, 0x60, 0xdd, 0xdb, 0xff, 0x29,
+	0x01, 0xcc, 0x70, 0x43, 0xdb, 0x50, 0x37, 0xd5, 0x94, 0x26, 0x57, 0x17, 0x53, 0xef, 0x88, 0x6e,
+	0x43, 0x3d, 0x13, 0x8c, 0x6b, 0xe5, 0x4b, 0xa6, 0x97, 0xd0, 0x26, 0x34, 0xe7, 0x1f, 0x6b, 0xc5,
+	0x62, 0x35, 0xaf, 0x42, 0xdf, 0x83, 0x30, 0x2f, 0x20, 0xae, 0x20, 0xd6, 0xf0, 0x4c, 0x81, 0x3e,
+	0x84, 0xe0, 0x39, 0xe3, 0x9c, 0xf1, 0xb1, 0xb2, 0xb7, 0x72, 0xd9, 0x61, 0x3c, 0xf7, 0x8a, 0x00,
+	0xf4, 0x63, 0x68, 0x49, 0xca, 0x13, 0x2a, 0xe3, 0x64, 0x2a, 0xfd, 0x09, 0xea, 0x9b, 0x95, 0xad,
+	0x0a, 0x5e, 0x73, 0xfa, 0x8f, 0x73, 0x75, 0xe7, 0xdf, 0x55, 0x58, 0x59, 0x78, 0x11, 0xe6, 0x8b,
+	0xb4, 0xbd, 0x78, 0xc7, 0x56, 0xec, 0x25, 0xf4, 0x3e, 0x84, 0x92, 0xfe, 0x7e, 0x4a, 0x95, 0xa6,
+	0xd2, 0x7e, 0xec, 0x65, 0x38, 0xcf, 0x5c, 0x51, 0x0b, 0x2a, 0x23, 0x96, 0x58, 0x04, 0x42, 0x6c,
+	0x96, 0xe8, 0x6d, 0x58, 0x26, 0xa9, 0x98, 0x72, 0x1d, 0x1f, 0xb2, 0x09, 0xcd, 0x3f, 0xbe, 0xe9,
+	0x74, 0x8f, 0x8c, 0x0a, 0x6d, 0x00, 0x30, 0xae, 0xb4, 0x9c, 0xa6, 0x79, 0xe5, 0x0f, 0xf1, 0x9c,
+	0xc6, 0x24, 0x4d, 0xb3, 0xfb, 0x96, 0x82, 0x01, 0x36, 0x4b, 0x03, 0xe7, 0x48, 0xa4, 0xd9, 0x84,
+	0x6a, 0x9a, 0x58, 0xce, 0x05, 0x78, 0xa6, 0x30, 0x37, 0x2b, 0xe9, 0x73, 0x22, 0x1d, 0xe7, 0x2e,
+	0xbf, 0x59, 0xe7, 0x88, 0x7e, 0x09, 0x0d, 0x47, 0x77, 0x15, 0x85, 0xb6, 0x00, 0xbc, 0x73, 0x65,
+	0xd5, 0xb2, 0xee, 0x38, 0x0f, 0x33, 0x4f, 0xcf, 0xb7, 0x3f, 0xcb, 0xcb, 0x00, 0xe7, 0x22, 0x3a,
+	0x86, 0x5b, 0x17, 0x37, 0xc6, 0xa6, 0xdd, 0xe9, 0x83, 0xeb, 0xd4, 0xc7, 0xee, 0xf9, 0xd6, 0x89,
+	0x6f, 0xb2, 0x0b, 0xda, 0xe9, 0x6d, 0xa8, 0x1f, 0x12, 0x66, 0x0a, 0xc0, 0xb2, 0x3d, 0x85, 0x97,
+	0xda, 0x5f, 0x01, 0x3a, 0x9f, 0x02, 0xfd, 0x14, 0x02, 0x77, 0x18, 0x2a, 0xaf, 0x2c, 0x57, 0x85,
+	0x27, 0x6a, 0x43, 0xe0, 0xbe, 0xba, 0xef, 0x66, 0x87, 0x10, 0x17, 0xb2, 0xd9, 0x5f, 0x52, 0xa2,
+	0x7c, 0x1d, 0x0b, 0xb1, 0x97, 0x3a, 0xaf, 0x01, 0xd6, 0xce, 0x60, 0x67, 0x2a, 0x47, 0x5e, 0x63,
+	0x72, 0xfe, 0xcd, 0x12, 0xdd, 0x81, 0x46, 0x5e, 0xa8, 0xca, 0x0b, 0xd4, 0x3c, 0x4f, 0xb1, 0x36,
+	0x04, 0x86, 0x5b, 0x9c, 0xa4, 0xd4, 0xd2, 0x2b, 0xc4, 0x85, 0xfc, 0x7f, 0xe7, 0x56, 0x34, 0xeb,
+	0x14, 0xc1, 0x66, 0x65, 0x2b, 0x2c, 0x9a, 0x00, 0xfa, 0x14, 0x82, 0xfc, 0x45, 0xdb, 0x9a, 0xd6,
+	0xdc, 0xe9, 0x5d, 0x8f, 0x43, 0xdd, 0x7c, 0x7c, 0xc0, 0x45, 0x02, 0x34, 0x58, 0xac, 0x28, 0x60,
+	0x99, 0xb2, 0x7d, 0xcd, 0x7c, 0xb3, 0xe9, 0x60, 0xb1, 0x08, 0xbd, 0x07, 0xeb, 0xe4, 0x84, 0x4a,
+	0x32, 0xa6, 0x7e, 0x7c, 0xa2, 0x23, 0xc1, 0x13, 0xe5, 0xc7, 0x04, 0xe4, 0x6d, 0x76, 0x6e, 0x72,
+	0x16, 0xf4, 0x23, 0x58, 0xf3, 0xd3, 0x52, 0x42, 0x49, 0x32, 0x61, 0x9c, 0x5a, 0x5a, 0x55, 0xf0,
+	0xaa, 0x53, 0x7f, 0xec, 0xb5, 0xe6, 0x0a, 0x8a, 0xb9, 0x68, 0xc5, 0x7a, 0x14, 0xb2, 0x2b, 0x50,
+	0x66, 0xda, 0xa1, 0x49, 0x3e, 0x7d, 0x46, 0xab, 0x16, 0xbb, 0xb5, 0x5c, 0xef, 0xe7, 0x4e, 0xb4,
+	0x0e, 0x35, 0xd7, 0x6e, 0xd6, 0x36, 0x4b, 0x5b, 0x2b, 0xd8, 0x09, 0xed, 0x3f, 0x97, 0x21, 0xc8,
+	0x31, 0x42, 0x0f, 0xa0, 0x99, 0x49, 0x91, 0x09, 0x45, 0x93, 0x78, 0x78, 0x7a, 0x25, 0x6b, 0x21,
+	0x77, 0xde, 0x3b, 0x45, 0xbb, 0x50, 0xb3, 0xf3, 0x51, 0x54, 0xbe, 0x74, 0x74, 0x38, 0x77, 0x3d,
+	0x9a, 0xa6, 0xd8, 0x45, 0xa2, 0x7b, 0x00, 0xbe, 0xd9, 0x1d, 0xd3, 0x53, 0xcf, 0x41, 0xdf, 0xfe,
+	0x3e, 0xa5, 0xa7, 0x86, 0x4d, 0x09, 0x93, 0x9e, 0x84, 0x66, 0x69, 0x81, 0x19, 0x8d, 0x68, 0x66,
+	0xc8, 0x54, 0xb3, 0x64, 0x2a, 0x64, 0x84, 0xa0, 0xaa, 0xc8, 0xc4, 0xcd, 0xac, 0x21, 0xb6, 0x6b,
+	0xc3, 0xd7, 0x91, 0x48, 0x53, 0xa6, 0x2d, 0x5f, 0x5d, 0x3b, 0x9d, 0xd3, 0xd8, 0x46, 0xc2, 0xc6,
+	0x9c, 0xe8, 0xa9, 0xa4, 0xbe, 0xa5, 0xce, 0x14, 0xed, 0x3f, 0x96, 0x01, 0x66, 0xb7, 0x6f, 0xaa,
+	0x78, 0x31, 0xb9, 0x5f, 0x89, 0xd4, 0xcc, 0xf5, 0x3b, 0x00, 0xea, 0x1e, 0x00, 0x53, 0xb1, 0x21,
+	0x91, 0x54, 0xd4, 0xcf, 0x2a, 0x21, 0x53, 0xd8, 0x29, 0x0a, 0x64, 0x6a, 0x6f, 0x44, 0xa6, 0x7e,
+	0x39, 0x32, 0x8d, 0xb3, 0xc8, 0xbc, 0x2e, 0x41, 0xd5, 0x9c, 0x6f, 0xa1, 0x58, 0x94, 0xce, 0x14,
+	0x8b, 0xf3, 0xa5, 0x05, 0x41, 0xf5, 0x88, 0xa8, 0x23, 0x7f, 0xa3, 0x76, 0x6d, 0x0e, 0x62, 0xa1,
+	0x7a, 0x68, 0x3a, 0x98, 0xff, 0xa1, 0x32, 0xa7, 0x41, 0x1d, 0x58, 0xf6, 0x95, 0xd9, 0x79, 0xb8,
+	0x9f, 0x24, 0x0b, 0x3a, 0x33, 0x31, 0x1c, 0x32, 0x3e, 0xa6, 0x32, 0x93, 0xac, 0xb8, 0xe7, 0x79,
+	0x95, 0x79, 0x0a, 0x99, 0x14, 0xe2, 0xd0, 0xcd, 0x47, 0xd8, 0x09, 0x9f, 0x54, 0x83, 0x72, 0xab,
+	0xf2, 0x49, 0x35, 0x08, 0x5a, 0xf3, 0x5f, 0x37, 0x8f, 0x43, 0xe7, 0x5d, 0xb8, 0x71, 0x6e, 0xb4,
+	0x36, 0x65, 0x99, 0xd3, 0x17, 0xda, 0xf7, 0xf9, 0x0a, 0xf6, 0x52, 0xe7, 0x2b, 0x58, 0xbf, 0x68,
+	0x52, 0x9e, 0x3d, 0x44, 0x07, 0x91, 0x13, 0xd0, 0x01, 0xac, 0x2c, 0xcc, 0xce, 0xb6, 0x32, 0x37,
+	0x77, 0x7e, 0x70, 0x9d, 0x0e, 0xe6, 0xe7, 0x96, 0xc5, 0x04, 0x9d, 0xbf, 0x96, 0xe7, 0x26, 0x92,
+	0xcf, 0xc4, 0x58, 0x2d, 0x34, 0x97, 0xb3, 0x3d, 0x61, 0x1f, 0xaa, 0x13, 0x31, 0xce, 0x69, 0x79,
+	0x65, 0x39, 0x34, 0xf9, 0x16, 0x24, 0x6c, 0xc3, 0xdb, 0x7f, 0x2f, 0xc1, 0xf2, 0xbc, 0xda, 0xdc,
+	0xfb, 0x44, 0x8c, 0x7d, 0x9f, 0x31, 0x4b, 0x43, 0x26, 0xcd, 0x52, 0xaa, 0x34, 0x49, 0x33, 0xff,
+	0x93, 0x77, 0xa6, 0x40, 0xcf, 0x20, 0x50, 0x86, 0xa9, 0x4c, 0x9f, 0x5a, 0x66, 0xac, 0xee, 0xfc,
+	0xfc, 0x7f, 0x3e, 0x4b, 0x77, 0xb0, 0xff, 0x6c, 0x1f, 0xf7, 0x9f, 0xfe, 0x06, 0x17, 0xb9, 0x3a,
+	0x0f, 0x20, 0xc8, 0xb5, 0x28, 0x80, 0x6a, 0xff, 0xc9, 0xa3, 0xcf, 0x5b, 0x4b, 0xa8, 0x09, 0x8d,
+	0xc1, 0xaf, 0x1f, 0x3e, 0xdc, 0x1f, 0x0c, 0x5a, 0x25, 0x14, 0x42, 0x6d, 0x1f, 0xe3, 0xcf, 0x71,
+	0xab, 0x6c, 0xf4, 0x5f, 0xec, 0xe2, 0x27, 0xfd, 0x27, 0x8f, 0x5b, 0x95, 0xbd, 0x0f, 0xbe, 0x7e,
+	0xb9, 0x51, 0xfa, 0xe6, 0xe5, 0x46, 0xe9, 0xdb, 0x97, 0x1b, 0xa5, 0x3f, 0xbc, 0xda, 0x58, 0xfa,
+	0xe6, 0xd5, 0xc6, 0xd2, 0x3f, 0x5f, 0x6d, 0x2c, 0xfd, 0xf6, 0xde, 0x98, 0xe9, 0xa3, 0xe9, 0xb0,
+	0x3b, 0x12, 0x69, 0xef, 0xfc, 0x3f, 0x1c, 0xc3, 0xba, 0xfd, 0xc7, 0xe1, 0xfe, 0x7f, 0x03, 0x00,
+	0x00, 0xff, 0xff, 0x44, 0xa9, 0x12, 0x65, 0xfe, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1160,6 +1466,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinValidStemsPercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidStemsPercent))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinValidValidations != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidValidations))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RejectionSlashPercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RejectionSlashPercent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxSolutionAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSolutionAttempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RevealPeriodBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealPeriodBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinStemSimilarityPercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinStemSimilarityPercent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProbingFeePercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProbingFeePercent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinInvalidInputReports != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinInvalidInputReports))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MinValidators != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidators))
 		i--
@@ -1276,6 +1622,20 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSigner) > 0 {
+		i -= len(m.PendingSigner)
+		copy(dAtA[i:], m.PendingSigner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingSigner)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.IpfsId) > 0 {
 		i -= len(m.IpfsId)
 		copy(dAtA[i:], m.IpfsId)
@@ -1433,6 +1793,40 @@ func (m *AudioStemTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.InvalidInputReports) > 0 {
+		for iNdEx := len(m.InvalidInputReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvalidInputReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Invalid {
+		i--
+		if m.Invalid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Threads) > 0 {
 		for iNdEx := len(m.Threads) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1515,7 +1909,7 @@ func (m *AudioStemTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AudioStemThread) Marshal() (dAtA []byte, err error) {
+func (m *AudioStemTask_InvalidInputReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1525,44 +1919,112 @@ func (m *AudioStemThread) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AudioStemThread) MarshalTo(dAtA []byte) (int, error) {
+func (m *AudioStemTask_InvalidInputReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AudioStemThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AudioStemTask_InvalidInputReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AverageStemSeconds != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AverageStemSeconds))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1a
 	}
-	if len(m.Validations) > 0 {
-		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Solution != nil {
-		{
-			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AudioStemThread) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AudioStemThread) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AudioStemThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RejectedWorkers) > 0 {
+		for iNdEx := len(m.RejectedWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RejectedWorkers[iNdEx])
+			copy(dAtA[i:], m.RejectedWorkers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RejectedWorkers[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RevealDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealDeadline))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AverageStemSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AverageStemSeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Solution != nil {
+		{
+			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -1653,6 +2115,27 @@ func (m *AudioStemThread_Solution) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Accepted {
 		i--
 		if m.Accepted {
@@ -1721,6 +2204,27 @@ func (m *AudioStemThread_Validation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.IsReverse {
 		i--
 		if m.IsReverse {
@@ -1782,6 +2286,20 @@ func (m *AudioStemThread_Stem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.InvalidCount != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidCount))
 		i--
@@ -1806,13 +2324,6 @@ func (m *AudioStemThread_Stem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Filename) > 0 {
 		i -= len(m.Filename)
 		copy(dAtA[i:], m.Filename)
@@ -2002,6 +2513,30 @@ func (m *Params) Size() (n int) {
 	if m.MinValidators != 0 {
 		n += 1 + sovTypes(uint64(m.MinValidators))
 	}
+	if m.MinInvalidInputReports != 0 {
+		n += 1 + sovTypes(uint64(m.MinInvalidInputReports))
+	}
+	if m.ProbingFeePercent != 0 {
+		n += 1 + sovTypes(uint64(m.ProbingFeePercent))
+	}
+	if m.MinStemSimilarityPercent != 0 {
+		n += 1 + sovTypes(uint64(m.MinStemSimilarityPercent))
+	}
+	if m.RevealPeriodBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.RevealPeriodBlocks))
+	}
+	if m.MaxSolutionAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSolutionAttempts))
+	}
+	if m.RejectionSlashPercent != 0 {
+		n += 1 + sovTypes(uint64(m.RejectionSlashPercent))
+	}
+	if m.MinValidValidations != 0 {
+		n += 1 + sovTypes(uint64(m.MinValidValidations))
+	}
+	if m.MinValidStemsPercent != 0 {
+		n += 1 + sovTypes(uint64(m.MinValidStemsPercent))
+	}
 	return n
 }
 
@@ -2062,6 +2597,14 @@ func (m *Worker) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PendingSigner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2137,6 +2680,39 @@ func (m *AudioStemTask) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.Invalid {
+		n += 2
+	}
+	if len(m.InvalidInputReports) > 0 {
+		for _, e := range m.InvalidInputReports {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AudioStemTask_InvalidInputReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2191,6 +2767,21 @@ func (m *AudioStemThread) Size() (n int) {
 	if m.AverageStemSeconds != 0 {
 		n += 1 + sovTypes(uint64(m.AverageStemSeconds))
 	}
+	if m.RevealDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.RevealDeadline))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTypes(uint64(m.Attempts))
+	}
+	if len(m.RejectedWorkers) > 0 {
+		for _, s := range m.RejectedWorkers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
 	return n
 }
 
@@ -2221,6 +2812,18 @@ func (m *AudioStemThread_Solution) Size() (n int) {
 	if m.Accepted {
 		n += 2
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2247,6 +2850,18 @@ func (m *AudioStemThread_Validation) Size() (n int) {
 	if m.IsReverse {
 		n += 2
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2260,10 +2875,6 @@ func (m *AudioStemThread_Stem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
 	l = len(m.Cid)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
@@ -2278,6 +2889,14 @@ func (m *AudioStemThread_Stem) Size() (n int) {
 	if m.InvalidCount != 0 {
 		n += 1 + sovTypes(uint64(m.InvalidCount))
 	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2455,61 +3074,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInvalidInputReports", wireType)
 			}
-			var msglen int
+			m.MinInvalidInputReports = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2519,30 +3088,232 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinInvalidInputReports |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTaskInfo", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbingFeePercent", wireType)
 			}
-			var msglen int
+			m.ProbingFeePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbingFeePercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStemSimilarityPercent", wireType)
+			}
+			m.MinStemSimilarityPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStemSimilarityPercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriodBlocks", wireType)
+			}
+			m.RevealPeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPeriodBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSolutionAttempts", wireType)
+			}
+			m.MaxSolutionAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSolutionAttempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionSlashPercent", wireType)
+			}
+			m.RejectionSlashPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectionSlashPercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidValidations", wireType)
+			}
+			m.MinValidValidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidValidations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidStemsPercent", wireType)
+			}
+			m.MinValidStemsPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidStemsPercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudioStemTaskInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2892,6 +3663,70 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			}
 			m.IpfsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3332,18 +4167,182 @@ func (m *AudioStemTask) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mp3", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mp3 = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threads = append(m.Threads, &AudioStemThread{})
+			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalid = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidInputReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instrument = string(dAtA[iNdEx:postIndex])
+			m.InvalidInputReports = append(m.InvalidInputReports, &AudioStemTask_InvalidInputReport{})
+			if err := m.InvalidInputReports[len(m.InvalidInputReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mp3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3360,12 +4359,62 @@ func (m *AudioStemTask) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Mp3 = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AudioStemTask_InvalidInputReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidInputReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidInputReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3375,17 +4424,29 @@ func (m *AudioStemTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3395,33 +4456,29 @@ func (m *AudioStemTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reward == nil {
-				m.Reward = &types.Coin{}
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3431,25 +4488,23 @@ func (m *AudioStemTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threads = append(m.Threads, &AudioStemThread{})
-			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3822,6 +4877,95 @@ func (m *AudioStemThread) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDeadline", wireType)
+			}
+			m.RevealDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedWorkers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectedWorkers = append(m.RejectedWorkers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3874,7 +5018,73 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stems = append(m.Stems, &AudioStemThread_Stem{})
+			if err := m.Stems[len(m.Stems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3902,13 +5112,13 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3918,29 +5128,47 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stems = append(m.Stems, &AudioStemThread_Stem{})
-			if err := m.Stems[len(m.Stems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3968,11 +5196,11 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4000,13 +5228,13 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Commitment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4016,12 +5244,24 @@ func (m *AudioStemThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4190,6 +5430,102 @@ func (m *AudioStemThread_Validation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsReverse = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4272,9 +5608,9 @@ func (m *AudioStemThread_Stem) Unmarshal(dAtA []byte) error {
 			}
 			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4302,11 +5638,11 @@ func (m *AudioStemThread_Stem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4334,11 +5670,49 @@ func (m *AudioStemThread_Stem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
+			}
+			m.ValidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+			}
+			m.InvalidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4366,13 +5740,13 @@ func (m *AudioStemThread_Stem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.ValidCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4382,30 +5756,24 @@ func (m *AudioStemThread_Stem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.InvalidCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
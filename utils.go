@@ -3,12 +3,17 @@ package audioStem
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	fmt "fmt"
 	io "io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-audio/wav"
 	"github.com/hajimehoshi/go-mp3"
@@ -41,11 +46,34 @@ func MapToKeyValueFormat(inputMap map[string]string) []string {
 	for key, value := range inputMap {
 		parts = append(parts, fmt.Sprintf("%s=%s", key, value))
 	}
+	// maps are iterated in random order
+	slices.Sort(parts)
 
 	// Join the key=value pairs with commas
 	return parts
 }
 
+// signers holds the address that sends the transactions of each worker of this node.
+var signers sync.Map
+
+// SetWorkerSigner makes the transactions of the worker be sent by the signer, a hot key its
+// operator set on chain. An empty signer sends them from the worker again.
+func SetWorkerSigner(worker, signer string) {
+	if signer == "" {
+		signers.Delete(worker)
+		return
+	}
+	signers.Store(worker, signer)
+}
+
+// signerOf returns the address that sends the transactions of the worker.
+func signerOf(worker string) string {
+	if signer, ok := signers.Load(worker); ok {
+		return signer.(string)
+	}
+	return worker
+}
+
 // Executes a cli command with their arguments
 func ExecuteCli(args []string) error {
 	executableName := "janctiond"
@@ -66,40 +94,68 @@ func ExecuteCli(args []string) error {
 	return nil
 }
 
-func FromCliToFrames(entries []string) map[string]AudioStemThread_Stem {
+// FromRevealsToFrames returns the revealed stems by filename. Reveals without filename are
+// skipped, the last reveal of a filename wins.
+func FromRevealsToFrames(reveals []*StemReveal) map[string]AudioStemThread_Stem {
 	result := make(map[string]AudioStemThread_Stem)
 
-	for _, entry := range entries {
-		parts := strings.Split(entry, "=")
-		if len(parts) != 2 {
-			fmt.Println("Invalid entry:", entry)
+	for _, reveal := range reveals {
+		if reveal == nil || reveal.Filename == "" {
 			continue
 		}
-
-		filename := parts[0]
-		cidAndHash := strings.Split(parts[1], ":")
-		if len(cidAndHash) != 2 {
-			fmt.Println("Invalid CID:Hash format:", parts[1])
-			continue
+		result[reveal.Filename] = AudioStemThread_Stem{
+			Filename:    reveal.Filename,
+			Cid:         reveal.Cid,
+			Hash:        reveal.Hash,
+			Fingerprint: reveal.Fingerprint,
+			Proof:       reveal.Proof,
 		}
-		frame := AudioStemThread_Stem{Filename: filename, Cid: cidAndHash[0], Hash: cidAndHash[1]}
-		result[filename] = frame
 	}
 
 	return result
 }
 
-func FromFramesToCli(frames map[string]AudioStemThread_Stem) []string {
-	var result []string
+// FromFramesToReveals returns the stems as they are revealed on chain, sorted by filename.
+func FromFramesToReveals(frames map[string]AudioStemThread_Stem) []*StemReveal {
+	var result []*StemReveal
 
 	for filename, frame := range frames {
-		entry := fmt.Sprintf("%s=%s:%s", filename, frame.Cid, frame.Hash)
-		result = append(result, entry)
+		result = append(result, &StemReveal{
+			Filename:    filename,
+			Cid:         frame.Cid,
+			Hash:        frame.Hash,
+			Fingerprint: frame.Fingerprint,
+			Proof:       frame.Proof,
+		})
 	}
+	// maps are iterated in random order
+	slices.SortFunc(result, func(a, b *StemReveal) int { return strings.Compare(a.Filename, b.Filename) })
 
 	return result
 }
 
+// WriteReveals writes each reveal as a JSON file in the directory and returns their paths.
+// The CLI reads message arguments from files ending in .json.
+func WriteReveals(dir string, reveals []*StemReveal) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, reveal := range reveals {
+		data, err := json.Marshal(reveal)
+		if err != nil {
+			return nil, err
+		}
+		file := filepath.Join(dir, reveal.Filename+".json")
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			return nil, err
+		}
+		paths = append(paths, file)
+	}
+	return paths, nil
+}
+
 // CalculateFileHash calculates the SHA-256 hash of a given file.
 func CalculateFileHash(filePath string) (string, error) {
 	hash, err := calculateAudioSampleHash(filePath)
@@ -109,6 +165,54 @@ func CalculateFileHash(filePath string) (string, error) {
 	return hash, nil
 }
 
+// ValidateAudioFile checks that the file can be decoded as WAV or MP3.
+func ValidateAudioFile(filePath string) error {
+	_, err := calculateAudioSampleHash(filePath)
+	return err
+}
+
+// ErrUndecodableAudio is returned when a file is neither a valid WAV nor a valid MP3.
+var ErrUndecodableAudio = errors.New("audio can't be decoded")
+
+// AudioInfo describes a probed audio file.
+type AudioInfo struct {
+	Format     string
+	Duration   time.Duration
+	SampleRate int
+	Channels   int
+}
+
+// ProbeAudioFile reads the headers of a WAV or MP3 file without decoding all of it.
+func ProbeAudioFile(filePath string) (AudioInfo, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return AudioInfo{}, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	wavDecoder := wav.NewDecoder(file)
+	if wavDecoder.IsValidFile() {
+		duration, err := wavDecoder.Duration()
+		if err != nil || duration <= 0 || wavDecoder.SampleRate == 0 || wavDecoder.NumChans == 0 {
+			return AudioInfo{}, fmt.Errorf("%w: invalid WAV header", ErrUndecodableAudio)
+		}
+		return AudioInfo{Format: "wav", Duration: duration, SampleRate: int(wavDecoder.SampleRate), Channels: int(wavDecoder.NumChans)}, nil
+	}
+
+	file.Seek(0, io.SeekStart)
+	mp3Decoder, err := mp3.NewDecoder(file)
+	if err != nil {
+		return AudioInfo{}, fmt.Errorf("%w: %s", ErrUndecodableAudio, err.Error())
+	}
+	// the decoder always outputs 16 bit stereo samples
+	length := mp3Decoder.Length()
+	if length <= 0 || mp3Decoder.SampleRate() == 0 {
+		return AudioInfo{}, fmt.Errorf("%w: empty MP3 stream", ErrUndecodableAudio)
+	}
+	duration := time.Duration(length/4) * time.Second / time.Duration(mp3Decoder.SampleRate())
+	return AudioInfo{Format: "mp3", Duration: duration, SampleRate: mp3Decoder.SampleRate(), Channels: 2}, nil
+}
+
 func calculateAudioSampleHash(filePath string) (string, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
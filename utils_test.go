@@ -1,17 +1,14 @@
 package audioStem
 
 import (
-	fmt "fmt"
-	"image"
-	"image/png"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
 
-	"bou.ke/monkey"
+	"github.com/go-audio/audio"
+	"github.com/go-audio/wav"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,40 +75,28 @@ func TestMapToKeyValueFormat(t *testing.T) {
 // --- Test for ExecuteCli ---
 func TestExecuteCli(t *testing.T) {
 	tests := []struct {
-		name       string
-		mockOutput func(cmd *exec.Cmd) ([]byte, error)
-		expectErr  bool
+		name      string
+		script    string
+		expectErr bool
 	}{
 		{
-			name: "Successful CLI execution",
-			mockOutput: func(cmd *exec.Cmd) ([]byte, error) {
-				return []byte("simulated output"), nil
-			},
+			name:      "Successful CLI execution",
+			script:    "#!/bin/sh\necho simulated output\n",
 			expectErr: false,
 		},
 		{
-			name: "CLI execution error",
-			mockOutput: func(cmd *exec.Cmd) ([]byte, error) {
-				return nil, fmt.Errorf("simulated error")
-			},
+			name:      "CLI execution error",
+			script:    "#!/bin/sh\necho simulated error >&2\nexit 1\n",
 			expectErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Monkey patch exec.Command to return a minimal valid *exec.Cmd
-			patchCmd := monkey.Patch(exec.Command, func(name string, args ...string) *exec.Cmd {
-				return &exec.Cmd{
-					Path: name,
-					Args: append([]string{name}, args...),
-				}
-			})
-			defer patchCmd.Unpatch()
-
-			// Monkey patch the Output method of *exec.Cmd to simulate CLI output or error
-			patchOutput := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "htdemucs", tt.mockOutput)
-			defer patchOutput.Unpatch()
+			// a fake janctiond is the only binary in the path
+			dir := t.TempDir()
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, "janctiond"), []byte(tt.script), 0o755))
+			t.Setenv("PATH", dir)
 
 			// Execute the CLI function
 			err := ExecuteCli([]string{"test"})
@@ -124,48 +109,30 @@ func TestExecuteCli(t *testing.T) {
 	}
 }
 
-// --- Test for FromCliToFrames ---
-func TestFromCliToFrames(t *testing.T) {
+// --- Test for FromRevealsToFrames ---
+func TestFromRevealsToFrames(t *testing.T) {
 	tests := []struct {
 		name     string
-		entries  []string
-		expected map[string]AudioStemThread_Frame
+		reveals  []*StemReveal
+		expected map[string]AudioStemThread_Stem
 	}{
 		{
-			name:    "Valid entry",
-			entries: []string{"file1.png=cid123:hash123"},
-			expected: map[string]AudioStemThread_Frame{
-				"file1.png": {Filename: "file1.png", Cid: "cid123", Hash: "hash123"},
+			name:    "Valid reveal",
+			reveals: []*StemReveal{{Filename: "vocals.wav", Cid: "cid123", Hash: "hash123", Proof: "0,1"}},
+			expected: map[string]AudioStemThread_Stem{
+				"vocals.wav": {Filename: "vocals.wav", Cid: "cid123", Hash: "hash123", Proof: "0,1"},
 			},
 		},
 		{
-			name:     "Invalid entry (missing '=')",
-			entries:  []string{"invalidEntryWithoutEquals"},
-			expected: map[string]AudioStemThread_Frame{},
-		},
-		{
-			name:     "Invalid CID:Hash format",
-			entries:  []string{"file2.png=missingColon"},
-			expected: map[string]AudioStemThread_Frame{},
-		},
-		{
-			name: "Mixed entries",
-			entries: []string{
-				"valid1.png=cidA:hashA",
-				"invalidNoEquals",
-				"badCidHash=justcid",
-				"valid2.png=cidB:hashB",
-			},
-			expected: map[string]AudioStemThread_Frame{
-				"valid1.png": {Filename: "valid1.png", Cid: "cidA", Hash: "hashA"},
-				"valid2.png": {Filename: "valid2.png", Cid: "cidB", Hash: "hashB"},
-			},
+			name:     "Reveals without filename",
+			reveals:  []*StemReveal{nil, {Cid: "cid123", Hash: "hash123"}},
+			expected: map[string]AudioStemThread_Stem{},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := FromCliToFrames(tt.entries)
+			result := FromRevealsToFrames(tt.reveals)
 
 			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("Expected: %+v, got: %+v", tt.expected, result)
@@ -174,67 +141,41 @@ func TestFromCliToFrames(t *testing.T) {
 	}
 }
 
-// --- Test for FromFramesToCli ---
-func TestFromFramesToCli(t *testing.T) {
-	tests := []struct {
-		name     string
-		frames   map[string]AudioStemThread_Frame
-		expected []string
-	}{
-		{
-			name: "Valid input",
-			frames: map[string]AudioStemThread_Frame{
-				"file1": {Filename: "file1", Cid: "cid1", Hash: "hash1"},
-			},
-			expected: []string{"file1=cid1:hash1"},
-		},
-		{
-			name:     "Empty map",
-			frames:   map[string]AudioStemThread_Frame{},
-			expected: []string{},
-		},
-		{
-			name: "Multiple frames",
-			frames: map[string]AudioStemThread_Frame{
-				"file1": {Filename: "file1", Cid: "cid1", Hash: "hash1"},
-				"file2": {Filename: "file2", Cid: "cid2", Hash: "hash2"},
-			},
-			expected: []string{"file1=cid1:hash1", "file2=cid2:hash2"},
-		},
+// --- Test for FromFramesToReveals ---
+func TestFromFramesToReveals(t *testing.T) {
+	frames := map[string]AudioStemThread_Stem{
+		"vocals.wav": {Filename: "vocals.wav", Cid: "cid2", Hash: "hash2"},
+		"bass.wav":   {Filename: "bass.wav", Cid: "cid1", Hash: "hash1", Fingerprint: "AAAA"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := FromFramesToCli(tt.frames)
-			if len(result) == 0 && len(tt.expected) == 0 {
-				return
-			}
-			sort.Strings(result)
-			sort.Strings(tt.expected)
-			if !reflect.DeepEqual(result, tt.expected) {
-				t.Errorf("Expected %v, got %v", tt.expected, result)
-			}
-		})
+	result := FromFramesToReveals(frames)
+	expected := []*StemReveal{
+		{Filename: "bass.wav", Cid: "cid1", Hash: "hash1", Fingerprint: "AAAA"},
+		{Filename: "vocals.wav", Cid: "cid2", Hash: "hash2"},
 	}
-}
+	assert.Equal(t, expected, result)
+	assert.Equal(t, frames, FromRevealsToFrames(result))
 
-func createTestImage(filePath string) error {
-	// Create a simple image (100x100 white image)
-	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	paths, err := WriteReveals(t.TempDir(), result)
+	assert.NoError(t, err)
+	assert.Len(t, paths, 2)
+	assert.Equal(t, "bass.wav.json", filepath.Base(paths[0]))
+}
 
-	// Create the image file
-	imgFile, err := os.Create(filePath)
+func createTestWav(filePath string) error {
+	// Create a short mono wav file
+	file, err := os.Create(filePath)
 	if err != nil {
 		return err
 	}
-	defer imgFile.Close()
+	defer file.Close()
 
-	// Encode the image as PNG
-	err = png.Encode(imgFile, img)
-	if err != nil {
+	encoder := wav.NewEncoder(file, 8000, 16, 1, 1)
+	buf := &audio.IntBuffer{Format: &audio.Format{NumChannels: 1, SampleRate: 8000}, Data: []int{1, 2, 3, 4}, SourceBitDepth: 16}
+	if err := encoder.Write(buf); err != nil {
 		return err
 	}
-	return nil
+	return encoder.Close()
 }
 
 func createTestTextFile(filePath string) error {
@@ -251,17 +192,16 @@ func createTestTextFile(filePath string) error {
 
 // --- Test for CalculateFileHash ---
 func TestCalculateFileHash(t *testing.T) {
-	err := createTestImage("test_image.png")
+	dir := t.TempDir()
+	err := createTestWav(filepath.Join(dir, "test_audio.wav"))
 	if err != nil {
-		t.Fatalf("Failed to create a test image: %v", err)
+		t.Fatalf("Failed to create a test wav: %v", err)
 	}
-	defer os.Remove("test_image.png")
 
-	err = createTestTextFile("text_test_file.txt")
+	err = createTestTextFile(filepath.Join(dir, "text_test_file.txt"))
 	if err != nil {
 		t.Fatalf("Failed to create a text test file: %v", err)
 	}
-	defer os.Remove("text_test_file.txt")
 
 	tests := []struct {
 		name            string
@@ -269,18 +209,18 @@ func TestCalculateFileHash(t *testing.T) {
 		expectedToError bool
 	}{
 		{
-			name:            "Valid image file",
-			filePath:        "test_image.png",
+			name:            "Valid audio file",
+			filePath:        filepath.Join(dir, "test_audio.wav"),
 			expectedToError: false,
 		},
 		{
 			name:            "Non-existent file",
-			filePath:        "non_existent_file.png",
+			filePath:        filepath.Join(dir, "non_existent_file.wav"),
 			expectedToError: true,
 		},
 		{
 			name:            "Invalid file format",
-			filePath:        "text_test_file.txt",
+			filePath:        filepath.Join(dir, "text_test_file.txt"),
 			expectedToError: true,
 		},
 	}
@@ -307,24 +247,14 @@ func TestGenerateDirectoryFileHashes(t *testing.T) {
 		expectError       bool
 	}
 
-	createPng := func(path string) error {
-		img := image.NewRGBA(image.Rect(0, 0, 1, 1))
-		file, err := os.Create(path)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-		return png.Encode(file, img)
-	}
-
 	tests := []testCase{
 		{
-			name: "Directory with 2 PNG files",
+			name: "Directory with 2 wav files",
 			setup: func(dir string) error {
-				if err := createPng(filepath.Join(dir, "a.png")); err != nil {
+				if err := createTestWav(filepath.Join(dir, "a.wav")); err != nil {
 					return err
 				}
-				if err := createPng(filepath.Join(dir, "b.png")); err != nil {
+				if err := createTestWav(filepath.Join(dir, "b.wav")); err != nil {
 					return err
 				}
 				return nil
@@ -350,13 +280,12 @@ func TestGenerateDirectoryFileHashes(t *testing.T) {
 			expectError:       true,
 		},
 		{
-			name: "PNG file with unreadable permissions",
+			name: "File that isn't audio",
 			setup: func(dir string) error {
-				path := filepath.Join(dir, "unreadable.png")
-				if err := createPng(path); err != nil {
+				if err := createTestWav(filepath.Join(dir, "a.wav")); err != nil {
 					return err
 				}
-				return os.Chmod(path, 0000)
+				return createTestTextFile(filepath.Join(dir, "notes.txt"))
 			},
 			expectedHashCount: 0,
 			expectError:       true,
@@ -31,7 +31,7 @@ func IsContainerRunning(ctx context.Context, threadId string) bool {
 	return containerName == name
 }
 
-func StemAudio(ctx context.Context, id string, filename string, instrument string, mp3 bool, path string, db *db.DB) error {
+func StemAudio(ctx context.Context, id string, filename string, instrument string, mp3 bool, path string, db db.Database) error {
 	n := "janctionstem" + id
 
 	started := time.Now().Unix()
@@ -2,494 +2,210 @@ package vm
 
 import (
 	"context"
-	"fmt"
 	"os"
-	"os/exec"
-	"reflect"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
-	"bou.ke/monkey"
-	"github.com/janction/audioStem/db"
-	"github.com/janction/audioStem/mocks"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem/db"
 )
 
+// fakeDocker puts a docker command running the script first in the path.
+func fakeDocker(t *testing.T, script string) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docker"), []byte("#!/bin/sh\n"+script+"\n"), 0o755))
+	t.Setenv("PATH", dir)
+}
+
 // --- Test for IsContainerRunning ---
 func TestIsContainerRunningKo(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
+	fakeDocker(t, "exit 1")
 
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Output error")
-	})
-	defer patch2.Unpatch()
-
-	// 3. Execute method under test
+	// 2. Execute method under test
 	b := IsContainerRunning(ctx, "1234")
 
-	// 4. Verification
+	// 3. Verification
 	require.False(t, b)
 }
 
 func TestIsContainerRunningOk(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
+	fakeDocker(t, "echo janctionstem1234")
 
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("myBlender1234"), nil
-	})
-	defer patch2.Unpatch()
-
-	// 3. Execute method under test
+	// 2. Execute method under test
 	b := IsContainerRunning(ctx, "1234")
 
-	// 4. Verification
+	// 3. Verification
 	require.True(t, b)
 }
 
-// --- Test for RenderVideo ---
-func TestRenderVideoNoReverse(t *testing.T) {
-	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := false
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
-
-	// 2. Monkey patch the renderVideoFrame function to not actually call it, just save the call to a variable
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
-		function_calls = append(function_calls, frameNumber)
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
-
-	// 5. Verification
-	expected := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	require.Equal(t, function_calls, expected)
-}
-
-func TestRenderVideoReverse(t *testing.T) {
-	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := true
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
-
-	// 2. Monkey patch the renderVideoFrame function to not actually call it, just count the number of times it is called
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
-		function_calls = append(function_calls, frameNumber)
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
-
-	// 5. Verification
-	expected := []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
-	require.Equal(t, function_calls, expected)
-}
-
-// --- Test for renderVideoFrame ---
-func TestRenderVideoFrame_ContainerVerificationError(t *testing.T) {
+func TestIsContainerRunning_OtherContainer(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
 	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
+	fakeDocker(t, "echo janctionstem12345")
 
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Error verifying if container already exists")
-	})
-	defer patch2.Unpatch()
+	// 2. Execute method under test
+	b := IsContainerRunning(ctx, "1234")
 
-	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 3. Verification
+	require.False(t, b)
+}
 
-	// 5. Verification
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to check container existence: Error verifying if container already exists")
+// --- Test for StemAudio ---
+
+// stemmingDocker returns a docker script that records its calls in the returned file, and
+// runs the step script for the docker command with the same name.
+func stemmingDocker(t *testing.T, steps map[string]string) (string, string) {
+	calls := filepath.Join(t.TempDir(), "calls")
+	script := "echo \"$@\" >> " + calls + "\ncase \"$1\" in\n"
+	for command, step := range steps {
+		script += command + ") " + step + " ;;\n"
+	}
+	return script + "esac", calls
+}
 
-	// 6. Verify mock expectations
-	mockDB.AssertExpectations(t)
+func dockerCalls(t *testing.T, calls string) []string {
+	content, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(content)), "\n")
 }
 
-func TestRenderVideoFrame_ContainerAlreadyExist(t *testing.T) {
+func TestStemAudio_ContainerVerificationError(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
+	script, _ := stemmingDocker(t, map[string]string{"ps": "exit 1"})
+	fakeDocker(t, script)
 
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, t.TempDir(), db.NewMemory())
 
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to check container existence")
+}
 
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("myBlender1234"), nil
-	})
-	defer patch2.Unpatch()
+func TestStemAudio_ContainerAlreadyExist(t *testing.T) {
+	// 1. Setup
+	script, calls := stemmingDocker(t, map[string]string{"ps": "echo janctionstem1-0"})
+	fakeDocker(t, script)
 
-	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, t.TempDir(), db.NewMemory())
 
-	// 5. Verification
+	// 3. Verification, the running container is left alone
 	require.NoError(t, err)
-
-	// 6. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	require.Len(t, dockerCalls(t, calls), 1)
 }
 
-func TestRenderVideoFrame_CreatingContainerKo(t *testing.T) {
+func TestStemAudio_CreatingContainerKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate failure when creating the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("Error creating container")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to create and start container: Error creating container")
+	script, calls := stemmingDocker(t, map[string]string{"run": "echo no such image >&2; exit 1"})
+	fakeDocker(t, script)
+
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, t.TempDir(), db.NewMemory())
 
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to create and start container")
+	require.Len(t, dockerCalls(t, calls), 2)
 }
 
-func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerKo(t *testing.T) {
+func TestStemAudio_CreatingContainerOk_WaitingContainerKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating the container and failure when waiting for it
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return fmt.Errorf("failed here")
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to wait for container: failed here")
+	script, calls := stemmingDocker(t, map[string]string{"wait": "exit 1"})
+	fakeDocker(t, script)
 
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, t.TempDir(), db.NewMemory())
+
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to wait for container")
+	require.Len(t, dockerCalls(t, calls), 3)
 }
 
-func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsKo(t *testing.T) {
+func TestStemAudio_CreatingContainerOk_WaitingContainerOk_RetrieveLogsKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs fail
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return nil, fmt.Errorf("failed here")
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container and failure when retrieving
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to retrieve container logs: failed here")
+	script, calls := stemmingDocker(t, map[string]string{"logs": "exit 1"})
+	fakeDocker(t, script)
+
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, t.TempDir(), db.NewMemory())
 
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to retrieve container logs")
+	require.Len(t, dockerCalls(t, calls), 4)
 }
 
-func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsOk_VerifyFileOk(t *testing.T) {
+func TestStemAudio_CreatingContainerOk_WaitingContainerOk_RetrieveLogsOk(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs succeeds
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return []byte(""), nil
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Patch Os.Stat to simulate success when verifying that file exists
-	patch4 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return nil, nil
-	})
-	defer patch4.Unpatch()
-
-	// 7. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 8. Assert no error
-	require.NoError(t, err)
+	script, calls := stemmingDocker(t, map[string]string{"logs": "echo separated"})
+	fakeDocker(t, script)
+	path := t.TempDir()
 
-	// 9. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 2. Execute method under test
+	err := StemAudio(context.Background(), "1-0", "song.wav", "vocals", false, path, db.NewMemory())
+
+	// 3. Verification, the input is separated with htdemucs and the container is removed
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ps -a --filter name=janctionstem1-0 --format {{.Names}}",
+		"run --rm --name janctionstem1-0 -v " + path + ":/data/input -v " + path + ":/data/output janction/audio-stem:latest -n htdemucs --out /data/output --shifts 1 --overlap 0.25 -j 1 /data/input/song.wav",
+		"wait janctionstem1-0",
+		"logs janctionstem1-0",
+		"rm janctionstem1-0",
+	}, dockerCalls(t, calls))
 }
 
 // --- Test for RemoveContainer ---
 func TestRemoveContainerKo(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
-	name := "container123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("failed removing container")
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
-
-	// 5. Assert the error
+	fakeDocker(t, "echo failed removing container >&2\nexit 1")
+
+	// 2. Execute the function under test
+	err := RemoveContainer(ctx, "container123")
+
+	// 3. Assert the error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed removing container")
 }
 
 func TestRemoveContainerOk(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
-	name := "container123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return nil
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
-
-	// 5. Assert no error
+	fakeDocker(t, "exit 0")
+
+	// 2. Execute the function under test
+	err := RemoveContainer(ctx, "container123")
+
+	// 3. Assert no error
 	require.NoError(t, err)
 }
 
 // --- Test for CountFilesInDirectory ---
 func TestCountFilesInDirectoryKo(t *testing.T) {
-	// 1. Setup
-	path := "path123"
+	// 1. Execute the function under test on a directory that doesn't exist
+	count := CountFilesInDirectory(filepath.Join(t.TempDir(), "path123"))
 
-	// 2. Patch Os.ReadDir to simulate failure when reading the directory
-	patch4 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return nil, fmt.Errorf("failed to read directory")
-	})
-	defer patch4.Unpatch()
-
-	// 3. Execute the function under test
-	count := CountFilesInDirectory(path)
-
-	// 4. Assert that the count is 0
-	require.Equal(t, count, 0)
+	// 2. Assert that the count is 0
+	require.Equal(t, 0, count)
 }
 
 func TestCountFilesInDirectoryOk(t *testing.T) {
 	// 1. Setup
-	path := "path123"
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vocals.wav"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "no_vocals.wav"), nil, 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "htdemucs"), 0o755))
 
-	// 2. Patch Os.ReadDir to simulate success when reading the directory
-	patch4 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return nil, nil
-	})
-	defer patch4.Unpatch()
-
-	// 3. Execute the function under test
-	count := CountFilesInDirectory(path)
+	// 2. Execute the function under test
+	count := CountFilesInDirectory(dir)
 
-	// 4. Assert that the count is 0
-	require.Equal(t, count, 0)
+	// 3. Assert that only the files are counted
+	require.Equal(t, 2, count)
 }
 
 // --- Test for FormatFrameFilename ---
@@ -501,7 +217,7 @@ func TestFormatFrameFilename(t *testing.T) {
 	filename := FormatFrameFilename(frame)
 
 	// 3. Assert
-	require.Equal(t, filename, "frame_000042.png")
+	require.Equal(t, "frame_000042.png", filename)
 }
 
 // --- Test for IsARM64 ---
@@ -510,71 +226,31 @@ func TestIsARM64(t *testing.T) {
 	is_arm := isARM64()
 
 	// 2. Assert
-	require.Equal(t, is_arm, runtime.GOARCH == "arm64")
+	require.Equal(t, runtime.GOARCH == "arm64", is_arm)
 }
 
 // --- Test for IsContainerExited ---
 func TestIsContainerExitedKo(t *testing.T) {
 	// 1. Setup
-	id := "thread123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			return fmt.Errorf("Error creating container")
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute the function under test
-	result, err := IsContainerExited(id)
-
-	// 5. Assert
-	require.Equal(t, result, false)
+	fakeDocker(t, "echo Error listing containers >&2\nexit 1")
+
+	// 2. Execute the function under test
+	result, err := IsContainerExited("thread123")
+
+	// 3. Assert
+	require.False(t, result)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Error creating container")
 }
 
 func TestIsContainerExitedOk(t *testing.T) {
 	// 1. Setup
 	threadId := "thread123"
-	containerName := "myBlender" + threadId
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			cmd.Stdout.Write([]byte(containerName))
-			return nil
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute the function under test
+	fakeDocker(t, "echo myBlender"+threadId)
+
+	// 2. Execute the function under test
 	result, err := IsContainerExited(threadId)
 
-	// 5. Assert
-	require.Equal(t, result, true)
+	// 3. Assert
+	require.True(t, result)
 	require.NoError(t, err)
 }
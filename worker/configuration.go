@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	audioStemCrypto "github.com/janction/audioStem/crypto"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/retention"
+)
+
+// DefaultLogRetentionDays is how long the logs of completed threads are kept. A retention
+// of zero days keeps them forever.
+const DefaultLogRetentionDays = 30
+
+// DefaultRetentionGraceHours is how long the files and pins of a completed thread are kept.
+const DefaultRetentionGraceHours = 24
+
+// Bounds of the download of a task input. Zero disables them.
+const (
+	DefaultDownloadTimeoutMinutes = 30
+	DefaultMaxInputSizeMB         = 1024
+)
+
+type VideoConfiguration struct {
+	Enabled                bool   `toml:"enabled"`
+	WorkerName             string `toml:"worker_name"`
+	WorkerAddress          string `toml:"worker_address"`
+	SignerAddress          string `toml:"signer_address"`
+	WorkerKeyLocation      string `toml:"worker_key_location"`
+	MinReward              int64  `toml:"min_reward"`
+	GPUAmount              int64  `toml:"gpu_amount"`
+	WorkerConcurrency      int64  `toml:"worker_concurrency"`
+	LogRetentionDays       int64  `toml:"log_retention_days"`
+	IPFSApi                string `toml:"ipfs_api"`
+	DownloadTimeoutMinutes int64  `toml:"download_timeout_minutes"`
+	MaxInputSizeMB         int64  `toml:"max_input_size_mb"`
+	RetentionGraceHours    int64  `toml:"retention_grace_hours"`
+	DiskQuotaMB            int64  `toml:"disk_quota_mb"`
+	DatabaseDir            string `toml:"database_dir"`
+	ConfigPath             string
+	RootPath               string
+}
+
+func GetAudioStemConfiguration(rootPath string) (*VideoConfiguration, error) {
+	var configPath string = rootPath + "/config/audioStem.toml"
+	conf := VideoConfiguration{
+		Enabled:                false,
+		LogRetentionDays:       DefaultLogRetentionDays,
+		IPFSApi:                ipfs.DefaultAPI,
+		DownloadTimeoutMinutes: DefaultDownloadTimeoutMinutes,
+		MaxInputSizeMB:         DefaultMaxInputSizeMB,
+		RetentionGraceHours:    DefaultRetentionGraceHours,
+		RootPath:               rootPath,
+		ConfigPath:             configPath,
+	}
+
+	// we make sure the root path exists. It might yet not be initialized
+	_, err := os.Stat(rootPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &conf, nil
+	}
+
+	// Load the YAML file
+	file, err := os.Open(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			conf.SaveConf()
+			return &conf, nil
+		}
+
+		log.Fatalf("Unable to open AudioStem configuration file. %v", err.Error())
+		return nil, err
+	}
+	defer file.Close()
+
+	decoder := toml.NewDecoder(file)
+	if _, err := decoder.Decode(&conf); err != nil {
+		log.Fatalf("Failed to decode YAML: %v\n", err.Error())
+		return nil, err
+	}
+
+	return &conf, nil
+}
+
+// DownloadOptions returns the bounds of the download of task inputs.
+func (c *VideoConfiguration) DownloadOptions() ipfs.DownloadOptions {
+	opts := ipfs.DownloadOptions{}
+	if c.DownloadTimeoutMinutes > 0 {
+		opts.Timeout = time.Duration(c.DownloadTimeoutMinutes) * time.Minute
+	}
+	if c.MaxInputSizeMB > 0 {
+		opts.MaxSize = uint64(c.MaxInputSizeMB) << 20
+	}
+	return opts
+}
+
+// KeyringPassphraseEnv is the environment variable with the passphrase of the file keyring
+// of the worker key.
+const KeyringPassphraseEnv = "AUDIOSTEM_KEYRING_PASSPHRASE"
+
+// KeyLocation returns the keyring of the worker key. WorkerKeyLocation is backend[:dir],
+// and defaults to the test keyring of the node.
+func (c *VideoConfiguration) KeyLocation() (audioStemCrypto.KeyLocation, error) {
+	location, err := audioStemCrypto.ParseKeyLocation(c.WorkerKeyLocation, c.RootPath)
+	if err != nil {
+		return location, err
+	}
+	location.Passphrase = os.Getenv(KeyringPassphraseEnv)
+	return location, nil
+}
+
+// DatabasePath returns the directory of the worker database, which defaults to the worker
+// directory of the node home so the node itself never opens it.
+func (c *VideoConfiguration) DatabasePath() string {
+	if c.DatabaseDir != "" {
+		return c.DatabaseDir
+	}
+	return filepath.Join(c.RootPath, "worker")
+}
+
+// RetentionConfig returns how long the storage of completed threads is kept.
+func (c *VideoConfiguration) RetentionConfig() retention.Config {
+	conf := retention.Config{RootPath: c.RootPath}
+	if c.RetentionGraceHours > 0 {
+		conf.GracePeriod = time.Duration(c.RetentionGraceHours) * time.Hour
+	}
+	if c.DiskQuotaMB > 0 {
+		conf.Quota = c.DiskQuotaMB << 20
+	}
+	return conf
+}
+
+func (c *VideoConfiguration) SaveConf() error {
+	// we make sure the root path exists. It might not be initialized
+	_, err := os.Stat(c.ConfigPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	// Marshal the struct into YAML format
+	data, err := toml.Marshal(&c)
+	if err != nil {
+		log.Fatalf("Error marshaling to YAML: %v\n", err)
+		return err
+	}
+
+	// Save the YAML data to a file
+	file, err := os.Create(c.ConfigPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,438 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/audioStemLogger"
+	"github.com/janction/audioStem/db"
+)
+
+// HeightFunc returns the height of the latest block of the chain.
+type HeightFunc func(ctx context.Context) (int64, error)
+
+const (
+	defaultFollowInterval = time.Second
+
+	// logPruneInterval is the amount of blocks between two prunes of the local logs.
+	logPruneInterval = 1000
+
+	// storageCollectInterval is the amount of blocks between two collections of the storage
+	// of completed threads.
+	storageCollectInterval = 100
+)
+
+// Daemon is the off-chain worker of a node. It follows the chain, enqueues the jobs each
+// new block requires and runs them with its dispatcher, which submits their results as
+// transactions. The chain never waits for it, so nodes that aren't workers don't run it.
+type Daemon struct {
+	cdc        codec.Codec
+	conf       VideoConfiguration
+	db         db.Database
+	query      audioStem.QueryClient
+	height     HeightFunc
+	dispatcher *Dispatcher
+	interval   time.Duration
+
+	lastHeight  int64
+	lastPrune   int64
+	lastCollect int64
+
+	// threads this worker is on, by task and thread id. They are followed until the chain
+	// releases the worker, so its local state can be completed or reset.
+	threads map[[2]string]bool
+}
+
+// NewDaemon creates the worker daemon of the configured worker. The chain is read through
+// the query client, while the progress of the worker is kept in the given database.
+func NewDaemon(cdc codec.Codec, conf VideoConfiguration, database db.Database, queryClient audioStem.QueryClient, height HeightFunc) *Daemon {
+	// with a signer, worker_address is the operator and worker_name the key of the signer
+	audioStem.SetWorkerSigner(conf.WorkerAddress, conf.SignerAddress)
+
+	d := &Daemon{
+		cdc:        cdc,
+		conf:       conf,
+		db:         database,
+		query:      queryClient,
+		height:     height,
+		dispatcher: NewDispatcher(database, int(conf.WorkerConcurrency)),
+		interval:   defaultFollowInterval,
+		threads:    make(map[[2]string]bool),
+	}
+	handleJobs(d.dispatcher, cdc, conf, database, queryClient)
+	d.renameLegacyThreads()
+	d.restoreThreads()
+	return d
+}
+
+// renameLegacyThreads moves the local state of the threads the worker is on to the ids that
+// separate their index from the task id, which the chain gave them when it upgraded.
+func (d *Daemon) renameLegacyThreads() {
+	tasks, err := d.db.ReadSubscribedTasks()
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to read the threads of the worker: %s", err.Error())
+		return
+	}
+	for _, task := range tasks {
+		if audioStem.ValidateThreadId(task.TaskId, task.ThreadId) == nil {
+			continue
+		}
+		legacyIndex, found := strings.CutPrefix(task.ThreadId, task.TaskId)
+		if !found {
+			continue
+		}
+		index, err := strconv.ParseUint(legacyIndex, 10, 32)
+		if err != nil {
+			continue
+		}
+		threadId := audioStem.FormatThreadId(task.TaskId, uint32(index))
+		if err := d.db.RenameThread(task.TaskId, task.ThreadId, threadId); err != nil {
+			audioStemLogger.Logger.Error("unable to rename local thread %s: %s", task.ThreadId, err.Error())
+			continue
+		}
+		workDir := filepath.Join(d.conf.RootPath, "audioStems")
+		if err := os.Rename(filepath.Join(workDir, task.ThreadId), filepath.Join(workDir, threadId)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			audioStemLogger.Logger.Error("unable to move the files of thread %s: %s", task.ThreadId, err.Error())
+		}
+		audioStemLogger.Logger.Info("local thread %s renamed to %s", task.ThreadId, threadId)
+	}
+}
+
+// restoreThreads follows again the threads the worker subscribed to before it was
+// restarted, so the ones the chain released it from meanwhile are still dropped.
+func (d *Daemon) restoreThreads() {
+	tasks, err := d.db.ReadSubscribedTasks()
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to read the threads of the worker: %s", err.Error())
+		return
+	}
+	for _, task := range tasks {
+		d.threads[[2]string{task.TaskId, task.ThreadId}] = true
+	}
+}
+
+// Run starts the dispatcher and processes every new block until the context is done.
+// Blocks the daemon missed are not replayed, since each step looks at the latest state.
+func (d *Daemon) Run(ctx context.Context) error {
+	d.dispatcher.Start(ctx)
+	audioStemLogger.Logger.Info("worker %s started", d.conf.WorkerAddress)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		height, err := d.height(ctx)
+		if err != nil {
+			audioStemLogger.Logger.Error("unable to read the height of the chain: %s", err.Error())
+		} else if height > d.lastHeight {
+			if err := d.step(ctx, height); err != nil {
+				audioStemLogger.Logger.Error("unable to process block %v: %s", height, err.Error())
+			} else {
+				d.lastHeight = height
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			d.dispatcher.Wait()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// step enqueues the work the state of the chain at the given height requires.
+func (d *Daemon) step(ctx context.Context, height int64) error {
+	params, err := d.query.GetParams(ctx, &audioStem.QueryGetParamsRequest{})
+	if err != nil {
+		return err
+	}
+	res, err := d.query.GetWorker(ctx, &audioStem.QueryGetWorkerRequest{Worker: d.conf.WorkerAddress})
+	if err != nil {
+		return err
+	}
+	pending, err := d.pendingTasks(ctx)
+	if err != nil {
+		return err
+	}
+
+	worker := res.Worker
+	switch {
+	case worker == nil:
+		d.register(params.Params)
+	case worker.Enabled && worker.CurrentTaskId == "":
+		d.subscribe(params.Params, pending)
+	case worker.Enabled:
+		d.work(*worker, pending)
+	default:
+		audioStemLogger.Logger.Debug("Worker %s is disabled, its stake has to be topped up with add-worker", d.conf.WorkerAddress)
+	}
+
+	for _, task := range pending {
+		for _, thread := range task.Threads {
+			d.follow(*thread, height)
+		}
+	}
+	d.release(pending)
+
+	if d.conf.LogRetentionDays > 0 && height-d.lastPrune >= logPruneInterval {
+		d.lastPrune = height
+		d.enqueue(db.JobPruneLogs, "logs", nil)
+	}
+	if height-d.lastCollect >= storageCollectInterval {
+		d.lastCollect = height
+		d.enqueue(db.JobCollectStorage, "storage", nil)
+	}
+
+	// we now will connect to the IPFS nodes of new workers
+	return d.connectWorkers(ctx)
+}
+
+// register registers the worker with the minimum stake, unless it was already sent.
+func (d *Daemon) register(params audioStem.Params) {
+	isRegistered, _ := d.db.IsWorkerRegistered(d.conf.WorkerAddress)
+	if isRegistered || params.MinWorkerStaking == nil {
+		return
+	}
+	audioStemLogger.Logger.Info("Registering Worker %s", d.conf.WorkerAddress)
+	d.enqueue(db.JobRegisterWorker, d.conf.WorkerAddress, []byte(params.MinWorkerStaking.String()))
+}
+
+// subscribe subscribes the idle worker to the first open thread with enough reward.
+func (d *Daemon) subscribe(params audioStem.Params, tasks []*audioStem.AudioStemTask) {
+	address := d.conf.WorkerAddress
+	minReward := math.NewInt(d.conf.MinReward)
+	for _, task := range tasks {
+		// we only search for in progress and with the reward this node will accept
+		if task.Completed || task.Reward == nil || task.Reward.Amount.LT(minReward) {
+			continue
+		}
+		for _, thread := range task.Threads {
+			if thread.Completed || len(thread.Workers) >= int(params.MaxWorkersPerThread) || slices.Contains(thread.Workers, address) || slices.Contains(thread.RejectedWorkers, address) {
+				continue
+			}
+			//we found our next thread
+			dbTask, err := d.db.ReadTask(task.TaskId, thread.ThreadId)
+			if err != nil || dbTask.WorkerSubscribed {
+				continue
+			}
+			audioStemLogger.Logger.Info(" registering worker %v in task %s thread %s ", address, task.TaskId, thread.ThreadId)
+			d.db.UpdateTask(task.TaskId, thread.ThreadId, true)
+			d.enqueueThread(db.JobSubscribeWorker, *thread)
+			return
+		}
+	}
+	audioStemLogger.Logger.Info("No audio Stem tasks available for me to work on")
+}
+
+// work moves the thread the worker is assigned to forward: it stems the input, then
+// proposes a solution or verifies the one someone else proposed.
+func (d *Daemon) work(worker audioStem.Worker, tasks []*audioStem.AudioStemTask) {
+	i := slices.IndexFunc(tasks, func(task *audioStem.AudioStemTask) bool { return task.TaskId == worker.CurrentTaskId })
+	if i < 0 || int(worker.CurrentThreadIndex) >= len(tasks[i].Threads) {
+		audioStemLogger.Logger.Error("thread %v of task %v is not in progress", worker.CurrentThreadIndex, worker.CurrentTaskId)
+		return
+	}
+	task := tasks[i]
+	thread := *task.Threads[worker.CurrentThreadIndex]
+	dbThread, err := d.db.ReadThread(thread.ThreadId)
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to read local thread %s: %s", thread.ThreadId, err.Error())
+		return
+	}
+	audioStemLogger.Logger.Info("local thread %s is %s", dbThread.ID, dbThread.State)
+
+	switch dbThread.State {
+	case db.ThreadIdle, db.ThreadDownloading, db.ThreadDownloaded, db.ThreadStemming:
+		// StartWork picks up from where we are: it resumes downloads interrupted by a restart
+		// and restarts stemming if the container is gone
+		if !thread.Completed {
+			audioStemLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
+			d.enqueueThread(db.JobStartWork, thread)
+		}
+	}
+
+	// we completed the work, so lets propose a solution
+	if thread.Solution == nil && dbThread.State == db.ThreadStemmed {
+		audioStemLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
+		d.enqueueThread(db.JobProposeSolution, thread)
+	}
+
+	// someone else already submited solution, lets submit our verification
+	if thread.Solution != nil && thread.Solution.ProposedBy != "" && thread.Solution.ProposedBy != d.conf.WorkerAddress && (dbThread.State == db.ThreadStemmed || dbThread.State == db.ThreadProposed) {
+		audioStemLogger.Logger.Info("Started verification for thread %s", thread.ThreadId)
+		d.enqueueThread(db.JobSubmitVerification, thread)
+	}
+}
+
+// follow reveals what the worker committed to on the thread and, once its solution is
+// accepted, submits it.
+func (d *Daemon) follow(thread audioStem.AudioStemThread, height int64) {
+	address := d.conf.WorkerAddress
+	if thread.Completed || thread.Solution == nil {
+		return
+	}
+
+	// if we are the node that needs to submit the solution of an accepted thread
+	// then we so it here
+	if thread.Solution.Accepted && thread.Solution.Dir == "" && thread.Solution.ProposedBy == address {
+		localThread, _ := d.db.ReadThread(thread.ThreadId)
+		if localThread.State == db.ThreadRevealed {
+			d.enqueueThread(db.JobSubmitSolution, thread)
+		}
+	}
+
+	// once validations are closed, we have until the deadline to reveal what we committed to
+	if thread.RevealDeadline == 0 || height > thread.RevealDeadline {
+		return
+	}
+	if thread.Solution.ProposedBy == address && thread.Solution.Salt == "" {
+		localThread, _ := d.db.ReadThread(thread.ThreadId)
+		if localThread.State == db.ThreadVerified || localThread.State == db.ThreadProposed {
+			audioStemLogger.Logger.Info("Revealing solution of thread %s", thread.ThreadId)
+			d.enqueueThread(db.JobRevealSolution, thread)
+		}
+	}
+	for _, validation := range thread.Validations {
+		if validation.Validator == address && validation.Salt == "" {
+			audioStemLogger.Logger.Info("Revealing validation of thread %s", thread.ThreadId)
+			d.enqueueThread(db.JobRevealValidation, thread)
+		}
+	}
+}
+
+// release drops the local state of the threads the chain released the worker from. Threads
+// completed on chain, alone or with their task, are completed, so their storage can be
+// collected. When a solution is rejected, the proposer can't work on the thread again, so its
+// thread is completed, while the other workers start from scratch if they subscribe again.
+// Threads the worker left are already completed, and it doesn't subscribe to them again.
+func (d *Daemon) release(tasks []*audioStem.AudioStemTask) {
+	address := d.conf.WorkerAddress
+	open := make(map[[2]string]*audioStem.AudioStemThread)
+	for _, task := range tasks {
+		for _, thread := range task.Threads {
+			open[[2]string{task.TaskId, thread.ThreadId}] = thread
+		}
+	}
+
+	for key := range d.threads {
+		thread, ok := open[key]
+		switch {
+		case !ok || thread.Completed:
+			d.completeLocalThread(key[1])
+		case slices.Contains(thread.Workers, address):
+			continue
+		case slices.Contains(thread.RejectedWorkers, address):
+			d.completeLocalThread(key[1])
+		case d.isLocalThreadCompleted(key[1]):
+			// the worker left the thread, so its subscription is kept
+		default:
+			if err := d.db.DeleteThread(key[1]); err != nil {
+				audioStemLogger.Logger.Error("unable to reset local thread %s: %s", key[1], err.Error())
+			}
+			d.db.UpdateTask(key[0], key[1], false)
+		}
+		delete(d.threads, key)
+	}
+
+	for key, thread := range open {
+		if !thread.Completed && slices.Contains(thread.Workers, address) {
+			d.threads[key] = true
+		}
+	}
+}
+
+// isLocalThreadCompleted returns true if the worker is done with the local thread.
+func (d *Daemon) isLocalThreadCompleted(threadId string) bool {
+	localThread, err := d.db.ReadThread(threadId)
+	return err == nil && localThread.State == db.ThreadCompleted
+}
+
+// completeLocalThread marks the local thread as completed.
+func (d *Daemon) completeLocalThread(threadId string) {
+	localThread, err := d.db.ReadThread(threadId)
+	if err != nil || localThread.State == db.ThreadCompleted {
+		return
+	}
+	if err := d.db.TransitionThread(threadId, localThread.State, db.ThreadCompleted); err != nil {
+		audioStemLogger.Logger.Error("unable to complete local thread %s: %s", threadId, err.Error())
+	}
+}
+
+// connectWorkers connects to the IPFS nodes of the workers it didn't connect to yet.
+func (d *Daemon) connectWorkers(ctx context.Context) error {
+	var key []byte
+	for {
+		res, err := d.query.GetWorkers(ctx, &audioStem.QueryGetWorkersRequest{Pagination: &query.PageRequest{Key: key}})
+		if err != nil {
+			return err
+		}
+		for _, worker := range res.Workers {
+			isAdded, _ := d.db.IsIPFSWorkerAdded(worker.Address)
+			if worker.IpfsId == "" || worker.PublicIp == "" || isAdded {
+				continue
+			}
+			audioStemLogger.Logger.Info("Connecting to IPFS node %s at %s", worker.IpfsId, worker.PublicIp)
+			payload, err := d.cdc.Marshal(&worker)
+			if err != nil {
+				return err
+			}
+			d.enqueue(db.JobConnectIPFS, worker.Address, payload)
+			d.db.AddIPFSWorker(worker.Address)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// pendingTasks reads the open tasks of the chain, page by page.
+func (d *Daemon) pendingTasks(ctx context.Context) ([]*audioStem.AudioStemTask, error) {
+	var tasks []*audioStem.AudioStemTask
+	var key []byte
+	for {
+		res, err := d.query.GetPendingAudioStemTasks(ctx, &audioStem.QueryGetPendingAudioStemTaskRequest{Pagination: &query.PageRequest{Key: key}})
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, res.AudioStemTasks...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return tasks, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// enqueue persists a job for the dispatcher, so the same work is never launched twice.
+func (d *Daemon) enqueue(kind db.JobKind, key string, payload []byte) {
+	queued, err := d.db.EnqueueJob(kind, key, payload, db.DefaultJobMaxAttempts)
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to enqueue job %s for %s: %s", kind, key, err.Error())
+		return
+	}
+	if queued {
+		audioStemLogger.Logger.Debug("job %s enqueued for %s", kind, key)
+		d.dispatcher.Notify()
+	}
+}
+
+func (d *Daemon) enqueueThread(kind db.JobKind, thread audioStem.AudioStemThread) {
+	payload, err := d.cdc.Marshal(&thread)
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to encode thread %s for job %s: %s", thread.ThreadId, kind, err.Error())
+		return
+	}
+	d.enqueue(kind, thread.ThreadId, payload)
+}
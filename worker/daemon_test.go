@@ -0,0 +1,316 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/retention"
+)
+
+const testWorker = "cosmos1rhpm69anjmyre6yh0a7dd2luk4nfykc7wa8e5d"
+
+// fakeChain serves the queries of the daemon from the state set by the test.
+type fakeChain struct {
+	audioStem.QueryClient
+	params  audioStem.Params
+	workers []audioStem.Worker
+	tasks   []*audioStem.AudioStemTask
+}
+
+func (c *fakeChain) GetParams(ctx context.Context, in *audioStem.QueryGetParamsRequest, opts ...grpc.CallOption) (*audioStem.QueryGetParamsResponse, error) {
+	return &audioStem.QueryGetParamsResponse{Params: c.params}, nil
+}
+
+func (c *fakeChain) GetWorker(ctx context.Context, in *audioStem.QueryGetWorkerRequest, opts ...grpc.CallOption) (*audioStem.QueryGetWorkerResponse, error) {
+	for _, worker := range c.workers {
+		if worker.Address == in.Worker {
+			return &audioStem.QueryGetWorkerResponse{Worker: &worker}, nil
+		}
+	}
+	return &audioStem.QueryGetWorkerResponse{}, nil
+}
+
+func (c *fakeChain) GetWorkers(ctx context.Context, in *audioStem.QueryGetWorkersRequest, opts ...grpc.CallOption) (*audioStem.QueryGetWorkersResponse, error) {
+	return &audioStem.QueryGetWorkersResponse{Workers: c.workers}, nil
+}
+
+// GetPendingAudioStemTasks serves a task per page, so the daemon has to follow the pages.
+func (c *fakeChain) GetPendingAudioStemTasks(ctx context.Context, in *audioStem.QueryGetPendingAudioStemTaskRequest, opts ...grpc.CallOption) (*audioStem.QueryGetPendingAudioStemTaskResponse, error) {
+	var pending []*audioStem.AudioStemTask
+	for _, task := range c.tasks {
+		if !task.Completed {
+			pending = append(pending, task)
+		}
+	}
+	page := 0
+	if key := in.Pagination.GetKey(); len(key) > 0 {
+		page = int(key[0])
+	}
+	res := &audioStem.QueryGetPendingAudioStemTaskResponse{Pagination: &query.PageResponse{}}
+	if page < len(pending) {
+		res.AudioStemTasks = pending[page : page+1]
+	}
+	if page+1 < len(pending) {
+		res.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+	return res, nil
+}
+
+func newTestDaemon(t *testing.T) (*Daemon, *fakeChain, db.Database) {
+	chain := &fakeChain{params: audioStem.DefaultParams()}
+	database := db.NewMemory()
+	conf := VideoConfiguration{Enabled: true, WorkerAddress: testWorker}
+	d := NewDaemon(moduletestutil.MakeTestEncodingConfig().Codec, conf, database, chain, nil)
+	return d, chain, database
+}
+
+func newTestTask(taskId string, workers ...string) *audioStem.AudioStemTask {
+	reward := sdk.NewCoin("jct", math.NewInt(10))
+	return &audioStem.AudioStemTask{
+		TaskId:  taskId,
+		Reward:  &reward,
+		Threads: []*audioStem.AudioStemThread{{TaskId: taskId, ThreadId: audioStem.FormatThreadId(taskId, 0), Workers: workers}},
+	}
+}
+
+// queued returns the kind of the jobs the daemon enqueued, by their key.
+func queued(t *testing.T, database db.Database) map[string][]db.JobKind {
+	jobs, err := database.ClaimJobs(time.Now().Unix(), 100)
+	require.NoError(t, err)
+	kinds := make(map[string][]db.JobKind)
+	for _, job := range jobs {
+		kinds[job.Key] = append(kinds[job.Key], job.Kind)
+	}
+	return kinds
+}
+
+func TestDaemon_RegistersAndSubscribes(t *testing.T) {
+	d, chain, database := newTestDaemon(t)
+	ctx := context.Background()
+
+	require.NoError(t, d.step(ctx, 1))
+	require.Contains(t, queued(t, database)[testWorker], db.JobRegisterWorker)
+
+	// once registered, the idle worker subscribes to the first thread it isn't rejected from
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true}}
+	rejected := newTestTask("1")
+	rejected.Threads[0].RejectedWorkers = []string{testWorker}
+	chain.tasks = []*audioStem.AudioStemTask{rejected, newTestTask("2")}
+	require.NoError(t, d.step(ctx, 2))
+	jobs := queued(t, database)
+	require.Empty(t, jobs["1-0"])
+	require.Equal(t, []db.JobKind{db.JobSubscribeWorker}, jobs["2-0"])
+
+	// the subscription was already sent, so it isn't sent again
+	require.NoError(t, d.step(ctx, 3))
+	require.Empty(t, queued(t, database)["2-0"])
+}
+
+func TestDaemon_Reveals(t *testing.T) {
+	d, chain, database := newTestDaemon(t)
+	ctx := context.Background()
+
+	task := newTestTask("1", testWorker)
+	thread := task.Threads[0]
+	thread.Solution = &audioStem.AudioStemThread_Solution{ProposedBy: testWorker}
+	thread.RevealDeadline = 10
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true, CurrentTaskId: "1"}}
+	chain.tasks = []*audioStem.AudioStemTask{task}
+	require.NoError(t, database.AddThread("1-0"))
+	for _, state := range []db.ThreadState{db.ThreadDownloading, db.ThreadDownloaded, db.ThreadStemming, db.ThreadStemmed, db.ThreadProposing, db.ThreadProposed} {
+		local, err := database.ReadThread("1-0")
+		require.NoError(t, err)
+		require.NoError(t, database.TransitionThread("1-0", local.State, state))
+	}
+
+	// past the deadline, nothing can be revealed
+	require.NoError(t, d.step(ctx, 11))
+	jobs := queued(t, database)["1-0"]
+	require.NotContains(t, jobs, db.JobRevealSolution)
+	// the proposer doesn't validate its own solution
+	require.NotContains(t, jobs, db.JobSubmitVerification)
+
+	require.NoError(t, d.step(ctx, 9))
+	require.Contains(t, queued(t, database)["1-0"], db.JobRevealSolution)
+}
+
+func TestDaemon_ReleasesThreads(t *testing.T) {
+	d, chain, database := newTestDaemon(t)
+	ctx := context.Background()
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true}}
+
+	proposed, validated, completed := newTestTask("1", testWorker), newTestTask("2", testWorker), newTestTask("3", testWorker)
+	chain.tasks = []*audioStem.AudioStemTask{proposed, validated, completed}
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		require.NoError(t, database.AddThread(id))
+		require.NoError(t, database.TransitionThread(id, db.ThreadIdle, db.ThreadDownloading))
+	}
+	require.NoError(t, d.step(ctx, 1))
+	require.Len(t, d.threads, 3)
+
+	// the solution of the first two threads was rejected, and the last task is completed
+	proposed.Threads[0].Workers = nil
+	proposed.Threads[0].RejectedWorkers = []string{testWorker}
+	validated.Threads[0].Workers = nil
+	completed.Completed = true
+	require.NoError(t, d.step(ctx, 2))
+	require.Empty(t, d.threads)
+
+	local, err := database.ReadThread("1-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadCompleted, local.State)
+	local, err = database.ReadThread("2-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadIdle, local.State)
+	local, err = database.ReadThread("3-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadCompleted, local.State)
+}
+
+func TestDaemon_DoesntRejoinLeftThreads(t *testing.T) {
+	d, chain, database := newTestDaemon(t)
+	ctx := context.Background()
+
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true}}
+	task := newTestTask("1")
+	chain.tasks = []*audioStem.AudioStemTask{task}
+	require.NoError(t, d.step(ctx, 1))
+	require.Equal(t, []db.JobKind{db.JobSubscribeWorker}, queued(t, database)["1-0"])
+
+	// the worker subscribed, then left the thread because its input was too large
+	task.Threads[0].Workers = []string{testWorker}
+	chain.workers[0].CurrentTaskId = "1"
+	require.NoError(t, d.step(ctx, 2))
+	require.NoError(t, database.TransitionThread("1-0", db.ThreadIdle, db.ThreadDownloading))
+	require.NoError(t, database.TransitionThread("1-0", db.ThreadDownloading, db.ThreadCompleted))
+	queued(t, database) // claims the jobs of the subscription
+	task.Threads[0].Workers = nil
+	chain.workers[0].CurrentTaskId = ""
+	require.NoError(t, d.step(ctx, 3))
+	require.Empty(t, d.threads)
+
+	// the idle worker doesn't subscribe to it again
+	require.NoError(t, d.step(ctx, 4))
+	require.Empty(t, queued(t, database)["1-0"])
+	local, err := database.ReadThread("1-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadCompleted, local.State)
+}
+
+func TestDaemon_CollectsCompletedThreads(t *testing.T) {
+	node := ipfs.NewFake()
+	previous := ipfs.DefaultClient()
+	ipfs.SetClient(node)
+	t.Cleanup(func() { ipfs.SetClient(previous) })
+
+	chain := &fakeChain{params: audioStem.DefaultParams()}
+	database := db.NewMemory()
+	conf := VideoConfiguration{Enabled: true, WorkerAddress: testWorker, RootPath: t.TempDir()}
+	d := NewDaemon(moduletestutil.MakeTestEncodingConfig().Codec, conf, database, chain, nil)
+	ctx := context.Background()
+
+	// the worker submitted the solution of the first thread, the other one is still open
+	task := newTestTask("1", testWorker)
+	task.Threads = append(task.Threads, &audioStem.AudioStemThread{TaskId: "1", ThreadId: "1-1", Index: 1})
+	task.Threads[0].Solution = &audioStem.AudioStemThread_Solution{ProposedBy: testWorker, Accepted: true}
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true, CurrentTaskId: "1"}}
+	chain.tasks = []*audioStem.AudioStemTask{task}
+	require.NoError(t, database.AddThread("1-0"))
+	for _, state := range []db.ThreadState{db.ThreadDownloading, db.ThreadDownloaded, db.ThreadStemming, db.ThreadStemmed, db.ThreadProposing, db.ThreadProposed, db.ThreadRevealing, db.ThreadRevealed, db.ThreadSubmitting, db.ThreadSubmitted} {
+		local, err := database.ReadThread("1-0")
+		require.NoError(t, err)
+		require.NoError(t, database.TransitionThread("1-0", local.State, state))
+	}
+	workDir := filepath.Join(conf.RootPath, "audioStems", "1-0")
+	require.NoError(t, os.MkdirAll(workDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "vocals.wav"), []byte("stem"), 0o644))
+	cid, err := node.AddDir(ctx, workDir)
+	require.NoError(t, err)
+	require.NoError(t, database.AddPin("1-0", cid))
+	require.NoError(t, d.step(ctx, 1))
+
+	// the chain completes the thread once the solution is submitted, while its task goes on
+	task.Threads[0].Completed = true
+	task.Threads[0].Solution.Dir = cid
+	chain.workers[0].CurrentTaskId = ""
+	require.NoError(t, d.step(ctx, 2))
+	require.Empty(t, d.threads)
+	local, err := database.ReadThread("1-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadCompleted, local.State)
+
+	report, err := retention.NewManager(database, retention.Config{RootPath: conf.RootPath}).Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1-0"}, report.Collected)
+	require.NoDirExists(t, workDir)
+	require.False(t, node.Pinned(cid))
+}
+
+func TestDaemon_RestoresThreads(t *testing.T) {
+	chain := &fakeChain{params: audioStem.DefaultParams()}
+	database := db.NewMemory()
+	ctx := context.Background()
+	chain.workers = []audioStem.Worker{{Address: testWorker, Enabled: true}}
+
+	// the worker subscribed to a thread before it was restarted
+	require.NoError(t, database.AddTask("1", "1-0"))
+	require.NoError(t, database.UpdateTask("1", "1-0", true))
+	require.NoError(t, database.AddThread("1-0"))
+	require.NoError(t, database.TransitionThread("1-0", db.ThreadIdle, db.ThreadDownloading))
+
+	conf := VideoConfiguration{Enabled: true, WorkerAddress: testWorker}
+	d := NewDaemon(moduletestutil.MakeTestEncodingConfig().Codec, conf, database, chain, nil)
+	require.Equal(t, map[[2]string]bool{{"1", "1-0"}: true}, d.threads)
+
+	// meanwhile the chain released the worker, so its local thread is reset
+	chain.tasks = []*audioStem.AudioStemTask{newTestTask("1")}
+	require.NoError(t, d.step(ctx, 1))
+	require.Empty(t, d.threads)
+
+	local, err := database.ReadThread("1-0")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadIdle, local.State)
+	task, err := database.ReadTask("1", "1-0")
+	require.NoError(t, err)
+	require.False(t, task.WorkerSubscribed)
+}
+
+func TestDaemon_RenamesLegacyThreads(t *testing.T) {
+	chain := &fakeChain{params: audioStem.DefaultParams()}
+	database := db.NewMemory()
+	root := t.TempDir()
+
+	// before the upgrade, the thread at index 10 of task 1 was known as 110
+	require.NoError(t, database.AddTask("1", "110"))
+	require.NoError(t, database.UpdateTask("1", "110", true))
+	require.NoError(t, database.AddThread("110"))
+	require.NoError(t, database.TransitionThread("110", db.ThreadIdle, db.ThreadDownloading))
+	require.NoError(t, database.SetSalt("110", "salt"))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "audioStems", "110"), 0o755))
+
+	conf := VideoConfiguration{Enabled: true, WorkerAddress: testWorker, RootPath: root}
+	d := NewDaemon(moduletestutil.MakeTestEncodingConfig().Codec, conf, database, chain, nil)
+	require.Equal(t, map[[2]string]bool{{"1", "1-10"}: true}, d.threads)
+
+	local, err := database.ReadThread("1-10")
+	require.NoError(t, err)
+	require.Equal(t, db.ThreadDownloading, local.State)
+	salt, err := database.ReadSalt("1-10")
+	require.NoError(t, err)
+	require.Equal(t, "salt", salt)
+	require.DirExists(t, filepath.Join(root, "audioStems", "1-10"))
+	require.NoDirExists(t, filepath.Join(root, "audioStems", "110"))
+}
@@ -0,0 +1,178 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/janction/audioStem/audioStemLogger"
+	"github.com/janction/audioStem/db"
+)
+
+// Handler executes a single job. A returned error schedules the job for a retry.
+type Handler func(ctx context.Context, job db.Job) error
+
+// Queue is the persistence the dispatcher needs from the worker database.
+type Queue interface {
+	ClaimJobs(now int64, limit int) ([]db.Job, error)
+	CompleteJob(id int64) error
+	FailJob(id int64, jobErr error, retryAt int64) error
+	ResetRunningJobs() error
+}
+
+const (
+	DefaultConcurrency  = 2
+	defaultPollInterval = time.Second
+	baseRetryDelay      = 5 * time.Second
+	maxRetryDelay       = 5 * time.Minute
+)
+
+// Dispatcher runs queued jobs from a single polling goroutine, executing at most
+// concurrency of them at the same time.
+type Dispatcher struct {
+	queue        Queue
+	handlers     map[db.JobKind]Handler
+	concurrency  int
+	pollInterval time.Duration
+
+	wake  chan struct{}
+	slots chan struct{}
+	wg    sync.WaitGroup
+	once  sync.Once
+}
+
+// NewDispatcher creates a dispatcher over the given queue.
+func NewDispatcher(queue Queue, concurrency int) *Dispatcher {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	return &Dispatcher{
+		queue:        queue,
+		handlers:     make(map[db.JobKind]Handler),
+		concurrency:  concurrency,
+		pollInterval: defaultPollInterval,
+		wake:         make(chan struct{}, 1),
+		slots:        make(chan struct{}, concurrency),
+	}
+}
+
+// Handle registers the handler for a job kind. It must be called before Start.
+func (d *Dispatcher) Handle(kind db.JobKind, handler Handler) {
+	d.handlers[kind] = handler
+}
+
+// Start launches the dispatcher loop. Jobs left running by a previous process are
+// queued again. Calling Start more than once has no effect.
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.once.Do(func() {
+		if err := d.queue.ResetRunningJobs(); err != nil {
+			audioStemLogger.Logger.Error("unable to reset running jobs: %s", err.Error())
+		}
+		go d.loop(ctx)
+	})
+}
+
+// Notify wakes up the dispatcher so newly enqueued jobs don't wait for the next poll.
+func (d *Dispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Wait blocks until every job started by the dispatcher has returned.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) loop(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// dispatch claims as many due jobs as there are free slots and runs them.
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	free := d.concurrency - len(d.slots)
+	if free <= 0 {
+		return
+	}
+
+	jobs, err := d.queue.ClaimJobs(time.Now().Unix(), free)
+	if err != nil {
+		audioStemLogger.Logger.Error("unable to claim jobs: %s", err.Error())
+		return
+	}
+
+	for _, job := range jobs {
+		d.slots <- struct{}{}
+		d.wg.Add(1)
+		go func(job db.Job) {
+			defer func() {
+				<-d.slots
+				d.wg.Done()
+				d.Notify()
+			}()
+			d.run(ctx, job)
+		}(job)
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context, job db.Job) {
+	handler, ok := d.handlers[job.Kind]
+	if !ok {
+		err := fmt.Errorf("no handler registered for job kind %s", job.Kind)
+		audioStemLogger.Logger.Error(err.Error())
+		d.queue.FailJob(job.ID, err, time.Now().Unix())
+		return
+	}
+
+	audioStemLogger.Logger.Debug("running job %v (%s) for %s, attempt %v of %v", job.ID, job.Kind, job.Key, job.Attempts, job.MaxAttempts)
+	err := runHandler(ctx, handler, job)
+	if err != nil {
+		retryAt := time.Now().Add(retryDelay(job.Attempts)).Unix()
+		audioStemLogger.Logger.Error("job %v (%s) for %s failed on attempt %v: %s", job.ID, job.Kind, job.Key, job.Attempts, err.Error())
+		if err := d.queue.FailJob(job.ID, err, retryAt); err != nil {
+			audioStemLogger.Logger.Error("unable to record failure of job %v: %s", job.ID, err.Error())
+		}
+		return
+	}
+
+	if err := d.queue.CompleteJob(job.ID); err != nil {
+		audioStemLogger.Logger.Error("unable to complete job %v: %s", job.ID, err.Error())
+	}
+}
+
+// runHandler executes the handler turning a panic into a job error, so a single
+// broken job can't take the node down.
+func runHandler(ctx context.Context, handler Handler, job db.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// retryDelay returns an exponential backoff for the given attempt number.
+func retryDelay(attempt int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/janction/audioStem/db"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(t *testing.T) db.Database {
+	return db.NewMemory()
+}
+
+func TestDispatcher_RunsJobs(t *testing.T) {
+	queue := newTestQueue(t)
+	d := NewDispatcher(queue, 2)
+
+	var mu sync.Mutex
+	var keys []string
+	done := make(chan struct{}, 3)
+	d.Handle(db.JobStartWork, func(ctx context.Context, job db.Job) error {
+		mu.Lock()
+		keys = append(keys, job.Key)
+		mu.Unlock()
+		done <- struct{}{}
+		return nil
+	})
+
+	for _, key := range []string{"thread1", "thread2", "thread3"} {
+		_, err := queue.EnqueueJob(db.JobStartWork, key, nil, 0)
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("jobs were not executed")
+		}
+	}
+	d.Wait()
+
+	require.ElementsMatch(t, []string{"thread1", "thread2", "thread3"}, keys)
+}
+
+func TestDispatcher_BoundedConcurrency(t *testing.T) {
+	queue := newTestQueue(t)
+	d := NewDispatcher(queue, 2)
+
+	var running, maxRunning int32
+	var finished sync.WaitGroup
+	finished.Add(5)
+	d.Handle(db.JobStartWork, func(ctx context.Context, job db.Job) error {
+		defer finished.Done()
+		current := atomic.AddInt32(&running, 1)
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		_, err := queue.EnqueueJob(db.JobStartWork, key, nil, 0)
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	finished.Wait()
+	d.Wait()
+	require.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestDispatcher_FailedJobIsRetried(t *testing.T) {
+	queue := newTestQueue(t)
+	d := NewDispatcher(queue, 1)
+	d.Handle(db.JobProposeSolution, func(ctx context.Context, job db.Job) error {
+		return errors.New("cli unavailable")
+	})
+
+	_, err := queue.EnqueueJob(db.JobProposeSolution, "thread1", nil, 3)
+	require.NoError(t, err)
+
+	jobs, err := queue.ClaimJobs(time.Now().Unix(), 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	d.run(context.Background(), jobs[0])
+
+	job, err := queue.ReadJob(jobs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, db.JobPending, job.State)
+	require.Equal(t, "cli unavailable", job.LastError)
+	require.Greater(t, job.NextRunAt, time.Now().Unix())
+}
+
+func TestDispatcher_PanicIsRecorded(t *testing.T) {
+	queue := newTestQueue(t)
+	d := NewDispatcher(queue, 1)
+	d.Handle(db.JobRevealSolution, func(ctx context.Context, job db.Job) error {
+		panic("unexpected")
+	})
+
+	_, err := queue.EnqueueJob(db.JobRevealSolution, "thread1", nil, 1)
+	require.NoError(t, err)
+	jobs, err := queue.ClaimJobs(time.Now().Unix(), 1)
+	require.NoError(t, err)
+
+	d.run(context.Background(), jobs[0])
+
+	job, err := queue.ReadJob(jobs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, db.JobFailed, job.State)
+	require.Contains(t, job.LastError, "unexpected")
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, 5*time.Second, retryDelay(1))
+	require.Equal(t, 10*time.Second, retryDelay(2))
+	require.Equal(t, 20*time.Second, retryDelay(3))
+	require.Equal(t, maxRetryDelay, retryDelay(20))
+}
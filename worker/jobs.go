@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/audioStemLogger"
+	"github.com/janction/audioStem/db"
+	"github.com/janction/audioStem/ipfs"
+	"github.com/janction/audioStem/retention"
+)
+
+// handleJobs registers the handlers of every job the daemon enqueues.
+func handleJobs(d *Dispatcher, cdc codec.Codec, conf VideoConfiguration, localDB db.Database, query audioStem.QueryClient) {
+	d.Handle(db.JobRegisterWorker, func(ctx context.Context, job db.Job) error {
+		stake, err := sdk.ParseCoinNormalized(string(job.Payload))
+		if err != nil {
+			return err
+		}
+		return audioStem.Worker{}.RegisterWorker(job.Key, stake, localDB)
+	})
+
+	d.Handle(db.JobSubscribeWorker, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		return audioStem.AudioStemTask{}.SubscribeWorkerToTask(ctx, conf.WorkerAddress, thread.TaskId, thread.ThreadId, localDB)
+	})
+
+	d.Handle(db.JobStartWork, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		workPath := filepath.Join(conf.RootPath, "audioStems", thread.ThreadId)
+		return thread.StartWork(ctx, conf.WorkerAddress, thread.Cid, workPath, conf.DownloadOptions(), localDB)
+	})
+
+	d.Handle(db.JobProposeSolution, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		keys, err := conf.KeyLocation()
+		if err != nil {
+			return err
+		}
+		return thread.ProposeSolution(cdc, keys, conf.WorkerName, conf.WorkerAddress, conf.RootPath, localDB)
+	})
+
+	d.Handle(db.JobSubmitVerification, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		keys, err := conf.KeyLocation()
+		if err != nil {
+			return err
+		}
+		// the validation needs as many stems as the chain requires to accept a solution
+		params, err := query.GetParams(ctx, &audioStem.QueryGetParamsRequest{})
+		if err != nil {
+			return err
+		}
+		return thread.SubmitVerification(cdc, keys, conf.WorkerName, conf.WorkerAddress, conf.RootPath, params.Params.MinValidStemsPercent, localDB)
+	})
+
+	d.Handle(db.JobRevealSolution, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		return thread.RevealSolution(conf.RootPath, localDB)
+	})
+
+	d.Handle(db.JobRevealValidation, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		return thread.RevealValidation(conf.WorkerAddress, conf.RootPath, localDB)
+	})
+
+	d.Handle(db.JobSubmitSolution, func(ctx context.Context, job db.Job) error {
+		thread, err := decodeThread(cdc, job)
+		if err != nil {
+			return err
+		}
+		return thread.SubmitSolution(ctx, conf.WorkerAddress, conf.RootPath, localDB)
+	})
+
+	d.Handle(db.JobConnectIPFS, func(ctx context.Context, job db.Job) error {
+		var w audioStem.Worker
+		if err := cdc.Unmarshal(job.Payload, &w); err != nil {
+			return err
+		}
+		ipfs.EnsureIPFSRunning()
+		ipfs.ConnectToIPFSNode(w.PublicIp, w.IpfsId)
+		return nil
+	})
+
+	d.Handle(db.JobPruneLogs, func(ctx context.Context, job db.Job) error {
+		if conf.LogRetentionDays <= 0 {
+			return nil
+		}
+		before := time.Now().AddDate(0, 0, -int(conf.LogRetentionDays)).Unix()
+		pruned, err := localDB.PruneLogs(before)
+		if err != nil {
+			return err
+		}
+		audioStemLogger.Logger.Debug("pruned %v log entries older than %v days", pruned, conf.LogRetentionDays)
+		return nil
+	})
+
+	d.Handle(db.JobCollectStorage, func(ctx context.Context, job db.Job) error {
+		report, err := retention.NewManager(localDB, conf.RetentionConfig()).Collect(ctx)
+		if err != nil {
+			return err
+		}
+		audioStemLogger.Logger.Debug("collected %v threads, %v bytes freed, %v bytes used", len(report.Collected), report.Freed, report.Used)
+		return nil
+	})
+}
+
+func decodeThread(cdc codec.Codec, job db.Job) (audioStem.AudioStemThread, error) {
+	var thread audioStem.AudioStemThread
+	err := cdc.Unmarshal(job.Payload, &thread)
+	return thread, err
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/db"
+)
+
+// ReadLogs returns the requested page of the logs the worker keeps for a thread in its
+// database.
+func ReadLogs(database db.Database, req *audioStem.QueryGetAudioStemLogsRequest) (*audioStem.QueryGetAudioStemLogsResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	offset, limit, err := logsPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := db.LogFilter{
+		ThreadId:    req.ThreadId,
+		Since:       req.Since,
+		MinSeverity: int64(req.MinSeverity),
+		Offset:      offset,
+		Limit:       limit,
+		Reverse:     req.Pagination.GetReverse(),
+	}
+	result, total, err := database.ReadLogs(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*audioStem.AudioStemLogs_AudioStemLog, 0, len(result))
+	for _, val := range result {
+		logEntry := audioStem.AudioStemLogs_AudioStemLog{Log: val.Log, Timestamp: val.Timestamp, Severity: audioStem.AudioStemLogs_AudioStemLog_SEVERITY(val.Severity)}
+		logs = append(logs, &logEntry)
+	}
+
+	pageRes := &query.PageResponse{}
+	if next := offset + len(result); next < total {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, uint64(next))
+	}
+	if req.Pagination.GetCountTotal() {
+		pageRes.Total = uint64(total)
+	}
+
+	return &audioStem.QueryGetAudioStemLogsResponse{AudioStemLogs: &audioStem.AudioStemLogs{ThreadId: req.ThreadId, Logs: logs}, Pagination: pageRes}, nil
+}
+
+// logsPage returns the offset and limit of the requested page of logs. Logs live in the
+// local database instead of the store, so the key of a page is the offset it starts at.
+func logsPage(page *query.PageRequest) (int, int, error) {
+	if page == nil {
+		return 0, query.DefaultLimit, nil
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return 0, 0, errors.New("either offset or key is expected, got both")
+	}
+
+	offset := int(page.Offset)
+	if len(page.Key) > 0 {
+		if len(page.Key) != 8 {
+			return 0, 0, errors.New("invalid pagination key")
+		}
+		offset = int(binary.BigEndian.Uint64(page.Key))
+	}
+
+	limit := int(page.Limit)
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	return offset, limit, nil
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/audioStem"
+	"github.com/janction/audioStem/db"
+)
+
+func newLogsDB(t *testing.T) db.Database {
+	localDB := db.NewMemory()
+	for i, severity := range []int64{db.SeverityInfo, db.SeverityWarning, db.SeverityError, db.SeverityInfo, db.SeveritySuccess} {
+		require.NoError(t, localDB.AddLogEntry("thread1", "log", int64(100+i), severity))
+	}
+	return localDB
+}
+
+func TestReadLogs_Empty(t *testing.T) {
+	localDB := newLogsDB(t)
+
+	res, err := ReadLogs(localDB, &audioStem.QueryGetAudioStemLogsRequest{ThreadId: "unknown"})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "unknown", res.AudioStemLogs.ThreadId)
+	require.Empty(t, res.AudioStemLogs.Logs)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+func TestReadLogs_Filters(t *testing.T) {
+	localDB := newLogsDB(t)
+
+	res, err := ReadLogs(localDB, &audioStem.QueryGetAudioStemLogsRequest{
+		ThreadId:    "thread1",
+		MinSeverity: audioStem.AudioStemLogs_AudioStemLog_WARNING,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.AudioStemLogs.Logs, 2)
+	require.Equal(t, audioStem.AudioStemLogs_AudioStemLog_WARNING, res.AudioStemLogs.Logs[0].Severity)
+	require.Equal(t, audioStem.AudioStemLogs_AudioStemLog_ERROR, res.AudioStemLogs.Logs[1].Severity)
+
+	res, err = ReadLogs(localDB, &audioStem.QueryGetAudioStemLogsRequest{ThreadId: "thread1", Since: 103})
+	require.NoError(t, err)
+	require.Len(t, res.AudioStemLogs.Logs, 2)
+	require.Equal(t, int64(103), res.AudioStemLogs.Logs[0].Timestamp)
+}
+
+func TestReadLogs_Pagination(t *testing.T) {
+	localDB := newLogsDB(t)
+	req := &audioStem.QueryGetAudioStemLogsRequest{ThreadId: "thread1", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+
+	var timestamps []int64
+	for {
+		res, err := ReadLogs(localDB, req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), res.Pagination.Total)
+		for _, log := range res.AudioStemLogs.Logs {
+			timestamps = append(timestamps, log.Timestamp)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2, CountTotal: true}
+	}
+	require.Equal(t, []int64{100, 101, 102, 103, 104}, timestamps)
+
+	_, err := ReadLogs(localDB, &audioStem.QueryGetAudioStemLogsRequest{
+		ThreadId:   "thread1",
+		Pagination: &query.PageRequest{Key: []byte{0, 0, 0, 0, 0, 0, 0, 1}, Offset: 1},
+	})
+	require.Error(t, err)
+}